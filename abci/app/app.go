@@ -27,19 +27,39 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/sirupsen/logrus"
 
 	appV1 "github.com/ndidplatform/smart-contract/v9/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	// appV2 "github.com/ndidplatform/smart-contract/v9/abci/app2/v2"
 )
 
+// versionedApplication is implemented by each ABCI app version
+type versionedApplication interface {
+	Info(info *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error)
+	CheckTx(check *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error)
+	FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error)
+	Commit(commit *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error)
+	Query(req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error)
+}
+
 type ABCIApplicationInterface struct {
 	appV1 *appV1.ABCIApplication
 	// appV2        *appV2.ABCIApplication
+	logger *logrus.Entry
+	// versions is registry of app versions supported by this binary keyed by protocol version
+	versions map[uint64]versionedApplication
+	// baseProtocolVersion is protocol version active before first scheduled activation
+	baseProtocolVersion uint64
+	// activations are loaded from committed state on start and after each commit
+	activations        []appV1.AppVersionActivation
+	activationsMutex   sync.RWMutex
 	CurrentBlockHeight int64
 }
 
@@ -60,6 +80,8 @@ func NewABCIApplicationInterface() *ABCIApplicationInterface {
 
 	var initialStateDir = getEnv("ABCI_INITIAL_STATE_DIR_PATH", "")
 
+	var chainHandoffExportDir = getEnv("ABCI_CHAIN_HANDOFF_EXPORT_DIR_PATH", "")
+
 	var retainBlockCountStr = getEnv("TENDERMINT_RETAIN_BLOCK_COUNT", "")
 	var retainBlockCount int64
 	if retainBlockCountStr == "" {
@@ -71,43 +93,139 @@ func NewABCIApplicationInterface() *ABCIApplicationInterface {
 		}
 	}
 
-	return &ABCIApplicationInterface{
-		appV1: appV1.NewABCIApplication(logger, db, initialStateDir, retainBlockCount),
-		// appV2: appV2.NewABCIApplication(logger, db, initialStateDir, retainBlockCount),
+	v1 := appV1.NewABCIApplication(logger, db, initialStateDir, retainBlockCount, chainHandoffExportDir)
+	// v2 := appV2.NewABCIApplication(logger, db, initialStateDir, retainBlockCount, chainHandoffExportDir)
+
+	app := &ABCIApplicationInterface{
+		appV1:  v1,
+		logger: logger,
+		versions: map[uint64]versionedApplication{
+			v1.AppProtocolVersion: v1,
+			// v2.AppProtocolVersion: v2,
+		},
+		baseProtocolVersion: v1.AppProtocolVersion,
+		CurrentBlockHeight:  v1.LastBlockHeight(),
+	}
+	protocolVersions := make([]uint64, 0, len(app.versions))
+	for protocolVersion := range app.versions {
+		protocolVersions = append(protocolVersions, protocolVersion)
 	}
+	v1.SetSupportedProtocolVersions(protocolVersions)
+	app.loadAppVersionSchedule()
+
+	return app
+}
+
+// loadAppVersionSchedule reloads version activations from committed state
+func (app *ABCIApplicationInterface) loadAppVersionSchedule() {
+	activations, err := app.appV1.GetAppVersionSchedule()
+	if err != nil {
+		panic(fmt.Errorf("could not load app version schedule: %v", err.Error()))
+	}
+	app.activationsMutex.Lock()
+	app.activations = activations
+	app.activationsMutex.Unlock()
+}
+
+func (app *ABCIApplicationInterface) protocolVersionAt(height int64) uint64 {
+	app.activationsMutex.RLock()
+	defer app.activationsMutex.RUnlock()
+	return appV1.AppProtocolVersionAt(app.activations, app.baseProtocolVersion, height)
+}
+
+// appAt returns app version to process block at height
+func (app *ABCIApplicationInterface) appAt(height int64) (versionedApplication, uint64, error) {
+	protocolVersion := app.protocolVersionAt(height)
+	versionApp, ok := app.versions[protocolVersion]
+	if !ok {
+		return nil, protocolVersion, fmt.Errorf(
+			"ABCI app protocol version %d required from block height %d is not supported by this binary, upgrade is required",
+			protocolVersion,
+			height,
+		)
+	}
+	return versionApp, protocolVersion, nil
 }
 
 func (app *ABCIApplicationInterface) Info(_ context.Context, info *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error) {
-	return app.appV1.Info(info)
+	versionApp, protocolVersion, err := app.appAt(app.CurrentBlockHeight)
+	if err != nil {
+		// report last block so consensus engine can replay and halt at upgrade height
+		versionApp = app.appV1
+	}
+	res, err := versionApp.Info(info)
+	if err != nil {
+		return res, err
+	}
+	res.AppVersion = protocolVersion
+	return res, nil
 }
 
 func (app *ABCIApplicationInterface) CheckTx(_ context.Context, check *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
-	// IMPORTANT: Need to move app state load to this struct level if using multiple ABCI app versions
-	// otherwise app.CurrentBlockHeight will always be 0 on process start
-	switch {
-	case app.CurrentBlockHeight >= 0:
-		return app.appV1.CheckTx(check)
-	default:
-		return app.appV1.CheckTx(check)
+	// Tx is to be included in next block
+	versionApp, _, err := app.appAt(app.CurrentBlockHeight + 1)
+	if err != nil {
+		return &abcitypes.ResponseCheckTx{
+			Code: code.UnsupportedAppProtocolVersion,
+			Log:  err.Error(),
+		}, nil
 	}
+	return versionApp.CheckTx(check)
 }
 
 func (app *ABCIApplicationInterface) FinalizeBlock(_ context.Context, req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
 	app.CurrentBlockHeight = req.Height
-	switch {
-	case app.CurrentBlockHeight >= 0:
-		return app.appV1.FinalizeBlock(req)
-	default:
-		return app.appV1.FinalizeBlock(req)
+	versionApp, protocolVersion, err := app.appAt(req.Height)
+	if err != nil {
+		app.logger.Error(err.Error())
+		panic(err)
+	}
+	res, err := versionApp.FinalizeBlock(req)
+	if err != nil {
+		return res, err
+	}
+
+	// Switch block header app version in the block before activation
+	nextProtocolVersion := app.protocolVersionAt(req.Height + 1)
+	if nextProtocolVersion != protocolVersion {
+		app.logger.Infof("ABCI app protocol version %d activates at block height %d", nextProtocolVersion, req.Height+1)
+		res.ConsensusParamUpdates = &cmtproto.ConsensusParams{
+			Version: &cmtproto.VersionParams{
+				App: nextProtocolVersion,
+			},
+		}
 	}
+
+	return res, nil
 }
 
 func (app *ABCIApplicationInterface) Commit(_ context.Context, commit *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error) {
-	return app.appV1.Commit(commit)
+	versionApp, _, err := app.appAt(app.CurrentBlockHeight)
+	if err != nil {
+		panic(err)
+	}
+	res, err := versionApp.Commit(commit)
+	if err != nil {
+		return res, err
+	}
+	app.loadAppVersionSchedule()
+	return res, nil
 }
 
 func (app *ABCIApplicationInterface) Query(_ context.Context, req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
-	return app.appV1.Query(req)
+	height := req.Height
+	if height == 0 {
+		height = app.CurrentBlockHeight
+	}
+	versionApp, _, err := app.appAt(height)
+	if err != nil {
+		return &abcitypes.ResponseQuery{
+			Code:   code.UnsupportedAppProtocolVersion,
+			Log:    err.Error(),
+			Height: height,
+		}, nil
+	}
+	return versionApp.Query(req)
 }
 
 func (app *ABCIApplicationInterface) InitChain(_ context.Context, chain *abcitypes.RequestInitChain) (*abcitypes.ResponseInitChain, error) {
@@ -138,7 +256,7 @@ func (app *ABCIApplicationInterface) ApplySnapshotChunk(ctx context.Context, chu
 	return app.appV1.ApplySnapshotChunk(ctx, chunk)
 }
 
-func (app ABCIApplicationInterface) ExtendVote(ctx context.Context, extend *abcitypes.RequestExtendVote) (*abcitypes.ResponseExtendVote, error) {
+func (app *ABCIApplicationInterface) ExtendVote(ctx context.Context, extend *abcitypes.RequestExtendVote) (*abcitypes.ResponseExtendVote, error) {
 	return app.appV1.ExtendVote(ctx, extend)
 }
 
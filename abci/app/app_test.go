@@ -0,0 +1,115 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	appV1 "github.com/ndidplatform/smart-contract/v9/abci/app/v1"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+// testVersionedApplication records blocks and txs dispatched to it
+type testVersionedApplication struct {
+	finalizedHeights []int64
+	checkTxCount     int
+}
+
+func (app *testVersionedApplication) Info(info *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error) {
+	return &abcitypes.ResponseInfo{}, nil
+}
+
+func (app *testVersionedApplication) CheckTx(check *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	app.checkTxCount++
+	return &abcitypes.ResponseCheckTx{Code: code.OK}, nil
+}
+
+func (app *testVersionedApplication) FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
+	app.finalizedHeights = append(app.finalizedHeights, req.Height)
+	return &abcitypes.ResponseFinalizeBlock{}, nil
+}
+
+func (app *testVersionedApplication) Commit(commit *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error) {
+	return &abcitypes.ResponseCommit{}, nil
+}
+
+func (app *testVersionedApplication) Query(req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
+	return &abcitypes.ResponseQuery{Log: "v6"}, nil
+}
+
+func TestAppVersionDispatch(t *testing.T) {
+	logger := logrus.NewEntry(logrus.New())
+	v1 := appV1.NewABCIApplication(logger, dbm.NewMemDB(), "", 0, "")
+	v6 := &testVersionedApplication{}
+	app := &ABCIApplicationInterface{
+		appV1:  v1,
+		logger: logger,
+		versions: map[uint64]versionedApplication{
+			v1.AppProtocolVersion: v1,
+			6:                     v6,
+		},
+		baseProtocolVersion: v1.AppProtocolVersion,
+		activations:         []appV1.AppVersionActivation{{ProtocolVersion: 6, ActivationHeight: 3}},
+	}
+	ctx := context.Background()
+
+	res, err := app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{Height: 1})
+	assert.NoError(t, err)
+	assert.Nil(t, res.ConsensusParamUpdates)
+
+	// block header app version is switched in the block before activation
+	res, err = app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{Height: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), res.ConsensusParamUpdates.Version.App)
+	assert.Empty(t, v6.finalizedHeights)
+
+	// tx checked for next block is dispatched to next version
+	checkTxRes, err := app.CheckTx(ctx, &abcitypes.RequestCheckTx{})
+	assert.NoError(t, err)
+	assert.Equal(t, code.OK, checkTxRes.Code)
+	assert.Equal(t, 1, v6.checkTxCount)
+
+	_, err = app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{Height: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3}, v6.finalizedHeights)
+
+	queryRes, err := app.Query(ctx, &abcitypes.RequestQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v6", queryRes.Log)
+
+	// version not registered in binary
+	app.activations = append(app.activations, appV1.AppVersionActivation{ProtocolVersion: 7, ActivationHeight: 5})
+	app.CurrentBlockHeight = 4
+	checkTxRes, err = app.CheckTx(ctx, &abcitypes.RequestCheckTx{})
+	assert.NoError(t, err)
+	assert.Equal(t, code.UnsupportedAppProtocolVersion, checkTxRes.Code)
+	assert.Panics(t, func() {
+		app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{Height: 5})
+	})
+}
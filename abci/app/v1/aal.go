@@ -26,11 +26,8 @@ import (
 	"encoding/json"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
-	"github.com/ndidplatform/smart-contract/v9/abci/utils"
-	data "github.com/ndidplatform/smart-contract/v9/protos/data"
 )
 
 type SetSupportedAALListParam struct {
@@ -87,13 +84,17 @@ func (app *ABCIApplication) setSupportedAALList(param []byte, callerNodeID strin
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	var supportedAALList data.SupportedAALList
-	supportedAALList.AalList = funcParam.SupportedAALList
-	supportedAALListByte, err := utils.ProtoDeterministicMarshal(&supportedAALList)
+	supportedAALList := funcParam.SupportedAALList
+	if supportedAALList == nil {
+		supportedAALList = []float64{}
+	}
+	err = app.storeParameter("SupportedAALList", supportedAALList)
 	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
-	app.state.Set(supportedAALListKeyBytes, supportedAALListByte)
 	return app.NewExecTxResult(code.OK, "success", "")
 }
 
@@ -112,29 +113,13 @@ func (app *ABCIApplication) GetSupportedAALList(param []byte, committedState boo
 	// 	return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	// }
 
-	var result GetSupportedAALListResult
-	result.SupportedAALList = make([]float64, 0)
-
-	supportedAALValue, err := app.state.Get(supportedAALListKeyBytes, committedState)
+	value, _, err := app.getParameterValue("SupportedAALList", committedState)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	if supportedAALValue == nil {
-		resultJSON, err := json.Marshal(result)
-		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-		}
 
-		return app.NewResponseQuery(resultJSON, "success", app.state.Height)
-	}
-
-	var supportedAALList data.SupportedAALList
-	err = proto.Unmarshal(supportedAALValue, &supportedAALList)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-
-	result.SupportedAALList = supportedAALList.AalList
+	var result GetSupportedAALListResult
+	result.SupportedAALList = value.([]float64)
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
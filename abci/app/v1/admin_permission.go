@@ -0,0 +1,573 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"strings"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func (app *ABCIApplication) getAdminPermission(nodeID string, committedState bool) (*data.AdminPermission, error) {
+	key := adminPermissionKeyPrefix + keySeparator + nodeID
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if value == nil {
+		return nil, nil
+	}
+	var adminPermission data.AdminPermission
+	err = proto.Unmarshal(value, &adminPermission)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &adminPermission, nil
+}
+
+func hasAdminPermissionScope(adminPermission *data.AdminPermission, scope appTypes.AdminPermissionScope) bool {
+	if adminPermission == nil {
+		return false
+	}
+	for _, s := range adminPermission.Scopes {
+		if s == string(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAdminPermission allows master NDID node and sub-administrator nodes
+// granted with given scope to call NDID method
+func (app *ABCIApplication) checkAdminPermission(callerNodeID string, scope appTypes.AdminPermissionScope, committedState bool) error {
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	adminPermission, err := app.getAdminPermission(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !hasAdminPermissionScope(adminPermission, scope) {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	return nil
+}
+
+// adminPermissionScopeByMethod lists NDID methods which sub-administrator nodes
+// can call with admin permission scope. SetParameter scope depends on parameter.
+var adminPermissionScopeByMethod = map[string]appTypes.AdminPermissionScope{
+	"SetNodeToken":    appTypes.AdminPermissionScopeTokens,
+	"AddNodeToken":    appTypes.AdminPermissionScopeTokens,
+	"ReduceNodeToken": appTypes.AdminPermissionScopeTokens,
+	"SetPriceFunc":    appTypes.AdminPermissionScopeTokens,
+
+	"AddNamespace":     appTypes.AdminPermissionScopeNamespaces,
+	"UpdateNamespace":  appTypes.AdminPermissionScopeNamespaces,
+	"EnableNamespace":  appTypes.AdminPermissionScopeNamespaces,
+	"DisableNamespace": appTypes.AdminPermissionScopeNamespaces,
+
+	"AddService":                               appTypes.AdminPermissionScopeServices,
+	"UpdateService":                            appTypes.AdminPermissionScopeServices,
+	"EnableService":                            appTypes.AdminPermissionScopeServices,
+	"DisableService":                           appTypes.AdminPermissionScopeServices,
+	"RegisterServiceDestinationByNDID":         appTypes.AdminPermissionScopeServices,
+	"EnableServiceDestinationByNDID":           appTypes.AdminPermissionScopeServices,
+	"DisableServiceDestinationByNDID":          appTypes.AdminPermissionScopeServices,
+	"SetServicePriceCeiling":                   appTypes.AdminPermissionScopeServices,
+	"SetServicePriceMinEffectiveDatetimeDelay": appTypes.AdminPermissionScopeServices,
+
+	"RegisterNode":                                         appTypes.AdminPermissionScopeNodes,
+	"UpdateNodeByNDID":                                     appTypes.AdminPermissionScopeNodes,
+	"EnableNode":                                           appTypes.AdminPermissionScopeNodes,
+	"DisableNode":                                          appTypes.AdminPermissionScopeNodes,
+	"DecommissionNode":                                     appTypes.AdminPermissionScopeNodes,
+	"AddNodeToProxyNode":                                   appTypes.AdminPermissionScopeNodes,
+	"UpdateNodeProxyNode":                                  appTypes.AdminPermissionScopeNodes,
+	"RemoveNodeFromProxyNode":                              appTypes.AdminPermissionScopeNodes,
+	"AddNodeCertificateAuthority":                          appTypes.AdminPermissionScopeNodes,
+	"RemoveNodeCertificateAuthority":                       appTypes.AdminPermissionScopeNodes,
+	"AddAllowedNodeSupportedFeature":                       appTypes.AdminPermissionScopeNodes,
+	"RemoveAllowedNodeSupportedFeature":                    appTypes.AdminPermissionScopeNodes,
+	"AddSuppressedIdentityModificationNotificationNode":    appTypes.AdminPermissionScopeNodes,
+	"RemoveSuppressedIdentityModificationNotificationNode": appTypes.AdminPermissionScopeNodes,
+	"FreezeReferenceGroup":                                 appTypes.AdminPermissionScopeNodes,
+	"UnfreezeReferenceGroup":                               appTypes.AdminPermissionScopeNodes,
+
+	"SetLastBlock": appTypes.AdminPermissionScopeChain,
+	"PauseMethod":  appTypes.AdminPermissionScopeChain,
+	"ResumeMethod": appTypes.AdminPermissionScopeChain,
+
+	"SetValidator":         appTypes.AdminPermissionScopeValidators,
+	"SetValidatorPowerCap": appTypes.AdminPermissionScopeValidators,
+}
+
+// getMethodAdminPermissionScope returns admin permission scope covering method call
+// or empty scope when the method is master NDID only or not an NDID method
+func getMethodAdminPermissionScope(method string, param []byte) appTypes.AdminPermissionScope {
+	if method == "SetParameter" {
+		var funcParam SetParameterParam
+		err := json.Unmarshal(param, &funcParam)
+		if err != nil {
+			return ""
+		}
+		definition, err := getParameterDefinition(funcParam.Name)
+		if err != nil {
+			return ""
+		}
+		return definition.adminScope
+	}
+	return adminPermissionScopeByMethod[method]
+}
+
+// isTokenExemptCaller returns true when caller is not charged token for method call:
+// master NDID node, or sub-administrator node granted with admin permission scope covering the method
+func (app *ABCIApplication) isTokenExemptCaller(method string, param []byte, callerNodeID string, committedState bool) (bool, error) {
+	ndidNode, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return false, err
+	}
+	if ndidNode {
+		return true, nil
+	}
+	scope := getMethodAdminPermissionScope(method, param)
+	if scope == "" {
+		return false, nil
+	}
+	adminPermission, err := app.getAdminPermission(callerNodeID, committedState)
+	if err != nil {
+		return false, err
+	}
+	return hasAdminPermissionScope(adminPermission, scope), nil
+}
+
+// checkCanManageNode prevents sub-administrators from modifying master NDID node
+func (app *ABCIApplication) checkCanManageNode(callerNodeID string, nodeID string, committedState bool) error {
+	if callerNodeID == nodeID {
+		return nil
+	}
+	callerIsNDID, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if callerIsNDID {
+		return nil
+	}
+	targetIsNDID, err := app.isNDIDNodeByNodeID(nodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if targetIsNDID {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to modify NDID node",
+		}
+	}
+	return nil
+}
+
+// parseAdminPermissionScopeList validates scopes (case-insensitive) and returns
+// them de-duplicated in canonical order
+func parseAdminPermissionScopeList(scopes []string) ([]string, error) {
+	requested := make(map[appTypes.AdminPermissionScope]bool)
+	for _, scope := range scopes {
+		found := false
+		for _, validScope := range appTypes.AdminPermissionScopes {
+			if strings.EqualFold(scope, string(validScope)) {
+				requested[validScope] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &ApplicationError{
+				Code:    code.InvalidAdminPermissionScope,
+				Message: "Invalid admin permission scope: " + scope,
+			}
+		}
+	}
+
+	result := make([]string, 0, len(requested))
+	for _, scope := range appTypes.AdminPermissionScopes {
+		if requested[scope] {
+			result = append(result, string(scope))
+		}
+	}
+	return result, nil
+}
+
+type GrantAdminPermissionParam struct {
+	NodeID string   `json:"node_id"`
+	Scopes []string `json:"scopes"`
+}
+
+func (app *ABCIApplication) validateGrantAdminPermission(funcParam GrantAdminPermissionParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if len(funcParam.Scopes) == 0 {
+		return &ApplicationError{
+			Code:    code.InvalidAdminPermissionScope,
+			Message: "Admin permission scope list can't be empty",
+		}
+	}
+	_, err = parseAdminPermissionScopeList(funcParam.Scopes)
+	if err != nil {
+		return err
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	nodeDetail, err := app.getNodeDetail(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if app.isNDIDNode(nodeDetail) {
+		return &ApplicationError{
+			Code:    code.CannotGrantAdminPermissionToNDIDNode,
+			Message: "Cannot grant admin permission to NDID node",
+		}
+	}
+
+	err = app.checkNodeNotDecommissioned(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) grantAdminPermissionCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam GrantAdminPermissionParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateGrantAdminPermission(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// grantAdminPermission adds scopes to node's delegated admin permission
+func (app *ABCIApplication) grantAdminPermission(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("GrantAdminPermission, Parameter: %s", param)
+	var funcParam GrantAdminPermissionParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateGrantAdminPermission(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	adminPermission, err := app.getAdminPermission(funcParam.NodeID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	if adminPermission == nil {
+		adminPermission = &data.AdminPermission{
+			NodeId: funcParam.NodeID,
+		}
+	}
+	scopes, err := parseAdminPermissionScopeList(append(adminPermission.Scopes, funcParam.Scopes...))
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	adminPermission.Scopes = scopes
+	adminPermission.GrantedBlockHeight = app.state.CurrentBlockHeight
+
+	value, err := utils.ProtoDeterministicMarshal(adminPermission)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	key := adminPermissionKeyPrefix + keySeparator + funcParam.NodeID
+	app.state.Set([]byte(key), value)
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "node_id"
+	attribute.Value = funcParam.NodeID
+	attributes = append(attributes, attribute)
+	attribute.Key = "scopes"
+	attribute.Value = strings.Join(scopes, ",")
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type RevokeAdminPermissionParam struct {
+	NodeID string   `json:"node_id"`
+	Scopes []string `json:"scopes"` // empty to revoke all scopes
+}
+
+func (app *ABCIApplication) validateRevokeAdminPermission(funcParam RevokeAdminPermissionParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	_, err = parseAdminPermissionScopeList(funcParam.Scopes)
+	if err != nil {
+		return err
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	adminPermission, err := app.getAdminPermission(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if adminPermission == nil {
+		return &ApplicationError{
+			Code:    code.AdminPermissionNotFound,
+			Message: "Admin permission not found",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) revokeAdminPermissionCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam RevokeAdminPermissionParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateRevokeAdminPermission(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// revokeAdminPermission removes scopes from node's delegated admin permission.
+// Permission record is deleted when no scope is left.
+func (app *ABCIApplication) revokeAdminPermission(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("RevokeAdminPermission, Parameter: %s", param)
+	var funcParam RevokeAdminPermissionParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateRevokeAdminPermission(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	adminPermission, err := app.getAdminPermission(funcParam.NodeID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	revokeScopes, _ := parseAdminPermissionScopeList(funcParam.Scopes)
+	remainingScopes := make([]string, 0)
+	if len(revokeScopes) > 0 {
+		revoke := make(map[string]bool, len(revokeScopes))
+		for _, scope := range revokeScopes {
+			revoke[scope] = true
+		}
+		for _, scope := range adminPermission.Scopes {
+			if !revoke[scope] {
+				remainingScopes = append(remainingScopes, scope)
+			}
+		}
+	}
+
+	key := adminPermissionKeyPrefix + keySeparator + funcParam.NodeID
+	if len(remainingScopes) == 0 {
+		err = app.state.Delete([]byte(key))
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+	} else {
+		adminPermission.Scopes = remainingScopes
+		value, err := utils.ProtoDeterministicMarshal(adminPermission)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		app.state.Set([]byte(key), value)
+	}
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "node_id"
+	attribute.Value = funcParam.NodeID
+	attributes = append(attributes, attribute)
+	attribute.Key = "scopes"
+	attribute.Value = strings.Join(remainingScopes, ",")
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type GetAdminPermissionParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type AdminPermission struct {
+	NodeID             string   `json:"node_id"`
+	Scopes             []string `json:"scopes"`
+	GrantedBlockHeight int64    `json:"granted_block_height"`
+}
+
+func newAdminPermission(adminPermission *data.AdminPermission) AdminPermission {
+	scopes := adminPermission.Scopes
+	if scopes == nil {
+		scopes = make([]string, 0)
+	}
+	return AdminPermission{
+		NodeID:             adminPermission.NodeId,
+		Scopes:             scopes,
+		GrantedBlockHeight: adminPermission.GrantedBlockHeight,
+	}
+}
+
+func (app *ABCIApplication) getAdminPermissionQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetAdminPermission, Parameter: %s", param)
+	var funcParam GetAdminPermissionParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	adminPermission, err := app.getAdminPermission(funcParam.NodeID, true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if adminPermission == nil {
+		return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
+	}
+
+	value, err := json.Marshal(newAdminPermission(adminPermission))
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
+
+type GetAdminPermissionListResult struct {
+	AdminPermissionList []AdminPermission `json:"admin_permission_list"`
+}
+
+func (app *ABCIApplication) getAdminPermissionList(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetAdminPermissionList, Parameter: %s", param)
+	nodeIDList, err := app.getCommittedKeyList(adminPermissionKeyPrefix + keySeparator)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	result := GetAdminPermissionListResult{
+		AdminPermissionList: make([]AdminPermission, 0, len(nodeIDList)),
+	}
+	for _, nodeID := range nodeIDList {
+		adminPermission, err := app.getAdminPermission(nodeID, true)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+		if adminPermission == nil {
+			continue
+		}
+		result.AdminPermissionList = append(result.AdminPermissionList, newAdminPermission(adminPermission))
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
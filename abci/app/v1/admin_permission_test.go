@@ -0,0 +1,269 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestParseAdminPermissionScopeList(t *testing.T) {
+	testCases := []struct {
+		name           string
+		scopes         []string
+		expectedScopes []string
+		expectedCode   uint32
+	}{
+		{"normalized and sorted", []string{"Validators", "tokens", "TOKENS"}, []string{"tokens", "validators"}, code.OK},
+		{"no scopes", nil, nil, code.OK},
+		{"unknown scope", []string{"nodes", "identity"}, nil, code.InvalidAdminPermissionScope},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scopes, err := parseAdminPermissionScopeList(tc.scopes)
+			if tc.expectedCode != code.OK {
+				assertApplicationErrorCode(t, tc.expectedCode, err)
+				return
+			}
+			assert.NoError(t, err)
+			if len(tc.expectedScopes) == 0 {
+				assert.Empty(t, scopes)
+			} else {
+				assert.Equal(t, tc.expectedScopes, scopes)
+			}
+		})
+	}
+}
+
+func TestHasAdminPermissionScope(t *testing.T) {
+	adminPermission := &data.AdminPermission{
+		NodeId: "sub_admin",
+		Scopes: []string{"namespaces", "services"},
+	}
+	testCases := []struct {
+		name            string
+		adminPermission *data.AdminPermission
+		scope           appTypes.AdminPermissionScope
+		expected        bool
+	}{
+		{"granted scope", adminPermission, appTypes.AdminPermissionScopeServices, true},
+		{"scope not granted", adminPermission, appTypes.AdminPermissionScopeTokens, false},
+		{"no admin permission", nil, appTypes.AdminPermissionScopeServices, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, hasAdminPermissionScope(tc.adminPermission, tc.scope))
+		})
+	}
+}
+
+func TestAdminPermissionEnforcement(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "admin1", "RP")
+	registerTestNode(t, app, "rp1", "RP")
+	deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{
+		NodeID: "admin1",
+		Scopes: []string{"namespaces", "nodes"},
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	// scoped sub-administrator
+	deliverTestTx(t, app, "AddNamespace", AddNamespaceParam{Namespace: "citizen_id", Active: true}, "admin1")
+	deliverTestTx(t, app, "UpdateNodeByNDID", UpdateNodeByNDIDParam{NodeID: "rp1", NodeName: "RP 1"}, "admin1")
+	rejectedTestCases := []struct {
+		name         string
+		method       string
+		param        interface{}
+		callerNodeID string
+	}{
+		{"scope not granted", "SetNodeToken", SetNodeTokenParam{NodeID: "rp1", Amount: 100}, "admin1"},
+		{"update master NDID node", "UpdateNodeByNDID", UpdateNodeByNDIDParam{NodeID: testNDIDNodeID, NodeName: "NDID"}, "admin1"},
+		{"disable master NDID node", "DisableNode", DisableNodeParam{NodeID: testNDIDNodeID}, "admin1"},
+		{"node without admin permission", "AddNamespace", AddNamespaceParam{Namespace: "passport", Active: true}, "rp1"},
+		{"grant by sub-administrator", "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "rp1", Scopes: []string{"namespaces"}}, "admin1"},
+	}
+	for _, tc := range rejectedTestCases {
+		res := callTestTx(t, app, tc.method, tc.param, tc.callerNodeID)
+		assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code, tc.name)
+	}
+	commitTestBlock(app)
+
+	// partial revoke
+	beginTestBlock(app)
+	deliverTestTx(t, app, "RevokeAdminPermission", RevokeAdminPermissionParam{NodeID: "admin1", Scopes: []string{"nodes"}}, testNDIDNodeID)
+	res := callTestTx(t, app, "UpdateNodeByNDID", UpdateNodeByNDIDParam{NodeID: "rp1", NodeName: "RP"}, "admin1")
+	assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code)
+	deliverTestTx(t, app, "AddNamespace", AddNamespaceParam{Namespace: "passport", Active: true}, "admin1")
+	commitTestBlock(app)
+
+	// revoke all scopes, takes effect within the same block
+	beginTestBlock(app)
+	deliverTestTx(t, app, "RevokeAdminPermission", RevokeAdminPermissionParam{NodeID: "admin1"}, testNDIDNodeID)
+	res = callTestTx(t, app, "AddNamespace", AddNamespaceParam{Namespace: "driver_license", Active: true}, "admin1")
+	assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code)
+	commitTestBlock(app)
+
+	adminPermission, err := app.getAdminPermission("admin1", true)
+	assert.NoError(t, err)
+	assert.Nil(t, adminPermission)
+}
+
+func TestAdminPermissionMasterNDIDOnlyMethods(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "admin1", "RP")
+	scopes := make([]string, 0, len(appTypes.AdminPermissionScopes))
+	for _, scope := range appTypes.AdminPermissionScopes {
+		scopes = append(scopes, string(scope))
+	}
+	deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "admin1", Scopes: scopes}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	// methods not covered by any scope
+	masterNDIDOnlyMethods := []string{
+		"GrantAdminPermission",
+		"RevokeAdminPermission",
+		"SetSupportedIALList",
+		"SetSupportedAALList",
+		"SetAllowedModeList",
+		"SetAllowedMinIalForRegisterIdentityAtFirstIdp",
+		"SetTimeOutBlockRegisterIdentity",
+		"AddErrorCode",
+		"RemoveErrorCode",
+		"AddRequestType",
+		"RemoveRequestType",
+		"BulkRegisterIdentityByNDID",
+		"TransferIdPAssociations",
+		"ProcessIdPAssociationTransferBatch",
+		"CancelIdPAssociationTransfer",
+		"SetUpgradePlan",
+		"SetGovernanceConfig",
+	}
+	beginTestBlock(app)
+	for _, method := range masterNDIDOnlyMethods {
+		res := app.callDeliverTx(method, []byte("{}"), "admin1")
+		assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code, method)
+	}
+	commitTestBlock(app)
+}
+
+func TestAdminPermissionScopeByMethod(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "admin1", "RP")
+	commitTestBlock(app)
+
+	// scope of each method is the scope checked by the method
+	for method, scope := range adminPermissionScopeByMethod {
+		otherScopes := make([]string, 0, len(appTypes.AdminPermissionScopes))
+		for _, s := range appTypes.AdminPermissionScopes {
+			if s != scope {
+				otherScopes = append(otherScopes, string(s))
+			}
+		}
+		beginTestBlock(app)
+		deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "admin1", Scopes: otherScopes}, testNDIDNodeID)
+		res := app.callDeliverTx(method, []byte(`{}`), "admin1")
+		assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code, method)
+		deliverTestTx(t, app, "RevokeAdminPermission", RevokeAdminPermissionParam{NodeID: "admin1"}, testNDIDNodeID)
+		deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "admin1", Scopes: []string{string(scope)}}, testNDIDNodeID)
+		res = app.callDeliverTx(method, []byte(`{}`), "admin1")
+		assert.NotEqual(t, code.NoPermissionForCallNDIDMethod, res.Code, method)
+		deliverTestTx(t, app, "RevokeAdminPermission", RevokeAdminPermissionParam{NodeID: "admin1"}, testNDIDNodeID)
+		commitTestBlock(app)
+	}
+}
+
+func TestIsTokenExemptCaller(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "admin1", "RP")
+	registerTestNode(t, app, "rp1", "RP")
+	deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "admin1", Scopes: []string{"namespaces"}}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	testCases := []struct {
+		method string
+		param  string
+		nodeID string
+		exempt bool
+	}{
+		{"SetSupportedIALList", `{}`, testNDIDNodeID, true},
+		{"CreateRequest", `{}`, testNDIDNodeID, true},
+		{"AddNamespace", `{}`, "admin1", true},
+		// scope not granted
+		{"SetNodeToken", `{}`, "admin1", false},
+		{"SetParameter", `{"name":"ServicePriceMinEffectiveDatetimeDelay"}`, "admin1", false},
+		// master NDID only method called without permission
+		{"SetSupportedIALList", `{}`, "admin1", false},
+		{"CancelScheduledChange", `{}`, "admin1", false},
+		{"CreateRequest", `{}`, "admin1", false},
+		{"AddNamespace", `{}`, "rp1", false},
+	}
+	for _, testCase := range testCases {
+		exempt, err := app.isTokenExemptCaller(testCase.method, []byte(testCase.param), testCase.nodeID, true)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.exempt, exempt, "%s by %s", testCase.method, testCase.nodeID)
+	}
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "admin1", Scopes: []string{"services"}}, testNDIDNodeID)
+	commitTestBlock(app)
+	exempt, err := app.isTokenExemptCaller("SetParameter", []byte(`{"name":"ServicePriceMinEffectiveDatetimeDelay"}`), "admin1", true)
+	assert.NoError(t, err)
+	assert.True(t, exempt)
+	exempt, err = app.isTokenExemptCaller("SetParameter", []byte(`{"name":"SupportedIALList"}`), "admin1", true)
+	assert.NoError(t, err)
+	assert.False(t, exempt)
+}
+
+func TestAdminPermissionAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+
+	// baseline node is granted scopes like any other node
+	beginTestBlock(app)
+	deliverTestTx(t, app, "GrantAdminPermission", GrantAdminPermissionParam{NodeID: "rp1", Scopes: []string{"nodes", "tokens"}}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "SetNodeToken", SetNodeTokenParam{NodeID: "idp1", Amount: 200}, "rp1")
+	deliverTestTx(t, app, "UpdateNodeByNDID", UpdateNodeByNDIDParam{NodeID: "idp1", NodeName: "IdP 1 updated"}, "rp1")
+	res := callTestTx(t, app, "AddNamespace", AddNamespaceParam{Namespace: "citizen_id", Active: true}, "rp1")
+	assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code)
+	commitTestBlock(app)
+
+	nodeDetail, err := app.getNodeDetail("idp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "IdP 1 updated", nodeDetail.NodeName)
+	// migrated roles are kept
+	assert.Equal(t, []string{"IdP"}, nodeDetail.Roles)
+	token, err := app.getToken("idp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(200), token)
+}
@@ -44,21 +44,25 @@ import (
 
 type ABCIApplication struct {
 	abcitypes.BaseApplication
-	AppProtocolVersion  uint64
-	CurrentChain        string
-	Version             string
-	checkTxNonceState   *utils.StringByteArrayMap
-	deliverTxNonceState map[string][]byte
-	logger              *logrus.Entry
-	state               AppState
-	valUpdates          map[string]abcitypes.ValidatorUpdate
-	verifiedSignatures  *utils.StringMap
-	lastBlockTime       time.Time
-	initialStateDir     string
-	retainBlockCount    int64
+	AppProtocolVersion    uint64
+	CurrentChain          string
+	Version               string
+	checkTxNonceState     *utils.StringByteArrayMap
+	deliverTxNonceState   map[string][]byte
+	logger                *logrus.Entry
+	state                 AppState
+	valUpdates            map[string]abcitypes.ValidatorUpdate
+	approvedProposals     []string
+	verifiedSignatures    *utils.StringMap
+	lastBlockHash         []byte
+	initialStateDir       string
+	retainBlockCount      int64
+	chainHandoffExportDir string
+	// supportedProtocolVersions are app protocol versions registered in this binary
+	supportedProtocolVersions []uint64
 }
 
-func NewABCIApplication(logger *logrus.Entry, db dbm.DB, initialStateDir string, retainBlockCount int64) *ABCIApplication {
+func NewABCIApplication(logger *logrus.Entry, db dbm.DB, initialStateDir string, retainBlockCount int64, chainHandoffExportDir string) *ABCIApplication {
 	defer func() {
 		if r := recover(); r != nil {
 			logger.Errorf("%s", identifyPanic())
@@ -76,22 +80,24 @@ func NewABCIApplication(logger *logrus.Entry, db dbm.DB, initialStateDir string,
 	logger.Infof("Start ABCI version: %s", ABCIVersion)
 
 	return &ABCIApplication{
-		AppProtocolVersion:  ABCIProtocolVersion,
-		Version:             ABCIVersion,
-		CurrentChain:        appState.ChainID,
-		checkTxNonceState:   utils.NewStringByteArrayMap(),
-		deliverTxNonceState: make(map[string][]byte),
-		logger:              logger,
-		state:               *appState,
-		valUpdates:          make(map[string]abcitypes.ValidatorUpdate),
-		verifiedSignatures:  utils.NewStringMap(),
-		initialStateDir:     initialStateDir,
-		retainBlockCount:    retainBlockCount,
+		AppProtocolVersion:    ABCIProtocolVersion,
+		Version:               ABCIVersion,
+		CurrentChain:          appState.ChainID,
+		checkTxNonceState:     utils.NewStringByteArrayMap(),
+		deliverTxNonceState:   make(map[string][]byte),
+		logger:                logger,
+		state:                 *appState,
+		valUpdates:            make(map[string]abcitypes.ValidatorUpdate),
+		verifiedSignatures:    utils.NewStringMap(),
+		initialStateDir:       initialStateDir,
+		retainBlockCount:      retainBlockCount,
+		chainHandoffExportDir: chainHandoffExportDir,
 	}
 }
 
 type InfoData struct {
-	InitialStateDataLoaded bool `json:"initial_state_data_loaded"`
+	InitialStateDataLoaded bool  `json:"initial_state_data_loaded"`
+	SchemaVersion          int64 `json:"schema_version"`
 }
 
 func (app *ABCIApplication) Info(info *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error) {
@@ -100,8 +106,13 @@ func (app *ABCIApplication) Info(info *abcitypes.RequestInfo) (*abcitypes.Respon
 	res.LastBlockHeight = app.state.Height
 	res.LastBlockAppHash = app.state.AppHash
 
+	schemaVersion, err := app.getSchemaVersion(true)
+	if err != nil {
+		app.logger.Warnf("ABCI Info: get schema version err: %+v", err)
+	}
 	infoData := &InfoData{
 		InitialStateDataLoaded: app.state.InitialStateDataLoaded,
+		SchemaVersion:          schemaVersion,
 	}
 	infoDataBytes, err := json.Marshal(infoData)
 	if err != nil {
@@ -133,15 +144,30 @@ func (app *ABCIApplication) InitChain(chain *abcitypes.RequestInitChain) (*abcit
 		app.state.HashDigest.Write(hash)
 
 		app.state.InitialStateDataLoaded = true
+
+		// record previous chain when the data is exported by chain handoff
+		initialStateMetadata, err := ReadInitialStateMetadata(app.initialStateDir)
+		if err != nil {
+			panic(err)
+		}
+		if initialStateMetadata.PreviousChain != nil {
+			app.logger.Infof("Previous chain: %s, last block height: %s", initialStateMetadata.PreviousChain.ChainID, initialStateMetadata.PreviousChain.LatestBlockHeight)
+			err = app.appendChainHistory(*initialStateMetadata.PreviousChain)
+			if err != nil {
+				panic(err)
+			}
+		}
 	} else {
 		app.logger.Infof("No initial state data provided")
+		// new chain has no records to migrate
+		app.setSchemaVersion(latestSchemaVersion())
 	}
 
 	app.CurrentChain = chain.ChainId
 	app.state.ChainID = chain.ChainId
 
 	for _, v := range chain.Validators {
-		r := app.updateValidator(v)
+		r := app.updateValidator(v, "", "", "")
 		if r.IsErr() {
 			app.logger.Error("Error updating validators", "r", r)
 		}
@@ -172,7 +198,24 @@ func (app *ABCIApplication) FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (
 	// 	panic(errors.New("chain ID mismatch (ABCI state != Tendermint)"))
 	// }
 
-	app.lastBlockTime = req.Time
+	app.state.CurrentBlockTime = req.Time.UnixMilli()
+	app.lastBlockHash = req.Hash
+
+	/*
+	 * pending state migrations of records written by earlier app version
+	 */
+
+	err := app.runStateMigrations()
+	if err != nil {
+		app.logger.Errorf("State migration failed: %+v", err)
+		panic(err)
+	}
+
+	/*
+	 * apply scheduled changes activated at this block
+	 */
+
+	events := app.applyScheduledChanges()
 
 	/*
 	 * execute transactions
@@ -190,6 +233,12 @@ func (app *ABCIApplication) FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (
 		txs[i] = execTxResult
 	}
 
+	/*
+	 * execute proposals approved in this block
+	 */
+
+	events = append(events, app.executeApprovedProposals()...)
+
 	/*
 	 * app hash
 	 */
@@ -216,6 +265,7 @@ func (app *ABCIApplication) FinalizeBlock(req *abcitypes.RequestFinalizeBlock) (
 
 	return &abcitypes.ResponseFinalizeBlock{
 		AppHash:          appHash,
+		Events:           events,
 		TxResults:        txs,
 		ValidatorUpdates: valUpdates,
 	}, nil
@@ -266,6 +316,15 @@ func (app *ABCIApplication) execTx(tx []byte) (res *abcitypes.ExecTxResult, err
 		return app.NewExecTxResult(code.MethodCanNotBeEmpty, "method can not be empty", ""), nil
 	}
 
+	// params of method with "_pb" suffix are protobuf encoded,
+	// signature is signed over method and params as sent
+	signedMethod, signedParam := method, param
+	method, param, err = decodeTxParams(method, param)
+	if err != nil {
+		go recordDeliverTxFailMetrics(method)
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), ""), nil
+	}
+
 	if mustCheckNodeSignature(method) {
 		// Check signature
 		publicKey, signingAlgorithm, retCode, retLog := app.getNodePublicKeyForSignatureVerification(method, param, nodeID, false)
@@ -288,7 +347,7 @@ func (app *ABCIApplication) execTx(tx []byte) (res *abcitypes.ExecTxResult, err
 		} else {
 			app.logger.Debugf("Cached verified Tx signature result could not be found")
 			app.logger.Debugf("Verifying Tx signature")
-			verifyResult, err := verifySignature(method, param, app.CurrentChain, nonce, signature, publicKey, signingAlgorithm)
+			verifyResult, err := verifySignature(signedMethod, signedParam, app.CurrentChain, nonce, signature, publicKey, signingAlgorithm)
 			if err != nil {
 				go recordDeliverTxFailMetrics(method)
 				return app.NewExecTxResult(code.VerifySignatureError, err.Error(), ""), nil
@@ -366,6 +425,16 @@ func (app *ABCIApplication) CheckTx(check *abcitypes.RequestCheckTx) (res *abcit
 
 	app.logger.Infof("CheckTx: %s, NodeID: %s", method, nodeID)
 
+	// params of method with "_pb" suffix are protobuf encoded,
+	// signature is signed over method and params as sent
+	signedMethod, signedParam := method, param
+	method, param, err = decodeTxParams(method, param)
+	if err != nil {
+		res = NewResponseCheckTx(code.UnmarshalError, err.Error())
+		go recordCheckTxFailMetrics(method)
+		return res, nil
+	}
+
 	if method == "" || param == nil || nodeID == "" {
 		res = NewResponseCheckTx(code.InvalidTransactionFormat, "Invalid transaction format")
 		go recordCheckTxFailMetrics(method)
@@ -394,7 +463,7 @@ func (app *ABCIApplication) CheckTx(check *abcitypes.RequestCheckTx) (res *abcit
 			return NewResponseCheckTx(retCode, retLog), nil
 		}
 
-		verifyResult, err := verifySignature(method, param, app.CurrentChain, nonce, signature, publicKey, signingAlgorithm)
+		verifyResult, err := verifySignature(signedMethod, signedParam, app.CurrentChain, nonce, signature, publicKey, signingAlgorithm)
 		if err != nil {
 			go recordCheckTxFailMetrics(method)
 			return NewResponseCheckTx(code.VerifySignatureError, err.Error()), nil
@@ -424,6 +493,8 @@ func (app *ABCIApplication) Commit(commit *abcitypes.RequestCommit) (*abcitypes.
 	dbSaveDuration := time.Since(startTime)
 	go recordDBSaveDurationMetrics(dbSaveDuration)
 
+	app.exportChainHandoff()
+
 	for key := range app.deliverTxNonceState {
 		app.checkTxNonceState.Delete(key)
 	}
@@ -435,6 +506,10 @@ func (app *ABCIApplication) Commit(commit *abcitypes.RequestCommit) (*abcitypes.
 	var retainHeight int64 = 0
 	if app.retainBlockCount > 0 && app.state.CurrentBlockHeight > app.retainBlockCount {
 		retainHeight = app.state.CurrentBlockHeight - app.retainBlockCount
+		err := app.state.PruneHistory(retainHeight)
+		if err != nil {
+			app.logger.Errorf("Commit: prune history: %+v", err)
+		}
 	}
 
 	return &abcitypes.ResponseCommit{
@@ -478,7 +553,23 @@ func (app *ABCIApplication) Query(req *abcitypes.RequestQuery) (res *abcitypes.R
 		return app.NewResponseQuery(nil, "method can't be empty", app.state.Height), nil
 	}
 
-	res = app.QueryRouter(method, param, height)
+	// query at earlier height reads values at the height
+	queryApp := app
+	if height > 0 && height < app.state.Height {
+		if height < app.state.earliestHistoricalHeight() {
+			return app.NewResponseQuery(nil, fmt.Sprintf("state at height %d is not available, earliest height is %d", height, app.state.earliestHistoricalHeight()), app.state.Height), nil
+		}
+		queryApp = app.historicalView(height)
+	}
+
+	switch query.ResponseEncoding {
+	case protoTm.Encoding_JSON:
+		res = queryApp.QueryRouter(method, param, height)
+	case protoTm.Encoding_PROTOBUF:
+		res = queryApp.protobufQuery(method, param, height)
+	default:
+		res = app.NewResponseQuery(nil, "unknown response encoding", app.state.Height)
+	}
 
 	return res, nil
 }
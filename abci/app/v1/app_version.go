@@ -0,0 +1,293 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// AppVersionActivation is ABCI app protocol version activated from block height
+type AppVersionActivation struct {
+	ProtocolVersion      uint64 `json:"protocol_version"`
+	ActivationHeight     int64  `json:"activation_height"`
+	Name                 string `json:"name"`
+	ScheduledBlockHeight int64  `json:"scheduled_block_height"`
+}
+
+// AppProtocolVersionAt returns protocol version active at block height.
+// Protocol version before first activation is baseProtocolVersion.
+func AppProtocolVersionAt(activations []AppVersionActivation, baseProtocolVersion uint64, height int64) uint64 {
+	protocolVersion := baseProtocolVersion
+	for _, activation := range activations {
+		if height < activation.ActivationHeight {
+			break
+		}
+		protocolVersion = activation.ProtocolVersion
+	}
+	return protocolVersion
+}
+
+func (app *ABCIApplication) getAppVersionSchedule(committedState bool) (*data.AppVersionSchedule, error) {
+	value, err := app.state.Get(appVersionScheduleKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var schedule data.AppVersionSchedule
+	if value == nil {
+		return &schedule, nil
+	}
+	err = proto.Unmarshal(value, &schedule)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &schedule, nil
+}
+
+func newAppVersionActivationList(schedule *data.AppVersionSchedule) []AppVersionActivation {
+	activations := make([]AppVersionActivation, 0, len(schedule.Activations))
+	for _, activation := range schedule.Activations {
+		activations = append(activations, AppVersionActivation{
+			ProtocolVersion:      activation.ProtocolVersion,
+			ActivationHeight:     activation.ActivationHeight,
+			Name:                 activation.Name,
+			ScheduledBlockHeight: activation.ScheduledBlockHeight,
+		})
+	}
+	return activations
+}
+
+// GetAppVersionSchedule returns committed app version schedule.
+// Used by ABCI app version dispatcher on start and after each commit.
+func (app *ABCIApplication) GetAppVersionSchedule() ([]AppVersionActivation, error) {
+	schedule, err := app.getAppVersionSchedule(true)
+	if err != nil {
+		return nil, err
+	}
+	return newAppVersionActivationList(schedule), nil
+}
+
+// SetSupportedProtocolVersions sets app protocol versions registered in this binary.
+// Upgrade plan can only switch to one of them.
+func (app *ABCIApplication) SetSupportedProtocolVersions(protocolVersions []uint64) {
+	app.supportedProtocolVersions = protocolVersions
+}
+
+func (app *ABCIApplication) isSupportedProtocolVersion(protocolVersion uint64) bool {
+	if protocolVersion == app.AppProtocolVersion {
+		return true
+	}
+	for _, supportedProtocolVersion := range app.supportedProtocolVersions {
+		if supportedProtocolVersion == protocolVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// LastBlockHeight returns height of last committed block
+func (app *ABCIApplication) LastBlockHeight() int64 {
+	return app.state.Height
+}
+
+// removePendingActivations drops activations which are not active at block height
+func removePendingActivations(schedule *data.AppVersionSchedule, height int64) {
+	activations := make([]*data.AppVersionActivation, 0, len(schedule.Activations))
+	for _, activation := range schedule.Activations {
+		if activation.ActivationHeight <= height {
+			activations = append(activations, activation)
+		}
+	}
+	schedule.Activations = activations
+}
+
+type SetUpgradePlanParam struct {
+	Name             string `json:"name"`
+	ProtocolVersion  uint64 `json:"protocol_version"`
+	ActivationHeight int64  `json:"activation_height"`
+}
+
+func (app *ABCIApplication) validateSetUpgradePlan(funcParam SetUpgradePlanParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.ProtocolVersion == 0 || funcParam.ActivationHeight <= 0 {
+		return &ApplicationError{
+			Code:    code.InvalidUpgradePlan,
+			Message: "Protocol version and activation height must be set",
+		}
+	}
+
+	// plan to version which this binary cannot run would halt the chain at activation height
+	if !app.isSupportedProtocolVersion(funcParam.ProtocolVersion) {
+		return &ApplicationError{
+			Code:    code.InvalidUpgradePlan,
+			Message: "Protocol version is not supported by this binary: " + strconv.FormatUint(funcParam.ProtocolVersion, 10),
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	// dispatcher loads schedule after commit, activation must be at least 2 blocks ahead
+	// so block header app version can be switched in the block before activation
+	if funcParam.ActivationHeight <= app.state.CurrentBlockHeight+1 {
+		return &ApplicationError{
+			Code:    code.InvalidUpgradePlan,
+			Message: "Activation height must be greater than next block height",
+		}
+	}
+
+	schedule, err := app.getAppVersionSchedule(committedState)
+	if err != nil {
+		return err
+	}
+	// plan replaces pending plan
+	removePendingActivations(schedule, app.state.CurrentBlockHeight)
+	currentProtocolVersion := AppProtocolVersionAt(newAppVersionActivationList(schedule), app.AppProtocolVersion, app.state.CurrentBlockHeight)
+	if funcParam.ProtocolVersion <= currentProtocolVersion {
+		return &ApplicationError{
+			Code:    code.InvalidUpgradePlan,
+			Message: "Protocol version must be greater than current protocol version: " + strconv.FormatUint(currentProtocolVersion, 10),
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) setUpgradePlanCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam SetUpgradePlanParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateSetUpgradePlan(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// setUpgradePlan schedules ABCI app protocol version switch at activation height.
+// Protocol version must be registered in the binary run by every node before plan is set.
+func (app *ABCIApplication) setUpgradePlan(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("SetUpgradePlan, Parameter: %s", param)
+	var funcParam SetUpgradePlanParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateSetUpgradePlan(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	schedule, err := app.getAppVersionSchedule(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	removePendingActivations(schedule, app.state.CurrentBlockHeight)
+	schedule.Activations = append(schedule.Activations, &data.AppVersionActivation{
+		ProtocolVersion:      funcParam.ProtocolVersion,
+		ActivationHeight:     funcParam.ActivationHeight,
+		Name:                 funcParam.Name,
+		ScheduledBlockHeight: app.state.CurrentBlockHeight,
+	})
+	value, err := utils.ProtoDeterministicMarshal(schedule)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(appVersionScheduleKeyBytes, value)
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "protocol_version"
+	attribute.Value = strconv.FormatUint(funcParam.ProtocolVersion, 10)
+	attributes = append(attributes, attribute)
+	attribute.Key = "activation_height"
+	attribute.Value = strconv.FormatInt(funcParam.ActivationHeight, 10)
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type GetUpgradePlanResult struct {
+	CurrentProtocolVersion uint64                 `json:"current_protocol_version"`
+	ActivationList         []AppVersionActivation `json:"activation_list"`
+}
+
+func (app *ABCIApplication) getUpgradePlan(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetUpgradePlan, Parameter: %s", param)
+	schedule, err := app.getAppVersionSchedule(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	activations := newAppVersionActivationList(schedule)
+	result := GetUpgradePlanResult{
+		CurrentProtocolVersion: AppProtocolVersionAt(activations, app.AppProtocolVersion, app.state.Height),
+		ActivationList:         activations,
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
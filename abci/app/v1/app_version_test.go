@@ -0,0 +1,86 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestAppProtocolVersionAt(t *testing.T) {
+	activations := []AppVersionActivation{
+		{ProtocolVersion: 6, ActivationHeight: 100},
+		{ProtocolVersion: 7, ActivationHeight: 200},
+	}
+	assert.Equal(t, uint64(5), AppProtocolVersionAt(nil, 5, 1000))
+	assert.Equal(t, uint64(5), AppProtocolVersionAt(activations, 5, 99))
+	assert.Equal(t, uint64(6), AppProtocolVersionAt(activations, 5, 100))
+	assert.Equal(t, uint64(6), AppProtocolVersionAt(activations, 5, 199))
+	assert.Equal(t, uint64(7), AppProtocolVersionAt(activations, 5, 200))
+}
+
+func TestRemovePendingActivations(t *testing.T) {
+	schedule := &data.AppVersionSchedule{
+		Activations: []*data.AppVersionActivation{
+			{ProtocolVersion: 6, ActivationHeight: 100},
+			{ProtocolVersion: 7, ActivationHeight: 200},
+		},
+	}
+	removePendingActivations(schedule, 150)
+	assert.Len(t, schedule.Activations, 1)
+	assert.Equal(t, uint64(6), schedule.Activations[0].ProtocolVersion)
+}
+
+func TestSetUpgradePlanProtocolVersion(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	app.AppProtocolVersion = 5
+	app.SetSupportedProtocolVersions([]uint64{5, 6})
+
+	testCases := []struct {
+		name            string
+		protocolVersion uint64
+		expectedCode    uint32
+	}{
+		{"not registered in binary", 7, code.InvalidUpgradePlan},
+		{"current version", 5, code.InvalidUpgradePlan},
+		{"registered newer version", 6, code.OK},
+	}
+	for _, testCase := range testCases {
+		beginTestBlock(app)
+		res := callTestTx(t, app, "SetUpgradePlan", SetUpgradePlanParam{
+			Name:             testCase.name,
+			ProtocolVersion:  testCase.protocolVersion,
+			ActivationHeight: app.state.CurrentBlockHeight + 10,
+		}, testNDIDNodeID)
+		assert.Equal(t, testCase.expectedCode, res.Code, "%s: %s", testCase.name, res.Log)
+		commitTestBlock(app)
+	}
+
+	activations, err := app.GetAppVersionSchedule()
+	assert.NoError(t, err)
+	assert.Len(t, activations, 1)
+	assert.Equal(t, uint64(6), activations[0].ProtocolVersion)
+}
@@ -32,6 +32,17 @@ type IsInitEndedResult struct {
 	InitEnded bool `json:"init_ended"`
 }
 
+type ChainHistory struct {
+	Chains []ChainHistoryDetail `json:"chains"`
+}
+
+type ChainHistoryDetail struct {
+	ChainID           string `json:"chain_id"`
+	LatestBlockHash   string `json:"latest_block_hash"`
+	LatestAppHash     string `json:"latest_app_hash"`
+	LatestBlockHeight string `json:"latest_block_height"`
+}
+
 func (app *ABCIApplication) isInitEnded(param []byte) *abcitypes.ResponseQuery {
 	app.logger.Infof("IsInitEnded, Parameter: %s", param)
 	var result IsInitEndedResult
@@ -52,10 +63,33 @@ func (app *ABCIApplication) isInitEnded(param []byte) *abcitypes.ResponseQuery {
 
 func (app *ABCIApplication) getChainHistory(param []byte) *abcitypes.ResponseQuery {
 	app.logger.Infof("GetChainHistory, Parameter: %s", param)
-	chainHistoryInfoKey := "ChainHistoryInfo"
-	value, err := app.state.Get([]byte(chainHistoryInfoKey), true)
+	value, err := app.state.Get(chainHistoryInfoKeyBytes, true)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
 	return app.NewResponseQuery(value, "success", app.state.Height)
 }
+
+// appendChainHistory records previous chain info into chain history.
+// Existing history that cannot be parsed is replaced.
+func (app *ABCIApplication) appendChainHistory(previousChain ChainHistoryDetail) error {
+	value, err := app.state.Get(chainHistoryInfoKeyBytes, false)
+	if err != nil {
+		return err
+	}
+	var chainHistory ChainHistory
+	if len(value) > 0 {
+		err = json.Unmarshal(value, &chainHistory)
+		if err != nil {
+			app.logger.Warnf("Existing chain history cannot be parsed, replacing: %s", err.Error())
+			chainHistory = ChainHistory{}
+		}
+	}
+	chainHistory.Chains = append(chainHistory.Chains, previousChain)
+	chainHistoryJSON, err := json.Marshal(chainHistory)
+	if err != nil {
+		return err
+	}
+	app.state.Set(chainHistoryInfoKeyBytes, chainHistoryJSON)
+	return nil
+}
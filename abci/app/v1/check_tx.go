@@ -99,6 +99,29 @@ var IsMethod = map[string]bool{
 	"UpdateNamespace":                  true,
 	"SetAllowedMinIalForRegisterIdentityAtFirstIdp":        true,
 	"RevokeAndAddAccessor":                                 true,
+	"RenewAccessor":                                        true,
+	"BulkRegisterIdentityByNDID":                           true,
+	"FreezeReferenceGroup":                                 true,
+	"UnfreezeReferenceGroup":                               true,
+	"TransferIdPAssociations":                              true,
+	"AcceptIdPAssociationTransfer":                         true,
+	"ProcessIdPAssociationTransferBatch":                   true,
+	"CancelIdPAssociationTransfer":                         true,
+	"AddNodeCertificateAuthority":                          true,
+	"RemoveNodeCertificateAuthority":                       true,
+	"DecommissionNode":                                     true,
+	"Heartbeat":                                            true,
+	"SetValidatorPowerCap":                                 true,
+	"GrantAdminPermission":                                 true,
+	"RevokeAdminPermission":                                true,
+	"SetGovernanceConfig":                                  true,
+	"CreateProposal":                                       true,
+	"ApproveProposal":                                      true,
+	"CancelScheduledChange":                                true,
+	"PauseMethod":                                          true,
+	"ResumeMethod":                                         true,
+	"SetUpgradePlan":                                       true,
+	"SetParameter":                                         true,
 	"SetServicePriceCeiling":                               true,
 	"SetServicePriceMinEffectiveDatetimeDelay":             true,
 	"SetServicePrice":                                      true,
@@ -112,7 +135,7 @@ var IsMethod = map[string]bool{
 }
 
 func (app *ABCIApplication) isNDIDNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleNdid {
+	if hasNodeRole(node, appTypes.NodeRoleNdid) {
 		return true
 	}
 
@@ -147,7 +170,7 @@ func (app *ABCIApplication) isNDIDNodeByNodeID(nodeID string, committedState boo
 }
 
 func (app *ABCIApplication) isRPNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleRp {
+	if hasNodeRole(node, appTypes.NodeRoleRp) {
 		return true
 	}
 
@@ -182,7 +205,7 @@ func (app *ABCIApplication) isRPNodeByNodeID(nodeID string, committedState bool)
 }
 
 func (app *ABCIApplication) isIDPNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp && !node.IsIdpAgent {
+	if hasNodeRole(node, appTypes.NodeRoleIdp) && !node.IsIdpAgent {
 		return true
 	}
 
@@ -217,7 +240,7 @@ func (app *ABCIApplication) isIDPNodeByNodeID(nodeID string, committedState bool
 }
 
 func (app *ABCIApplication) isIDPAgentNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp && node.IsIdpAgent {
+	if hasNodeRole(node, appTypes.NodeRoleIdp) && node.IsIdpAgent {
 		return true
 	}
 
@@ -252,7 +275,7 @@ func (app *ABCIApplication) isIDPAgentNodeByNodeID(nodeID string, committedState
 }
 
 func (app *ABCIApplication) isIDPorIDPAgentNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp {
+	if hasNodeRole(node, appTypes.NodeRoleIdp) {
 		return true
 	}
 
@@ -287,7 +310,7 @@ func (app *ABCIApplication) isIDPorIDPAgentNodeByNodeID(nodeID string, committed
 }
 
 func (app *ABCIApplication) isASNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleAs {
+	if hasNodeRole(node, appTypes.NodeRoleAs) {
 		return true
 	}
 
@@ -322,10 +345,10 @@ func (app *ABCIApplication) isASNodeByNodeID(nodeID string, committedState bool)
 }
 
 func (app *ABCIApplication) isIDPorRPNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleRp {
+	if hasNodeRole(node, appTypes.NodeRoleRp) {
 		return true
 	}
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp && !node.IsIdpAgent {
+	if hasNodeRole(node, appTypes.NodeRoleIdp) && !node.IsIdpAgent {
 		return true
 	}
 
@@ -360,7 +383,7 @@ func (app *ABCIApplication) isIDPorRPNodeByNodeID(nodeID string, committedState
 }
 
 func (app *ABCIApplication) isProxyNode(node *data.NodeDetail) bool {
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleProxy {
+	if hasNodeRole(node, appTypes.NodeRoleProxy) {
 		return true
 	}
 
@@ -561,7 +584,9 @@ func (app *ABCIApplication) getSigningPublicKeyFromNodeID(nodeID string, committ
 	return nodeDetail.SigningPublicKey
 }
 
-func checkPubKeyForSigning(key string, algorithm appTypes.SignatureAlgorithm) (err error) {
+// checkPubKeyForSigning validates signing public key. Key can also be a certificate chain
+// issued by NDID trusted CA when certOpts is given.
+func checkPubKeyForSigning(key string, algorithm appTypes.SignatureAlgorithm, certOpts *nodeCertificateVerifyOptions) (err error) {
 	block, _ := pem.Decode([]byte(key))
 	if block == nil {
 		return &ApplicationError{
@@ -570,7 +595,13 @@ func checkPubKeyForSigning(key string, algorithm appTypes.SignatureAlgorithm) (e
 		}
 	}
 	var pub interface{}
-	if strings.Contains(key, "BEGIN RSA PUBLIC KEY") {
+	if block.Type == certificatePEMBlockType {
+		cert, err := verifyCertificateChain(key, x509.KeyUsageDigitalSignature, certOpts)
+		if err != nil {
+			return err
+		}
+		pub = cert.PublicKey
+	} else if strings.Contains(key, "BEGIN RSA PUBLIC KEY") {
 		pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
 	} else {
 		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
@@ -659,7 +690,9 @@ func checkPubKeyForSigning(key string, algorithm appTypes.SignatureAlgorithm) (e
 	return nil
 }
 
-func checkPubKeyForEncryption(key string) (err error) {
+// checkPubKeyForEncryption validates encryption public key. Key can also be a certificate chain
+// issued by NDID trusted CA when certOpts is given.
+func checkPubKeyForEncryption(key string, certOpts *nodeCertificateVerifyOptions) (err error) {
 	block, _ := pem.Decode([]byte(key))
 	if block == nil {
 		return &ApplicationError{
@@ -668,7 +701,13 @@ func checkPubKeyForEncryption(key string) (err error) {
 		}
 	}
 	var pub interface{}
-	if strings.Contains(key, "BEGIN RSA PUBLIC KEY") {
+	if block.Type == certificatePEMBlockType {
+		cert, err := verifyCertificateChain(key, x509.KeyUsageKeyEncipherment, certOpts)
+		if err != nil {
+			return err
+		}
+		pub = cert.PublicKey
+	} else if strings.Contains(key, "BEGIN RSA PUBLIC KEY") {
 		pub, err = x509.ParsePKCS1PublicKey(block.Bytes)
 	} else {
 		pub, err = x509.ParsePKIXPublicKey(block.Bytes)
@@ -703,7 +742,46 @@ func checkPubKeyForEncryption(key string) (err error) {
 	return nil
 }
 
-func checkAccessorPubKey(key string) (err error) {
+// accessorTypeBySignatureAlgorithm returns accessor type (key type) of given
+// signature algorithm. Accessor without algorithm is RSA key.
+func accessorTypeBySignatureAlgorithm(algorithm appTypes.SignatureAlgorithm) string {
+	switch algorithm {
+	case "",
+		appTypes.SignatureAlgorithmRSAPSSSHA256,
+		appTypes.SignatureAlgorithmRSAPSSSHA384,
+		appTypes.SignatureAlgorithmRSAPSSSHA512,
+		appTypes.SignatureAlgorithmRSAPKCS1V15SHA256,
+		appTypes.SignatureAlgorithmRSAPKCS1V15SHA384,
+		appTypes.SignatureAlgorithmRSAPKCS1V15SHA512:
+		return "RSA"
+	case appTypes.SignatureAlgorithmECDSASHA256,
+		appTypes.SignatureAlgorithmECDSASHA384:
+		return "EC"
+	case appTypes.SignatureAlgorithmEd25519:
+		return "Ed25519"
+	default:
+		return ""
+	}
+}
+
+// checkAccessorPubKey validates accessor public key against given signature algorithm
+// using the same key policy as node signing key. Accessor without algorithm
+// (registered before accessor algorithm was introduced) must be RSA key.
+// Accessor type, if given, must start with key type of the algorithm
+// (case-insensitive, e.g. "RSA2048" for RSA algorithms).
+func checkAccessorPubKey(key string, accessorType string, algorithm appTypes.SignatureAlgorithm) (err error) {
+	keyType := accessorTypeBySignatureAlgorithm(algorithm)
+	if accessorType != "" && (keyType == "" || !strings.HasPrefix(strings.ToUpper(accessorType), strings.ToUpper(keyType))) {
+		return &ApplicationError{
+			Code:    code.AccessorTypeDoesNotMatchAlgorithm,
+			Message: "Accessor type does not match accessor algorithm",
+		}
+	}
+
+	if algorithm != "" {
+		return checkPubKeyForSigning(key, algorithm, nil)
+	}
+
 	block, _ := pem.Decode([]byte(key))
 	if block == nil {
 		return &ApplicationError{
@@ -823,6 +901,12 @@ func (app *ABCIApplication) commonValidate(method string, param []byte, nonce []
 		}
 	}
 
+	// ---- Check method does not require multi-signature approval ----
+	err := app.checkMethodNotGoverned(method, committedState)
+	if err != nil {
+		return err
+	}
+
 	// If method is not 'InitNDID' then check node is active
 	if method != "InitNDID" {
 		// Get node detail by NodeID
@@ -892,9 +976,18 @@ func (app *ABCIApplication) commonValidate(method string, param []byte, nonce []
 			}
 		}
 
-		ndidNode := appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleNdid
+		// ---- Check method is not paused for this node ----
+		err = app.checkMethodNotPaused(method, &nodeDetail, nodeID, committedState)
+		if err != nil {
+			return err
+		}
+
+		tokenExempt, err := app.isTokenExemptCaller(method, param, nodeID, committedState)
+		if err != nil {
+			return err
+		}
 		// check if node has enough token to execute a function
-		if !ndidNode {
+		if !tokenExempt {
 			needToken := app.getTokenPriceByFunc(method, committedState)
 			nodeToken, err := app.getToken(nodeID, committedState)
 			if err != nil {
@@ -926,6 +1019,14 @@ func (app *ABCIApplication) CheckTxRouter(method string, param []byte, nonce []b
 		return NewResponseCheckTx(code.UnknownError, err.Error())
 	}
 
+	_, err = getChangeActivation(method, param)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
 	return app.callCheckTx(method, param, nodeID)
 }
 
@@ -1042,6 +1143,52 @@ func (app *ABCIApplication) callCheckTx(name string, param []byte, nodeID string
 		return app.revokeAccessorCheckTx(param, nodeID)
 	case "RevokeAndAddAccessor":
 		return app.revokeAndAddAccessorCheckTx(param, nodeID)
+	case "RenewAccessor":
+		return app.renewAccessorCheckTx(param, nodeID)
+	case "BulkRegisterIdentityByNDID":
+		return app.bulkRegisterIdentityByNDIDCheckTx(param, nodeID)
+	case "FreezeReferenceGroup":
+		return app.freezeReferenceGroupCheckTx(param, nodeID)
+	case "UnfreezeReferenceGroup":
+		return app.unfreezeReferenceGroupCheckTx(param, nodeID)
+	case "TransferIdPAssociations":
+		return app.transferIdPAssociationsCheckTx(param, nodeID)
+	case "AcceptIdPAssociationTransfer":
+		return app.acceptIdPAssociationTransferCheckTx(param, nodeID)
+	case "ProcessIdPAssociationTransferBatch":
+		return app.processIdPAssociationTransferBatchCheckTx(param, nodeID)
+	case "CancelIdPAssociationTransfer":
+		return app.cancelIdPAssociationTransferCheckTx(param, nodeID)
+	case "AddNodeCertificateAuthority":
+		return app.addNodeCertificateAuthorityCheckTx(param, nodeID)
+	case "RemoveNodeCertificateAuthority":
+		return app.removeNodeCertificateAuthorityCheckTx(param, nodeID)
+	case "DecommissionNode":
+		return app.decommissionNodeCheckTx(param, nodeID)
+	case "Heartbeat":
+		return app.heartbeatCheckTx(param, nodeID)
+	case "SetValidatorPowerCap":
+		return app.setValidatorPowerCapCheckTx(param, nodeID)
+	case "GrantAdminPermission":
+		return app.grantAdminPermissionCheckTx(param, nodeID)
+	case "RevokeAdminPermission":
+		return app.revokeAdminPermissionCheckTx(param, nodeID)
+	case "SetGovernanceConfig":
+		return app.setGovernanceConfigCheckTx(param, nodeID)
+	case "CreateProposal":
+		return app.createProposalCheckTx(param, nodeID)
+	case "ApproveProposal":
+		return app.approveProposalCheckTx(param, nodeID)
+	case "CancelScheduledChange":
+		return app.cancelScheduledChangeCheckTx(param, nodeID)
+	case "PauseMethod":
+		return app.pauseMethodCheckTx(param, nodeID)
+	case "ResumeMethod":
+		return app.resumeMethodCheckTx(param, nodeID)
+	case "SetUpgradePlan":
+		return app.setUpgradePlanCheckTx(param, nodeID)
+	case "SetParameter":
+		return app.setParameterCheckTx(param, nodeID)
 	case "UpdateIdentityModeList":
 		return app.updateIdentityModeListCheckTx(param, nodeID)
 
@@ -0,0 +1,95 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func publicKeyToPEM(t *testing.T, publicKey interface{}) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatalf("error marshal public key: %+v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func assertApplicationErrorCode(t *testing.T, expectedCode uint32, err error) {
+	appErr, ok := err.(*ApplicationError)
+	if !ok {
+		t.Fatalf("expected application error, got: %+v", err)
+	}
+	assert.Equal(t, expectedCode, appErr.Code)
+}
+
+func TestCheckAccessorPubKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generate key: %+v", err)
+	}
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generate key: %+v", err)
+	}
+	ed25519PublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generate key: %+v", err)
+	}
+
+	rsaPEM := publicKeyToPEM(t, &rsaKey.PublicKey)
+	p256PEM := publicKeyToPEM(t, &p256Key.PublicKey)
+	ed25519PEM := publicKeyToPEM(t, ed25519PublicKey)
+
+	// without algorithm (legacy), only RSA key is accepted
+	assert.Nil(t, checkAccessorPubKey(rsaPEM, "RSA", ""))
+	assertApplicationErrorCode(t, code.UnsupportedKeyType, checkAccessorPubKey(p256PEM, "", ""))
+	assertApplicationErrorCode(t, code.UnsupportedKeyType, checkAccessorPubKey(ed25519PEM, "", ""))
+
+	assert.Nil(t, checkAccessorPubKey(rsaPEM, "RSA2048", appTypes.SignatureAlgorithmRSAPKCS1V15SHA256))
+	assert.Nil(t, checkAccessorPubKey(p256PEM, "ECDSA", appTypes.SignatureAlgorithmECDSASHA256))
+	assert.Nil(t, checkAccessorPubKey(p256PEM, "EC", appTypes.SignatureAlgorithmECDSASHA256))
+	assert.Nil(t, checkAccessorPubKey(ed25519PEM, "ed25519", appTypes.SignatureAlgorithmEd25519))
+
+	// curve must match algorithm
+	assertApplicationErrorCode(t, code.UnsupportedSigningAlgorithm, checkAccessorPubKey(p256PEM, "", appTypes.SignatureAlgorithmECDSASHA384))
+	assertApplicationErrorCode(t, code.IncompatibleKeyAlgorithm, checkAccessorPubKey(rsaPEM, "", appTypes.SignatureAlgorithmEd25519))
+	assertApplicationErrorCode(t, code.IncompatibleKeyAlgorithm, checkAccessorPubKey(ed25519PEM, "", "UNKNOWN"))
+
+	// accessor type must match algorithm
+	assertApplicationErrorCode(t, code.AccessorTypeDoesNotMatchAlgorithm, checkAccessorPubKey(rsaPEM, "ECDSA", ""))
+	assertApplicationErrorCode(t, code.AccessorTypeDoesNotMatchAlgorithm, checkAccessorPubKey(rsaPEM, "RSA", "UNKNOWN"))
+	assertApplicationErrorCode(t, code.AccessorTypeDoesNotMatchAlgorithm, checkAccessorPubKey(p256PEM, "RSA", appTypes.SignatureAlgorithmECDSASHA256))
+	assertApplicationErrorCode(t, code.AccessorTypeDoesNotMatchAlgorithm, checkAccessorPubKey(ed25519PEM, "RSA", appTypes.SignatureAlgorithmEd25519))
+}
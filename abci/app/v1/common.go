@@ -33,14 +33,19 @@ var modeFunctionMap = map[string]bool{
 }
 
 var (
-	masterNDIDKeyBytes                            = []byte("MasterNDID")
-	initStateKeyBytes                             = []byte("InitState")
-	lastBlockKeyBytes                             = []byte("lastBlock")
-	idpListKeyBytes                               = []byte("IdPList")
-	allNamespaceKeyBytes                          = []byte("AllNamespace")
-	servicePriceMinEffectiveDatetimeDelayKeyBytes = []byte("ServicePriceMinEffectiveDatetimeDelay")
-	supportedIALListKeyBytes                      = []byte("SupportedIALList")
-	supportedAALListKeyBytes                      = []byte("SupportedAALList")
+	masterNDIDKeyBytes                   = []byte("MasterNDID")
+	initStateKeyBytes                    = []byte("InitState")
+	lastBlockKeyBytes                    = []byte("lastBlock")
+	idpListKeyBytes                      = []byte("IdPList")
+	allNamespaceKeyBytes                 = []byte("AllNamespace")
+	nodeCertificateAuthorityListKeyBytes = []byte("NodeCertificateAuthorityList")
+	validatorPowerCapKeyBytes            = []byte("ValidatorPowerCap")
+	governanceConfigKeyBytes             = []byte("GovernanceConfig")
+	scheduledChangeListKeyBytes          = []byte("ScheduledChangeList")
+	pausedMethodListKeyBytes             = []byte("PausedMethodList")
+	appVersionScheduleKeyBytes           = []byte("AppVersionSchedule")
+	stateSchemaVersionKeyBytes           = []byte("StateSchemaVersion")
+	chainHistoryInfoKeyBytes             = []byte("ChainHistoryInfo")
 )
 
 const (
@@ -71,4 +76,14 @@ const (
 	suppressedIdentityModificationNotificationNodePrefix = "SuppressedIdentityModificationNotificationNode"
 	nodeSupportedFeatureKeyPrefix                        = "NodeSupportedFeature"
 	validatorKeyPrefix                                   = "Validator"
+	idpAssociationTransferKeyPrefix                      = "IdPAssociationTransfer"
+	decommissionedNodeKeyPrefix                          = "DecommissionedNode"
+	nodeHeartbeatKeyPrefix                               = "NodeHeartbeat"
+	adminPermissionKeyPrefix                             = "AdminPermission"
+	proposalKeyPrefix                                    = "Proposal"
+	historyKeyPrefix                                     = "History"
+	historyIndexKeyPrefix                                = "HistoryIndex"
+	blockTimeKeyPrefix                                   = "BlockTime"
+	idpRefGroupCodeKeyPrefix                             = "IdPRefGroupCode"
+	ownedOpenRequestKeyPrefix                            = "OwnedOpenRequest"
 )
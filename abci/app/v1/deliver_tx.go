@@ -94,16 +94,28 @@ func (app *ABCIApplication) DeliverTxRouter(method string, param []byte, nonce [
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	result := app.callDeliverTx(method, param, nodeID)
+	activation, err := getChangeActivation(method, param)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	var result *abcitypes.ExecTxResult
+	if activation != nil {
+		result = app.scheduleChange(method, param, nodeID, activation)
+	} else {
+		result = app.callDeliverTx(method, param, nodeID)
+	}
 	// ---- Burn token ----
-	ndidNode, err := app.isNDIDNodeByNodeID(nodeID, false)
+	tokenExempt, err := app.isTokenExemptCaller(method, param, nodeID, false)
 	if err != nil {
 		if appErr, ok := err.(*ApplicationError); ok {
 			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
 		}
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
-	if !ndidNode && !regulatorMethod[method] {
+	if !tokenExempt {
 		needToken := app.getTokenPriceByFunc(method, false)
 		err := app.reduceToken(nodeID, needToken)
 		if err != nil {
@@ -239,6 +251,52 @@ func (app *ABCIApplication) callDeliverTx(name string, param []byte, nodeID stri
 		return app.revokeAccessor(param, nodeID)
 	case "RevokeAndAddAccessor":
 		return app.revokeAndAddAccessor(param, nodeID)
+	case "RenewAccessor":
+		return app.renewAccessor(param, nodeID)
+	case "BulkRegisterIdentityByNDID":
+		return app.bulkRegisterIdentityByNDID(param, nodeID)
+	case "FreezeReferenceGroup":
+		return app.freezeReferenceGroup(param, nodeID)
+	case "UnfreezeReferenceGroup":
+		return app.unfreezeReferenceGroup(param, nodeID)
+	case "TransferIdPAssociations":
+		return app.transferIdPAssociations(param, nodeID)
+	case "AcceptIdPAssociationTransfer":
+		return app.acceptIdPAssociationTransfer(param, nodeID)
+	case "ProcessIdPAssociationTransferBatch":
+		return app.processIdPAssociationTransferBatch(param, nodeID)
+	case "CancelIdPAssociationTransfer":
+		return app.cancelIdPAssociationTransfer(param, nodeID)
+	case "AddNodeCertificateAuthority":
+		return app.addNodeCertificateAuthority(param, nodeID)
+	case "RemoveNodeCertificateAuthority":
+		return app.removeNodeCertificateAuthority(param, nodeID)
+	case "DecommissionNode":
+		return app.decommissionNode(param, nodeID)
+	case "Heartbeat":
+		return app.heartbeat(param, nodeID)
+	case "SetValidatorPowerCap":
+		return app.setValidatorPowerCap(param, nodeID)
+	case "GrantAdminPermission":
+		return app.grantAdminPermission(param, nodeID)
+	case "RevokeAdminPermission":
+		return app.revokeAdminPermission(param, nodeID)
+	case "SetGovernanceConfig":
+		return app.setGovernanceConfig(param, nodeID)
+	case "CreateProposal":
+		return app.createProposal(param, nodeID)
+	case "ApproveProposal":
+		return app.approveProposal(param, nodeID)
+	case "CancelScheduledChange":
+		return app.cancelScheduledChange(param, nodeID)
+	case "PauseMethod":
+		return app.pauseMethod(param, nodeID)
+	case "ResumeMethod":
+		return app.resumeMethod(param, nodeID)
+	case "SetUpgradePlan":
+		return app.setUpgradePlan(param, nodeID)
+	case "SetParameter":
+		return app.setParameter(param, nodeID)
 	case "UpdateIdentityModeList":
 		return app.updateIdentityModeList(param, nodeID)
 
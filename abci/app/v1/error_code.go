@@ -33,6 +33,7 @@ import (
 )
 
 type GetErrorCodeListParam struct {
+	PaginationParam
 	Type string `json:"type"`
 }
 
@@ -50,28 +51,41 @@ func (app *ABCIApplication) getErrorCodeList(param []byte) *abcitypes.ResponseQu
 
 	// convert funcParam to lowercase and fetch the code list
 	funcParam.Type = strings.ToLower(funcParam.Type)
-	errorCodeListKey := errorCodeListKeyPrefix + keySeparator + funcParam.Type
-	errorCodeListBytes, err := app.state.Get([]byte(errorCodeListKey), false)
+	result := make([]*GetErrorCodeListResult, 0)
+	lastID, hasMore, err := app.iterateCommittedKeyPrefix(
+		errorCodeKeyPrefix+keySeparator+funcParam.Type+keySeparator,
+		funcParam.PaginationParam,
+		func(id string, value []byte) (func(), error) {
+			var errorCode data.ErrorCode
+			err := proto.Unmarshal(value, &errorCode)
+			if err != nil {
+				return nil, err
+			}
+			return func() {
+				result = append(result, &GetErrorCodeListResult{
+					ErrorCode:   errorCode.ErrorCode,
+					Description: errorCode.Description,
+				})
+			}, nil
+		},
+	)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
 
-	var errorCodeList data.ErrorCodeList
-	err = proto.Unmarshal(errorCodeListBytes, &errorCodeList)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-
-	// parse result into response format
-	result := make([]*GetErrorCodeListResult, 0, len(errorCodeList.ErrorCode))
-	for _, errorCode := range errorCodeList.ErrorCode {
-		result = append(result, &GetErrorCodeListResult{
-			ErrorCode:   errorCode.ErrorCode,
-			Description: errorCode.Description,
+	var returnValue []byte
+	if funcParam.isPaginated() {
+		var nextCursor string
+		if hasMore {
+			nextCursor = lastID
+		}
+		returnValue, err = json.Marshal(PaginatedListResult{
+			Items:      result,
+			NextCursor: nextCursor,
 		})
+	} else {
+		returnValue, err = json.Marshal(result)
 	}
-
-	returnValue, err := json.Marshal(result)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
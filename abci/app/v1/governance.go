@@ -0,0 +1,749 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// governedMethod lists NDID methods which must be submitted as proposals
+// when multi-signature approval is enabled
+var governedMethod = map[string]bool{
+	"SetNodeToken":        true,
+	"AddNodeToken":        true,
+	"ReduceNodeToken":     true,
+	"SetValidator":        true,
+	"SetLastBlock":        true,
+	"UpdateNodeByNDID":    true,
+	"SetGovernanceConfig": true,
+	"SetUpgradePlan":      true,
+}
+
+func (app *ABCIApplication) getGovernanceConfig(committedState bool) (*data.GovernanceConfig, error) {
+	value, err := app.state.Get(governanceConfigKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var config data.GovernanceConfig
+	if value == nil {
+		return &config, nil
+	}
+	err = proto.Unmarshal(value, &config)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &config, nil
+}
+
+func isGovernanceMember(config *data.GovernanceConfig, nodeID string) bool {
+	for _, memberNodeID := range config.MemberNodeIds {
+		if memberNodeID == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+// countProposalApprovals counts approvals from current governance members
+func countProposalApprovals(proposal *data.Proposal, config *data.GovernanceConfig) int32 {
+	var count int32
+	for _, approverNodeID := range proposal.ApproverNodeIds {
+		if isGovernanceMember(config, approverNodeID) {
+			count++
+		}
+	}
+	return count
+}
+
+// checkMethodNotGoverned rejects direct call to governed method when multi-signature approval is enabled
+func (app *ABCIApplication) checkMethodNotGoverned(method string, committedState bool) error {
+	if !governedMethod[method] {
+		return nil
+	}
+	config, err := app.getGovernanceConfig(committedState)
+	if err != nil {
+		return err
+	}
+	if config.Threshold > 0 {
+		return &ApplicationError{
+			Code:    code.MethodRequiresProposal,
+			Message: "This method must be submitted as a proposal",
+		}
+	}
+	return nil
+}
+
+func (app *ABCIApplication) getProposal(proposalID string, committedState bool) (*data.Proposal, error) {
+	key := proposalKeyPrefix + keySeparator + proposalID
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if value == nil {
+		return nil, &ApplicationError{
+			Code:    code.ProposalNotFound,
+			Message: "Proposal not found",
+		}
+	}
+	var proposal data.Proposal
+	err = proto.Unmarshal(value, &proposal)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &proposal, nil
+}
+
+func (app *ABCIApplication) setProposal(proposal *data.Proposal) error {
+	value, err := utils.ProtoDeterministicMarshal(proposal)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
+	}
+	key := proposalKeyPrefix + keySeparator + proposal.ProposalId
+	app.state.Set([]byte(key), value)
+	return nil
+}
+
+// queueProposalIfApproved schedules proposal for execution at the end of current block
+// once it has enough approvals
+func (app *ABCIApplication) queueProposalIfApproved(proposal *data.Proposal, config *data.GovernanceConfig) {
+	if countProposalApprovals(proposal, config) >= config.Threshold {
+		app.approvedProposals = append(app.approvedProposals, proposal.ProposalId)
+	}
+}
+
+func (app *ABCIApplication) newProposalExecTxResult(proposal *data.Proposal) *abcitypes.ExecTxResult {
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "proposal_id"
+	attribute.Value = proposal.ProposalId
+	attributes = append(attributes, attribute)
+	attribute.Key = "method"
+	attribute.Value = proposal.Method
+	attributes = append(attributes, attribute)
+	attribute.Key = "approval_count"
+	attribute.Value = strconv.Itoa(len(proposal.ApproverNodeIds))
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+// executeApprovedProposals executes proposals approved in current block.
+// Called in FinalizeBlock after all transactions are executed.
+func (app *ABCIApplication) executeApprovedProposals() []abcitypes.Event {
+	events := make([]abcitypes.Event, 0)
+	if len(app.approvedProposals) == 0 {
+		return events
+	}
+	defer func() {
+		app.approvedProposals = nil
+	}()
+
+	ndidNodeID, err := app.state.Get(masterNDIDKeyBytes, false)
+	if err != nil {
+		app.logger.Errorf("executeApprovedProposals: %+v", err)
+		return events
+	}
+
+	for _, proposalID := range app.approvedProposals {
+		proposal, err := app.getProposal(proposalID, false)
+		if err != nil {
+			app.logger.Errorf("executeApprovedProposals: %+v", err)
+			continue
+		}
+		// may be queued more than once when approved again in the same block
+		if proposal.Status != string(appTypes.ProposalStatusPending) {
+			continue
+		}
+
+		result := app.executeProposal(proposal, string(ndidNodeID))
+		if result.Code == code.OK {
+			proposal.Status = string(appTypes.ProposalStatusExecuted)
+		} else {
+			proposal.Status = string(appTypes.ProposalStatusFailed)
+		}
+		proposal.ExecutedBlockHeight = app.state.CurrentBlockHeight
+		proposal.ResultCode = result.Code
+		proposal.ResultLog = result.Log
+		err = app.setProposal(proposal)
+		if err != nil {
+			app.logger.Errorf("executeApprovedProposals: %+v", err)
+			continue
+		}
+
+		attributes := []abcitypes.EventAttribute{
+			{Key: "proposal_id", Value: proposal.ProposalId},
+			{Key: "method", Value: proposal.Method},
+			{Key: "status", Value: proposal.Status},
+			{Key: "result_code", Value: strconv.FormatUint(uint64(result.Code), 10)},
+		}
+		for _, event := range result.Events {
+			attributes = append(attributes, event.Attributes...)
+		}
+		events = append(events, abcitypes.Event{
+			Type:       "did.proposal",
+			Attributes: attributes,
+		})
+	}
+
+	return events
+}
+
+func (app *ABCIApplication) executeProposal(proposal *data.Proposal, ndidNodeID string) (res *abcitypes.ExecTxResult) {
+	// Recover when panic
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Errorf("Recovered in %s, %s", r, identifyPanic())
+			res = app.NewExecTxResult(code.UnknownError, "Unknown error", "")
+		}
+	}()
+
+	app.logger.Infof("Execute proposal: %s, Method: %s", proposal.ProposalId, proposal.Method)
+	return app.callDeliverTx(proposal.Method, proposal.Params, ndidNodeID)
+}
+
+type SetGovernanceConfigParam struct {
+	MemberNodeIDList []string `json:"member_node_id_list"`
+	Threshold        int32    `json:"threshold"`
+}
+
+func (app *ABCIApplication) validateSetGovernanceConfig(funcParam SetGovernanceConfigParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.Threshold < 0 || int(funcParam.Threshold) > len(funcParam.MemberNodeIDList) {
+		return &ApplicationError{
+			Code:    code.InvalidGovernanceConfig,
+			Message: "Threshold must be between 0 and number of members",
+		}
+	}
+	memberNodeIDs := make(map[string]bool, len(funcParam.MemberNodeIDList))
+	for _, memberNodeID := range funcParam.MemberNodeIDList {
+		if memberNodeIDs[memberNodeID] {
+			return &ApplicationError{
+				Code:    code.InvalidGovernanceConfig,
+				Message: "Duplicate member node ID: " + memberNodeID,
+			}
+		}
+		memberNodeIDs[memberNodeID] = true
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	for _, memberNodeID := range funcParam.MemberNodeIDList {
+		_, err := app.getNodeDetail(memberNodeID, committedState)
+		if err != nil {
+			return err
+		}
+		err = app.checkNodeNotDecommissioned(memberNodeID, committedState)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) setGovernanceConfigCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam SetGovernanceConfigParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateSetGovernanceConfig(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// setGovernanceConfig sets governance members and number of approvals required (M of N).
+// Threshold 0 disables multi-signature approval.
+func (app *ABCIApplication) setGovernanceConfig(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("SetGovernanceConfig, Parameter: %s", param)
+	var funcParam SetGovernanceConfigParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateSetGovernanceConfig(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	config := data.GovernanceConfig{
+		MemberNodeIds: funcParam.MemberNodeIDList,
+		Threshold:     funcParam.Threshold,
+	}
+	value, err := utils.ProtoDeterministicMarshal(&config)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(governanceConfigKeyBytes, value)
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type CreateProposalParam struct {
+	ProposalID        string          `json:"proposal_id"`
+	Method            string          `json:"method"`
+	Params            json.RawMessage `json:"params"`
+	ExpiryBlockHeight int64           `json:"expiry_block_height"`
+}
+
+func (app *ABCIApplication) validateCreateProposal(funcParam CreateProposalParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	config, err := app.getGovernanceConfig(committedState)
+	if err != nil {
+		return err
+	}
+	if config.Threshold == 0 {
+		return &ApplicationError{
+			Code:    code.GovernanceNotEnabled,
+			Message: "Multi-signature approval is not enabled",
+		}
+	}
+	if !isGovernanceMember(config, callerNodeID) {
+		ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &ApplicationError{
+				Code:    code.NotGovernanceMember,
+				Message: "This node is not a governance member",
+			}
+		}
+	}
+
+	// stateless
+
+	if funcParam.ProposalID == "" {
+		return &ApplicationError{
+			Code:    code.ProposalIDCannotBeEmpty,
+			Message: "Proposal ID cannot be empty",
+		}
+	}
+
+	if !governedMethod[funcParam.Method] {
+		return &ApplicationError{
+			Code:    code.MethodCannotBeProposed,
+			Message: "Method cannot be submitted as a proposal: " + funcParam.Method,
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	key := proposalKeyPrefix + keySeparator + funcParam.ProposalID
+	exists, err := app.state.Has([]byte(key), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if exists {
+		return &ApplicationError{
+			Code:    code.ProposalAlreadyExists,
+			Message: "Proposal already exists",
+		}
+	}
+
+	if funcParam.ExpiryBlockHeight <= app.state.CurrentBlockHeight {
+		return &ApplicationError{
+			Code:    code.InvalidProposalExpiryHeight,
+			Message: "Expiry block height must be greater than current block height",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) createProposalCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam CreateProposalParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateCreateProposal(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// createProposal submits governed method call for approval.
+// Proposer's approval is counted when proposer is a governance member.
+func (app *ABCIApplication) createProposal(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("CreateProposal, Parameter: %s", param)
+	var funcParam CreateProposalParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateCreateProposal(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	config, err := app.getGovernanceConfig(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	proposal := data.Proposal{
+		ProposalId:          funcParam.ProposalID,
+		Method:              funcParam.Method,
+		Params:              funcParam.Params,
+		ProposerNodeId:      callerNodeID,
+		ApproverNodeIds:     make([]string, 0),
+		CreationBlockHeight: app.state.CurrentBlockHeight,
+		ExpiryBlockHeight:   funcParam.ExpiryBlockHeight,
+		Status:              string(appTypes.ProposalStatusPending),
+	}
+	if isGovernanceMember(config, callerNodeID) {
+		proposal.ApproverNodeIds = append(proposal.ApproverNodeIds, callerNodeID)
+	}
+	err = app.setProposal(&proposal)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	app.queueProposalIfApproved(&proposal, config)
+
+	return app.newProposalExecTxResult(&proposal)
+}
+
+type ApproveProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+func (app *ABCIApplication) validateApproveProposal(funcParam ApproveProposalParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	config, err := app.getGovernanceConfig(committedState)
+	if err != nil {
+		return err
+	}
+	if !isGovernanceMember(config, callerNodeID) {
+		return &ApplicationError{
+			Code:    code.NotGovernanceMember,
+			Message: "This node is not a governance member",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	proposal, err := app.getProposal(funcParam.ProposalID, committedState)
+	if err != nil {
+		return err
+	}
+	if proposal.Status != string(appTypes.ProposalStatusPending) {
+		return &ApplicationError{
+			Code:    code.ProposalIsNotPending,
+			Message: "Proposal is not pending",
+		}
+	}
+	if app.state.CurrentBlockHeight > proposal.ExpiryBlockHeight {
+		return &ApplicationError{
+			Code:    code.ProposalExpired,
+			Message: "Proposal expired",
+		}
+	}
+	for _, approverNodeID := range proposal.ApproverNodeIds {
+		if approverNodeID == callerNodeID {
+			return &ApplicationError{
+				Code:    code.ProposalAlreadyApproved,
+				Message: "Proposal already approved by this node",
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) approveProposalCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam ApproveProposalParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateApproveProposal(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) approveProposal(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("ApproveProposal, Parameter: %s", param)
+	var funcParam ApproveProposalParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateApproveProposal(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	config, err := app.getGovernanceConfig(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	proposal, err := app.getProposal(funcParam.ProposalID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	proposal.ApproverNodeIds = append(proposal.ApproverNodeIds, callerNodeID)
+	err = app.setProposal(proposal)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	app.queueProposalIfApproved(proposal, config)
+
+	return app.newProposalExecTxResult(proposal)
+}
+
+type GetGovernanceConfigResult struct {
+	MemberNodeIDList []string `json:"member_node_id_list"`
+	Threshold        int32    `json:"threshold"`
+}
+
+func (app *ABCIApplication) getGovernanceConfigQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetGovernanceConfig, Parameter: %s", param)
+	config, err := app.getGovernanceConfig(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	result := GetGovernanceConfigResult{
+		MemberNodeIDList: config.MemberNodeIds,
+		Threshold:        config.Threshold,
+	}
+	if result.MemberNodeIDList == nil {
+		result.MemberNodeIDList = make([]string, 0)
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
+
+type GetProposalParam struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+type ProposalInfo struct {
+	ProposalID          string          `json:"proposal_id"`
+	Method              string          `json:"method"`
+	Params              json.RawMessage `json:"params"`
+	ProposerNodeID      string          `json:"proposer_node_id"`
+	ApproverNodeIDList  []string        `json:"approver_node_id_list"`
+	CreationBlockHeight int64           `json:"creation_block_height"`
+	ExpiryBlockHeight   int64           `json:"expiry_block_height"`
+	Status              string          `json:"status"`
+	ExecutedBlockHeight int64           `json:"executed_block_height"`
+	ResultCode          uint32          `json:"result_code"`
+	ResultLog           string          `json:"result_log"`
+}
+
+// newProposalInfo converts stored proposal for query result.
+// Pending proposal past its expiry height is reported as expired.
+func newProposalInfo(proposal *data.Proposal, height int64) ProposalInfo {
+	status := proposal.Status
+	if status == string(appTypes.ProposalStatusPending) && height > proposal.ExpiryBlockHeight {
+		status = string(appTypes.ProposalStatusExpired)
+	}
+	params := json.RawMessage(proposal.Params)
+	if !json.Valid(params) {
+		params = json.RawMessage("null")
+	}
+	approverNodeIDList := proposal.ApproverNodeIds
+	if approverNodeIDList == nil {
+		approverNodeIDList = make([]string, 0)
+	}
+	return ProposalInfo{
+		ProposalID:          proposal.ProposalId,
+		Method:              proposal.Method,
+		Params:              params,
+		ProposerNodeID:      proposal.ProposerNodeId,
+		ApproverNodeIDList:  approverNodeIDList,
+		CreationBlockHeight: proposal.CreationBlockHeight,
+		ExpiryBlockHeight:   proposal.ExpiryBlockHeight,
+		Status:              status,
+		ExecutedBlockHeight: proposal.ExecutedBlockHeight,
+		ResultCode:          proposal.ResultCode,
+		ResultLog:           proposal.ResultLog,
+	}
+}
+
+func (app *ABCIApplication) getProposalQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetProposal, Parameter: %s", param)
+	var funcParam GetProposalParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	proposal, err := app.getProposal(funcParam.ProposalID, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok && appErr.Code == code.ProposalNotFound {
+			return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
+		}
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	value, err := json.Marshal(newProposalInfo(proposal, app.state.Height))
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
+
+type GetProposalListParam struct {
+	Status string `json:"status"`
+}
+
+type GetProposalListResult struct {
+	ProposalList []ProposalInfo `json:"proposal_list"`
+}
+
+func (app *ABCIApplication) getProposalList(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetProposalList, Parameter: %s", param)
+	var funcParam GetProposalListParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	proposalIDList, err := app.getCommittedKeyList(proposalKeyPrefix + keySeparator)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	result := GetProposalListResult{
+		ProposalList: make([]ProposalInfo, 0),
+	}
+	for _, proposalID := range proposalIDList {
+		proposal, err := app.getProposal(proposalID, true)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+		proposalInfo := newProposalInfo(proposal, app.state.Height)
+		if funcParam.Status != "" && proposalInfo.Status != funcParam.Status {
+			continue
+		}
+		result.ProposalList = append(result.ProposalList, proposalInfo)
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
@@ -0,0 +1,191 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestCountProposalApprovals(t *testing.T) {
+	config := &data.GovernanceConfig{
+		MemberNodeIds: []string{"member1", "member2", "member3"},
+		Threshold:     2,
+	}
+	assert.True(t, isGovernanceMember(config, "member2"))
+	assert.False(t, isGovernanceMember(config, "removed_member"))
+
+	testCases := []struct {
+		name              string
+		approverNodeIDs   []string
+		expectedApprovals int32
+	}{
+		{"no approvals", nil, 0},
+		{"approval by removed member is not counted", []string{"member1", "removed_member"}, 1},
+		{"threshold reached", []string{"member1", "removed_member", "member3"}, 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := &data.Proposal{ApproverNodeIds: tc.approverNodeIDs}
+			assert.Equal(t, tc.expectedApprovals, countProposalApprovals(proposal, config))
+		})
+	}
+}
+
+func TestNewProposalInfo(t *testing.T) {
+	testCases := []struct {
+		name           string
+		status         appTypes.ProposalStatus
+		blockHeight    int64
+		expectedStatus appTypes.ProposalStatus
+	}{
+		{"pending at expiry height", appTypes.ProposalStatusPending, 10, appTypes.ProposalStatusPending},
+		{"pending after expiry height", appTypes.ProposalStatusPending, 11, appTypes.ProposalStatusExpired},
+		{"executed after expiry height", appTypes.ProposalStatusExecuted, 11, appTypes.ProposalStatusExecuted},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := &data.Proposal{
+				ProposalId:        "proposal1",
+				Method:            "SetNodeToken",
+				Params:            []byte(`{"node_id":"rp1","amount":100}`),
+				ExpiryBlockHeight: 10,
+				Status:            string(tc.status),
+			}
+			info := newProposalInfo(proposal, tc.blockHeight)
+			assert.Equal(t, string(tc.expectedStatus), info.Status)
+			assert.Equal(t, []string{}, info.ApproverNodeIDList)
+			assert.Equal(t, json.RawMessage(`{"node_id":"rp1","amount":100}`), info.Params)
+		})
+	}
+}
+
+func TestGovernedMethodNotSchedulable(t *testing.T) {
+	for method := range governedMethod {
+		assert.False(t, schedulableMethod[method], method)
+	}
+}
+
+func TestProposalExecution(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	for _, nodeID := range []string{"member1", "member2", "member3", "rp1"} {
+		registerTestNode(t, app, nodeID, "RP")
+	}
+	deliverTestTx(t, app, "SetGovernanceConfig", SetGovernanceConfigParam{
+		MemberNodeIDList: []string{"member1", "member2", "member3"},
+		Threshold:        2,
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	// token methods cannot be called directly
+	for _, method := range []string{"SetNodeToken", "AddNodeToken", "ReduceNodeToken"} {
+		assertApplicationErrorCode(t, code.MethodRequiresProposal, app.checkMethodNotGoverned(method, true))
+	}
+	assert.NoError(t, app.checkMethodNotGoverned("AddNamespace", true))
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "CreateProposal", CreateProposalParam{
+		ProposalID:        "proposal1",
+		Method:            "AddNodeToken",
+		Params:            json.RawMessage(`{"node_id":"rp1","amount":100}`),
+		ExpiryBlockHeight: app.state.CurrentBlockHeight + 10,
+	}, "member1")
+	deliverTestTx(t, app, "CreateProposal", CreateProposalParam{
+		ProposalID:        "proposal2",
+		Method:            "ReduceNodeToken",
+		Params:            json.RawMessage(`{"node_id":"rp1","amount":1000}`),
+		ExpiryBlockHeight: app.state.CurrentBlockHeight + 10,
+	}, "member1")
+	// below threshold
+	assert.Empty(t, app.executeApprovedProposals())
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "ApproveProposal", ApproveProposalParam{ProposalID: "proposal1"}, "member2")
+	deliverTestTx(t, app, "ApproveProposal", ApproveProposalParam{ProposalID: "proposal2"}, "member3")
+	// approved proposals are executed after all transactions of the block
+	tokenAmount, err := app.getToken("rp1", false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), tokenAmount)
+	executionHeight := app.state.CurrentBlockHeight
+	events := app.executeApprovedProposals()
+	commitTestBlock(app)
+
+	assert.Len(t, events, 2)
+	tokenAmount, err = app.getToken("rp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(100), tokenAmount)
+
+	proposal, err := app.getProposal("proposal1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, string(appTypes.ProposalStatusExecuted), proposal.Status)
+	assert.Equal(t, code.OK, proposal.ResultCode)
+	assert.Equal(t, executionHeight, proposal.ExecutedBlockHeight)
+
+	// method call failed when executed
+	proposal, err = app.getProposal("proposal2", true)
+	assert.NoError(t, err)
+	assert.Equal(t, string(appTypes.ProposalStatusFailed), proposal.Status)
+	assert.NotEqual(t, code.OK, proposal.ResultCode)
+
+	// executed proposal cannot be approved again
+	res := callTestTx(t, app, "ApproveProposal", ApproveProposalParam{ProposalID: "proposal1"}, "member3")
+	assert.NotEqual(t, code.OK, res.Code)
+}
+
+func TestProposalExecutionAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+
+	// governance is not enabled in baseline state
+	assert.NoError(t, app.checkMethodNotGoverned("SetNodeToken", true))
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "SetGovernanceConfig", SetGovernanceConfigParam{
+		MemberNodeIDList: []string{"idp1", "rp1"},
+		Threshold:        2,
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	assertApplicationErrorCode(t, code.MethodRequiresProposal, app.checkMethodNotGoverned("SetNodeToken", true))
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "CreateProposal", CreateProposalParam{
+		ProposalID:        "proposal1",
+		Method:            "SetNodeToken",
+		Params:            json.RawMessage(`{"node_id":"rp1","amount":500}`),
+		ExpiryBlockHeight: app.state.CurrentBlockHeight + 10,
+	}, "rp1")
+	deliverTestTx(t, app, "ApproveProposal", ApproveProposalParam{ProposalID: "proposal1"}, "idp1")
+	assert.Len(t, app.executeApprovedProposals(), 1)
+	commitTestBlock(app)
+
+	tokenAmount, err := app.getToken("rp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(500), tokenAmount)
+}
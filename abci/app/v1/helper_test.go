@@ -0,0 +1,155 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+const testNDIDNodeID = "ndid1"
+
+var (
+	testKeyOnce sync.Once
+	testKeyPEM  string
+)
+
+// testPublicKeyPEM returns RSA public key shared by nodes and accessors in tests
+func testPublicKeyPEM(t *testing.T) string {
+	testKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("error generate key: %+v", err)
+		}
+		testKeyPEM = publicKeyToPEM(t, &key.PublicKey)
+	})
+	return testKeyPEM
+}
+
+func newTestApp(t *testing.T) *ABCIApplication {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	return &ABCIApplication{
+		logger:     logrus.NewEntry(logrus.New()),
+		state:      *appState,
+		valUpdates: make(map[string]abcitypes.ValidatorUpdate),
+	}
+}
+
+// newTestAppWithNDID returns app with NDID node initialized and committed
+func newTestAppWithNDID(t *testing.T) *ABCIApplication {
+	app := newTestApp(t)
+	beginTestBlock(app)
+	initTestNDID(t, app)
+	commitTestBlock(app)
+	return app
+}
+
+// initTestNDID delivers InitNDID and EndInit in current block
+func initTestNDID(t *testing.T, app *ABCIApplication) {
+	publicKey := testPublicKeyPEM(t)
+	deliverTestTx(t, app, "InitNDID", InitNDIDParam{
+		NodeID:                 testNDIDNodeID,
+		SigningPublicKey:       publicKey,
+		SigningAlgorithm:       "RSASSA_PKCS1_V1_5_SHA_256",
+		SigningMasterPublicKey: publicKey,
+		SigningMasterAlgorithm: "RSASSA_PKCS1_V1_5_SHA_256",
+		EncryptionPublicKey:    publicKey,
+		EncryptionAlgorithm:    "RSAES_PKCS1_V1_5",
+	}, testNDIDNodeID)
+	deliverTestTx(t, app, "EndInit", EndInitParam{}, testNDIDNodeID)
+}
+
+// beginTestBlock starts next block like FinalizeBlock
+func beginTestBlock(app *ABCIApplication) {
+	app.state.CurrentBlockHeight = app.state.Height + 1
+}
+
+// commitTestBlock saves state of current block like Commit
+func commitTestBlock(app *ABCIApplication) {
+	app.valUpdates = make(map[string]abcitypes.ValidatorUpdate)
+	app.state.Height = app.state.Height + 1
+	app.state.Save()
+}
+
+func callTestTx(t *testing.T, app *ABCIApplication, method string, param interface{}, nodeID string) *abcitypes.ExecTxResult {
+	paramJSON, err := json.Marshal(param)
+	assert.NoError(t, err)
+	return app.callDeliverTx(method, paramJSON, nodeID)
+}
+
+// deliverTestTx executes transaction and fails test if it is not successful
+func deliverTestTx(t *testing.T, app *ABCIApplication, method string, param interface{}, nodeID string) *abcitypes.ExecTxResult {
+	res := callTestTx(t, app, method, param, nodeID)
+	if res.Code != code.OK {
+		t.Fatalf("%s failed: code %d, %s", method, res.Code, res.Log)
+	}
+	return res
+}
+
+func registerTestNode(t *testing.T, app *ABCIApplication, nodeID string, role string) {
+	publicKey := testPublicKeyPEM(t)
+	deliverTestTx(t, app, "RegisterNode", RegisterNodeParam{
+		NodeID:                 nodeID,
+		SigningPublicKey:       publicKey,
+		SigningAlgorithm:       "RSASSA_PKCS1_V1_5_SHA_256",
+		SigningMasterPublicKey: publicKey,
+		SigningMasterAlgorithm: "RSASSA_PKCS1_V1_5_SHA_256",
+		EncryptionPublicKey:    publicKey,
+		EncryptionAlgorithm:    "RSAES_PKCS1_V1_5",
+		NodeName:               nodeID,
+		Role:                   role,
+		MaxIal:                 3,
+		MaxAal:                 3,
+	}, testNDIDNodeID)
+}
+
+func addTestNamespace(t *testing.T, app *ABCIApplication, namespace string) {
+	deliverTestTx(t, app, "AddNamespace", AddNamespaceParam{
+		Namespace: namespace,
+		Active:    true,
+	}, testNDIDNodeID)
+}
+
+func newTestRegisterIdentityParam(t *testing.T, refGroupCode string, namespace string, identifierHash string, accessorID string) RegisterIdentityParam {
+	return RegisterIdentityParam{
+		ReferenceGroupCode: refGroupCode,
+		NewIdentityList: []Identity{
+			{IdentityNamespace: namespace, IdentityIdentifierHash: identifierHash},
+		},
+		Ial:               3,
+		ModeList:          []int32{2, 3},
+		AccessorID:        accessorID,
+		AccessorPublicKey: testPublicKeyPEM(t),
+		AccessorType:      "RSA",
+	}
+}
@@ -26,11 +26,8 @@ import (
 	"encoding/json"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
-	"github.com/ndidplatform/smart-contract/v9/abci/utils"
-	data "github.com/ndidplatform/smart-contract/v9/protos/data"
 )
 
 type SetSupportedIALListParam struct {
@@ -87,13 +84,17 @@ func (app *ABCIApplication) setSupportedIALList(param []byte, callerNodeID strin
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	var supportedIALList data.SupportedIALList
-	supportedIALList.IalList = funcParam.SupportedIALList
-	supportedIALListByte, err := utils.ProtoDeterministicMarshal(&supportedIALList)
+	supportedIALList := funcParam.SupportedIALList
+	if supportedIALList == nil {
+		supportedIALList = []float64{}
+	}
+	err = app.storeParameter("SupportedIALList", supportedIALList)
 	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
-	app.state.Set(supportedIALListKeyBytes, supportedIALListByte)
 	return app.NewExecTxResult(code.OK, "success", "")
 }
 
@@ -112,29 +113,13 @@ func (app *ABCIApplication) GetSupportedIALList(param []byte, committedState boo
 	// 	return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	// }
 
-	var result GetSupportedIALListResult
-	result.SupportedIALList = make([]float64, 0)
-
-	supportedIALValue, err := app.state.Get(supportedIALListKeyBytes, committedState)
+	value, _, err := app.getParameterValue("SupportedIALList", committedState)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	if supportedIALValue == nil {
-		resultJSON, err := json.Marshal(result)
-		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-		}
 
-		return app.NewResponseQuery(resultJSON, "success", app.state.Height)
-	}
-
-	var supportedIALList data.SupportedIALList
-	err = proto.Unmarshal(supportedIALValue, &supportedIALList)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-
-	result.SupportedIALList = supportedIALList.IalList
+	var result GetSupportedIALListResult
+	result.SupportedIALList = value.([]float64)
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -25,11 +25,13 @@ package app
 import (
 	"encoding/json"
 	"sort"
+	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
@@ -50,12 +52,20 @@ type RegisterIdentityParam struct {
 	AccessorID         string     `json:"accessor_id"`
 	AccessorPublicKey  string     `json:"accessor_public_key"`
 	AccessorType       string     `json:"accessor_type"`
+	AccessorAlgorithm  string     `json:"accessor_algorithm"`
+	AccessorExpiration *time.Time `json:"accessor_expiration"`
 	RequestID          string     `json:"request_id"`
 }
 
 func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityParam, callerNodeID string, committedState bool, checktx bool) error {
+	return app.validateRegisterIdentityAtIdP(funcParam, callerNodeID, committedState, checktx, true)
+}
+
+// validateRegisterIdentityAtIdP validates identity registration at given IdP node.
+// Request check is skipped (checkRequest is false) only for identity import approved by NDID.
+func (app *ABCIApplication) validateRegisterIdentityAtIdP(funcParam RegisterIdentityParam, idpNodeID string, committedState bool, checktx bool, checkRequest bool) error {
 	// permission
-	nodeDetailKey := nodeIDKeyPrefix + keySeparator + callerNodeID
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + idpNodeID
 	nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), committedState)
 	if err != nil {
 		return &ApplicationError{
@@ -135,7 +145,11 @@ func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityP
 		newIdentityNamespaceAndHash[identity.IdentityNamespace+identity.IdentityIdentifierHash] = true
 	}
 
-	err = checkAccessorPubKey(funcParam.AccessorPublicKey)
+	err = checkAccessorPubKey(
+		funcParam.AccessorPublicKey,
+		funcParam.AccessorType,
+		appTypes.SignatureAlgorithm(funcParam.AccessorAlgorithm),
+	)
 	if err != nil {
 		return err
 	}
@@ -154,6 +168,11 @@ func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityP
 		}
 	}
 
+	err = app.validateAccessorExpiration(funcParam.AccessorExpiration, committedState)
+	if err != nil {
+		return err
+	}
+
 	// Valid Mode
 	var validMode = map[int32]bool{}
 	allowedMode := app.GetAllowedModeFromStateDB("RegisterIdentity", committedState)
@@ -201,6 +220,10 @@ func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityP
 				Message: err.Error(),
 			}
 		}
+		err = checkReferenceGroupNotFrozen(&refGroup)
+		if err != nil {
+			return err
+		}
 		// If there's at least one node active
 		for _, idp := range refGroup.Idps {
 			nodeDetailKey := nodeIDKeyPrefix + keySeparator + idp.NodeId
@@ -231,7 +254,7 @@ func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityP
 			}
 		}
 	}
-	if mode3 && minIdp > 0 {
+	if checkRequest && mode3 && minIdp > 0 {
 		err = app.checkRequestUsable(funcParam.RequestID, "RegisterIdentity", minIdp, committedState)
 		if err != nil {
 			return err
@@ -327,7 +350,7 @@ func (app *ABCIApplication) validateRegisterIdentity(funcParam RegisterIdentityP
 			}
 
 			for _, idp := range refGroup.Idps {
-				if idp.NodeId == callerNodeID {
+				if idp.NodeId == idpNodeID {
 					for _, accessor := range idp.Accessors {
 						if accessor.AccessorId == funcParam.AccessorID {
 							if !accessor.Active {
@@ -384,8 +407,26 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	user := funcParam
+	err = app.setRegisterIdentity(funcParam, callerNodeID, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "reference_group_code"
+	attribute.Value = funcParam.ReferenceGroupCode
+	attributes = append(attributes, attribute)
 
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+// setRegisterIdentity writes identity registration of an IdP node to state.
+// Request usage is counted only when useRequest is true.
+func (app *ABCIApplication) setRegisterIdentity(user RegisterIdentityParam, idpNodeID string, useRequest bool) error {
 	// remove duplicates
 	modeMap := make(map[int32]struct{})
 	modeListNoDuplicate := make([]int32, 0)
@@ -402,7 +443,10 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	refGroupKey := refGroupCodeKeyPrefix + keySeparator + user.ReferenceGroupCode
 	refGroupValue, err := app.state.Get([]byte(refGroupKey), false)
 	if err != nil {
-		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
 	}
 
 	var refGroup data.ReferenceGroup
@@ -418,22 +462,34 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	if refGroupValue != nil {
 		err := proto.Unmarshal(refGroupValue, &refGroup)
 		if err != nil {
-			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+			return &ApplicationError{
+				Code:    code.UnmarshalError,
+				Message: err.Error(),
+			}
 		}
 		// If there's at least one node active
 		for _, idp := range refGroup.Idps {
 			nodeDetailKey := nodeIDKeyPrefix + keySeparator + idp.NodeId
 			nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), false)
 			if err != nil {
-				return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+				return &ApplicationError{
+					Code:    code.AppStateError,
+					Message: err.Error(),
+				}
 			}
 			if nodeDetailValue == nil {
-				return app.NewExecTxResult(code.NodeIDNotFound, "Node ID not found", "")
+				return &ApplicationError{
+					Code:    code.NodeIDNotFound,
+					Message: "Node ID not found",
+				}
 			}
 			var nodeDetail data.NodeDetail
 			err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
 			if err != nil {
-				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+				return &ApplicationError{
+					Code:    code.UnmarshalError,
+					Message: err.Error(),
+				}
 			}
 			if nodeDetail.Active && idp.Active {
 				minIdp = 1
@@ -446,12 +502,14 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	accessor.AccessorId = user.AccessorID
 	accessor.AccessorType = user.AccessorType
 	accessor.AccessorPublicKey = user.AccessorPublicKey
+	accessor.AccessorAlgorithm = user.AccessorAlgorithm
 	accessor.Active = true
-	accessor.Owner = callerNodeID
+	accessor.Owner = idpNodeID
 	accessor.CreationBlockHeight = app.state.CurrentBlockHeight
 	accessor.CreationChainId = app.CurrentChain
+	accessor.ExpirationDatetime = accessorExpirationToUnixMilli(user.AccessorExpiration)
 	var idp data.IdPInRefGroup
-	idp.NodeId = callerNodeID
+	idp.NodeId = idpNodeID
 	idp.Mode = append(idp.Mode, user.ModeList...)
 	idp.Accessors = append(idp.Accessors, &accessor)
 	idp.Ial = user.Ial
@@ -470,15 +528,17 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	}
 	foundThisNodeID := false
 	for iIdp, idp := range refGroup.Idps {
-		if idp.NodeId == callerNodeID {
+		if idp.NodeId == idpNodeID {
 			refGroup.Idps[iIdp].Active = true
-			refGroup.Idps[iIdp].Mode = funcParam.ModeList
+			refGroup.Idps[iIdp].Mode = user.ModeList
 			// should accessors be replaced instead?
 			foundAccessorInThisGroup := false
 			for iAcc, accessor := range refGroup.Idps[iIdp].Accessors {
-				if accessor.AccessorId == funcParam.AccessorID {
+				if accessor.AccessorId == user.AccessorID {
 					refGroup.Idps[iIdp].Accessors[iAcc].AccessorType = user.AccessorType
 					refGroup.Idps[iIdp].Accessors[iAcc].AccessorPublicKey = user.AccessorPublicKey
+					refGroup.Idps[iIdp].Accessors[iAcc].AccessorAlgorithm = user.AccessorAlgorithm
+					refGroup.Idps[iIdp].Accessors[iAcc].ExpirationDatetime = accessor.ExpirationDatetime
 					refGroup.Idps[iIdp].Accessors[iAcc].Active = true
 					foundAccessorInThisGroup = true
 				}
@@ -504,13 +564,19 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	}
 	refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
 	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
 	}
 
-	if mode3 && minIdp > 0 {
+	if useRequest && mode3 && minIdp > 0 {
 		increaseRequestUseCountResult := app.increaseRequestUseCount(user.RequestID)
 		if increaseRequestUseCountResult.Code != code.OK {
-			return increaseRequestUseCountResult
+			return &ApplicationError{
+				Code:    increaseRequestUseCountResult.Code,
+				Message: increaseRequestUseCountResult.Log,
+			}
 		}
 	}
 
@@ -524,13 +590,7 @@ func (app *ABCIApplication) registerIdentity(param []byte, callerNodeID string)
 	app.state.Set([]byte(accessorToRefCodeKey), []byte(accessorToRefCodeValue))
 	app.state.Set([]byte(refGroupKey), []byte(refGroupValue))
 
-	var attributes []abcitypes.EventAttribute
-	var attribute abcitypes.EventAttribute
-	attribute.Key = "reference_group_code"
-	attribute.Value = user.ReferenceGroupCode
-	attributes = append(attributes, attribute)
-
-	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+	return app.addIdPRefGroupCodeIndex(idpNodeID, user.ReferenceGroupCode)
 }
 
 type UpdateIdentityParam struct {
@@ -651,6 +711,10 @@ func (app *ABCIApplication) validateUpdateIdentity(funcParam UpdateIdentityParam
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 	nodeIDToUpdateIndex := -1
 	for index, idp := range refGroup.Idps {
 		if idp.NodeId == callerNodeID {
@@ -844,6 +908,10 @@ func (app *ABCIApplication) validateUpdateIdentityModeList(funcParam UpdateIdent
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 	foundThisNodeID := false
 	for _, idp := range refGroup.Idps {
 		if idp.NodeId == callerNodeID {
@@ -1064,6 +1132,10 @@ func (app *ABCIApplication) validateAddIdentity(funcParam AddIdentityParam, call
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 
 	minIdp := 0
 	// If have at least one node active
@@ -1345,6 +1417,10 @@ func (app *ABCIApplication) validateRevokeIdentityAssociation(funcParam RevokeId
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 	foundThisNodeID := false
 	mode3 := false
 	for _, idp := range refGroup.Idps {
@@ -1478,13 +1554,15 @@ func (app *ABCIApplication) revokeIdentityAssociation(param []byte, callerNodeID
 }
 
 type AddAccessorParam struct {
-	ReferenceGroupCode     string `json:"reference_group_code"`
-	IdentityNamespace      string `json:"identity_namespace"`
-	IdentityIdentifierHash string `json:"identity_identifier_hash"`
-	AccessorID             string `json:"accessor_id"`
-	AccessorPublicKey      string `json:"accessor_public_key"`
-	AccessorType           string `json:"accessor_type"`
-	RequestID              string `json:"request_id"`
+	ReferenceGroupCode     string     `json:"reference_group_code"`
+	IdentityNamespace      string     `json:"identity_namespace"`
+	IdentityIdentifierHash string     `json:"identity_identifier_hash"`
+	AccessorID             string     `json:"accessor_id"`
+	AccessorPublicKey      string     `json:"accessor_public_key"`
+	AccessorType           string     `json:"accessor_type"`
+	AccessorAlgorithm      string     `json:"accessor_algorithm"`
+	AccessorExpiration     *time.Time `json:"accessor_expiration"`
+	RequestID              string     `json:"request_id"`
 }
 
 func (app *ABCIApplication) validateAddAccessor(funcParam AddAccessorParam, callerNodeID string, committedState bool, checktx bool) error {
@@ -1509,7 +1587,11 @@ func (app *ABCIApplication) validateAddAccessor(funcParam AddAccessorParam, call
 		}
 	}
 
-	err = checkAccessorPubKey(funcParam.AccessorPublicKey)
+	err = checkAccessorPubKey(
+		funcParam.AccessorPublicKey,
+		funcParam.AccessorType,
+		appTypes.SignatureAlgorithm(funcParam.AccessorAlgorithm),
+	)
 	if err != nil {
 		return err
 	}
@@ -1520,6 +1602,11 @@ func (app *ABCIApplication) validateAddAccessor(funcParam AddAccessorParam, call
 
 	// stateful
 
+	err = app.validateAccessorExpiration(funcParam.AccessorExpiration, committedState)
+	if err != nil {
+		return err
+	}
+
 	// Check duplicate accessor ID
 	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + funcParam.AccessorID
 	refGroupCodeFromDB, err := app.state.Get([]byte(accessorToRefCodeKey), committedState)
@@ -1578,6 +1665,10 @@ func (app *ABCIApplication) validateAddAccessor(funcParam AddAccessorParam, call
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 	foundThisNodeID := false
 	mode3 := false
 	for _, idp := range refGroup.Idps {
@@ -1688,11 +1779,16 @@ func (app *ABCIApplication) addAccessor(param []byte, callerNodeID string) *abci
 	var accessor data.Accessor
 	accessor.AccessorId = funcParam.AccessorID
 	accessor.AccessorType = funcParam.AccessorType
+	if accessor.AccessorType == "" {
+		accessor.AccessorType = accessorTypeBySignatureAlgorithm(appTypes.SignatureAlgorithm(funcParam.AccessorAlgorithm))
+	}
 	accessor.AccessorPublicKey = funcParam.AccessorPublicKey
+	accessor.AccessorAlgorithm = funcParam.AccessorAlgorithm
 	accessor.Active = true
 	accessor.Owner = callerNodeID
 	accessor.CreationBlockHeight = app.state.CurrentBlockHeight
 	accessor.CreationChainId = app.CurrentChain
+	accessor.ExpirationDatetime = accessorExpirationToUnixMilli(funcParam.AccessorExpiration)
 	for _, idp := range refGroup.Idps {
 		if idp.NodeId == callerNodeID {
 			idp.Accessors = append(idp.Accessors, &accessor)
@@ -1806,6 +1902,10 @@ func (app *ABCIApplication) validateRevokeAccessor(funcParam RevokeAccessorParam
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 
 	mode3 := false
 	for _, idp := range refGroup.Idps {
@@ -1955,11 +2055,13 @@ func (app *ABCIApplication) revokeAccessor(param []byte, callerNodeID string) *a
 }
 
 type RevokeAndAddAccessorParam struct {
-	RevokingAccessorID string `json:"revoking_accessor_id"`
-	AccessorID         string `json:"accessor_id"`
-	AccessorPublicKey  string `json:"accessor_public_key"`
-	AccessorType       string `json:"accessor_type"`
-	RequestID          string `json:"request_id"`
+	RevokingAccessorID string     `json:"revoking_accessor_id"`
+	AccessorID         string     `json:"accessor_id"`
+	AccessorPublicKey  string     `json:"accessor_public_key"`
+	AccessorType       string     `json:"accessor_type"`
+	AccessorAlgorithm  string     `json:"accessor_algorithm"`
+	AccessorExpiration *time.Time `json:"accessor_expiration"`
+	RequestID          string     `json:"request_id"`
 }
 
 func (app *ABCIApplication) validateRevokeAndAddAccessor(funcParam RevokeAndAddAccessorParam, callerNodeID string, committedState bool, checktx bool) error {
@@ -1977,7 +2079,11 @@ func (app *ABCIApplication) validateRevokeAndAddAccessor(funcParam RevokeAndAddA
 
 	// stateless
 
-	err = checkAccessorPubKey(funcParam.AccessorPublicKey)
+	err = checkAccessorPubKey(
+		funcParam.AccessorPublicKey,
+		funcParam.AccessorType,
+		appTypes.SignatureAlgorithm(funcParam.AccessorAlgorithm),
+	)
 	if err != nil {
 		return err
 	}
@@ -1988,6 +2094,11 @@ func (app *ABCIApplication) validateRevokeAndAddAccessor(funcParam RevokeAndAddA
 
 	// stateful
 
+	err = app.validateAccessorExpiration(funcParam.AccessorExpiration, committedState)
+	if err != nil {
+		return err
+	}
+
 	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + funcParam.RevokingAccessorID
 	refGroupCode, err := app.state.Get([]byte(accessorToRefCodeKey), committedState)
 	if err != nil {
@@ -2024,6 +2135,10 @@ func (app *ABCIApplication) validateRevokeAndAddAccessor(funcParam RevokeAndAddA
 			Message: err.Error(),
 		}
 	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
 
 	foundThisNodeID := false
 	mode3 := false
@@ -2169,11 +2284,16 @@ func (app *ABCIApplication) revokeAndAddAccessor(param []byte, callerNodeID stri
 	var accessor data.Accessor
 	accessor.AccessorId = funcParam.AccessorID
 	accessor.AccessorType = funcParam.AccessorType
+	if accessor.AccessorType == "" {
+		accessor.AccessorType = accessorTypeBySignatureAlgorithm(appTypes.SignatureAlgorithm(funcParam.AccessorAlgorithm))
+	}
 	accessor.AccessorPublicKey = funcParam.AccessorPublicKey
+	accessor.AccessorAlgorithm = funcParam.AccessorAlgorithm
 	accessor.Active = true
 	accessor.Owner = callerNodeID
 	accessor.CreationBlockHeight = app.state.CurrentBlockHeight
 	accessor.CreationChainId = app.CurrentChain
+	accessor.ExpirationDatetime = accessorExpirationToUnixMilli(funcParam.AccessorExpiration)
 	for _, idp := range refGroup.Idps {
 		if idp.NodeId == callerNodeID {
 			idp.Accessors = append(idp.Accessors, &accessor)
@@ -2205,6 +2325,227 @@ func (app *ABCIApplication) revokeAndAddAccessor(param []byte, callerNodeID stri
 	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
 }
 
+type RenewAccessorParam struct {
+	AccessorID         string     `json:"accessor_id"`
+	AccessorExpiration *time.Time `json:"accessor_expiration"`
+}
+
+func (app *ABCIApplication) validateRenewAccessor(funcParam RenewAccessorParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isIDPNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallIdPMethod,
+			Message: "This node does not have permission to call IdP method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.AccessorID == "" {
+		return &ApplicationError{
+			Code:    code.AccessorIDCannotBeEmpty,
+			Message: "Accessor ID is required",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	err = app.validateAccessorExpiration(funcParam.AccessorExpiration, committedState)
+	if err != nil {
+		return err
+	}
+
+	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + funcParam.AccessorID
+	refGroupCode, err := app.state.Get([]byte(accessorToRefCodeKey), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if refGroupCode == nil {
+		return &ApplicationError{
+			Code:    code.AccessorIDNotFound,
+			Message: "Accessor ID not found",
+		}
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + string(refGroupCode)
+	refGroupValue, err := app.state.Get([]byte(refGroupKey), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if refGroupValue == nil {
+		return &ApplicationError{
+			Code:    code.RefGroupNotFound,
+			Message: "Reference group not found",
+		}
+	}
+	var refGroup data.ReferenceGroup
+	err = proto.Unmarshal(refGroupValue, &refGroup)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	err = checkReferenceGroupNotFrozen(&refGroup)
+	if err != nil {
+		return err
+	}
+
+	for _, idp := range refGroup.Idps {
+		if idp.NodeId != callerNodeID {
+			continue
+		}
+		for _, accessor := range idp.Accessors {
+			if accessor.AccessorId == funcParam.AccessorID {
+				if !accessor.Active {
+					return &ApplicationError{
+						Code:    code.AccessorIsNotActive,
+						Message: "Accessor is not active",
+					}
+				}
+				return nil
+			}
+		}
+	}
+
+	return &ApplicationError{
+		Code:    code.AccessorNotFoundInThisIdP,
+		Message: "Accessor not found in this IdP",
+	}
+}
+
+func (app *ABCIApplication) renewAccessorCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam RenewAccessorParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateRenewAccessor(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) renewAccessor(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("RenewAccessor, Parameter: %s", param)
+	var funcParam RenewAccessorParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateRenewAccessor(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	accessorToRefCodeKey := accessorToRefCodeKeyPrefix + keySeparator + funcParam.AccessorID
+	refGroupCode, err := app.state.Get([]byte(accessorToRefCodeKey), false)
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + string(refGroupCode)
+	refGroupValue, err := app.state.Get([]byte(refGroupKey), false)
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+	var refGroup data.ReferenceGroup
+	err = proto.Unmarshal(refGroupValue, &refGroup)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	for iIdP, idp := range refGroup.Idps {
+		if idp.NodeId == callerNodeID {
+			for iAcc, accessor := range idp.Accessors {
+				if accessor.AccessorId == funcParam.AccessorID {
+					refGroup.Idps[iIdP].Accessors[iAcc].ExpirationDatetime = accessorExpirationToUnixMilli(funcParam.AccessorExpiration)
+					break
+				}
+			}
+			break
+		}
+	}
+
+	refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set([]byte(refGroupKey), []byte(refGroupValue))
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "reference_group_code"
+	attribute.Value = string(refGroupCode)
+	attributes = append(attributes, attribute)
+
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+// validateAccessorExpiration checks that an accessor expiration, if given, is
+// after the block time of the state being validated against. nil means the
+// accessor never expires.
+func (app *ABCIApplication) validateAccessorExpiration(expiration *time.Time, committedState bool) error {
+	if expiration == nil {
+		return nil
+	}
+	if !expiration.After(time.UnixMilli(app.state.GetBlockTime(committedState))) {
+		return &ApplicationError{
+			Code:    code.AccessorExpirationMustBeInTheFuture,
+			Message: "Accessor expiration must be in the future",
+		}
+	}
+	return nil
+}
+
+// isAccessorExpired reports whether an accessor has expired as of the block
+// time persisted with the state being read.
+func (app *ABCIApplication) isAccessorExpired(accessor *data.Accessor, committedState bool) bool {
+	if accessor.ExpirationDatetime == 0 {
+		return false
+	}
+	return app.state.GetBlockTime(committedState) >= accessor.ExpirationDatetime
+}
+
+func checkReferenceGroupNotFrozen(refGroup *data.ReferenceGroup) error {
+	if refGroup.Frozen {
+		return &ApplicationError{
+			Code:    code.ReferenceGroupIsFrozen,
+			Message: "Reference group is frozen",
+		}
+	}
+	return nil
+}
+
+func accessorExpirationToUnixMilli(expiration *time.Time) int64 {
+	if expiration == nil {
+		return 0
+	}
+	return expiration.UnixMilli() // in milliseconds
+}
+
 type CheckExistingIdentityParam struct {
 	ReferenceGroupCode     string `json:"reference_group_code"`
 	IdentityNamespace      string `json:"identity_namespace"`
@@ -2282,12 +2623,14 @@ type GetAccessorKeyParam struct {
 }
 
 type GetAccessorKeyResult struct {
-	AccessorPublicKey   string `json:"accessor_public_key"`
-	AccessorType        string `json:"accessor_type"`
-	Active              bool   `json:"active"`
-	OwnerNodeID         string `json:"owner_node_id"`
-	CreationBlockHeight int64  `json:"creation_block_height"`
-	CreationChainID     string `json:"creation_chain_id"`
+	AccessorPublicKey   string     `json:"accessor_public_key"`
+	AccessorType        string     `json:"accessor_type"`
+	AccessorAlgorithm   string     `json:"accessor_algorithm"`
+	Active              bool       `json:"active"`
+	OwnerNodeID         string     `json:"owner_node_id"`
+	CreationBlockHeight int64      `json:"creation_block_height"`
+	CreationChainID     string     `json:"creation_chain_id"`
+	AccessorExpiration  *time.Time `json:"accessor_expiration"`
 }
 
 func (app *ABCIApplication) getAccessorKey(param []byte) *abcitypes.ResponseQuery {
@@ -2325,10 +2668,16 @@ func (app *ABCIApplication) getAccessorKey(param []byte) *abcitypes.ResponseQuer
 			if accessor.AccessorId == funcParam.AccessorID {
 				result.AccessorPublicKey = accessor.AccessorPublicKey
 				result.AccessorType = accessor.AccessorType
-				result.Active = accessor.Active
+				result.AccessorAlgorithm = accessor.AccessorAlgorithm
+				// expired accessor is reported as inactive
+				result.Active = accessor.Active && !app.isAccessorExpired(accessor, true)
 				result.OwnerNodeID = accessor.Owner
 				result.CreationBlockHeight = accessor.CreationBlockHeight
 				result.CreationChainID = accessor.CreationChainId
+				if accessor.ExpirationDatetime != 0 {
+					expiration := time.UnixMilli(accessor.ExpirationDatetime).UTC()
+					result.AccessorExpiration = &expiration
+				}
 				break
 			}
 		}
@@ -2401,10 +2750,12 @@ type GetIdentityInfoParam struct {
 }
 
 type GetIdentityInfoResult struct {
-	Ial      float64 `json:"ial"`
-	Lial     *bool   `json:"lial"`
-	Laal     *bool   `json:"laal"`
-	ModeList []int32 `json:"mode_list"`
+	Ial              float64 `json:"ial"`
+	Lial             *bool   `json:"lial"`
+	Laal             *bool   `json:"laal"`
+	ModeList         []int32 `json:"mode_list"`
+	Frozen           bool    `json:"frozen"`
+	FreezeReasonCode string  `json:"freeze_reason_code,omitempty"`
 }
 
 func (app *ABCIApplication) getIdentityInfo(param []byte) *abcitypes.ResponseQuery {
@@ -2461,6 +2812,8 @@ func (app *ABCIApplication) getIdentityInfo(param []byte) *abcitypes.ResponseQue
 		}
 		return app.NewResponseQuery(returnValue, "Reference group not found", app.state.Height)
 	}
+	result.Frozen = refGroup.Frozen
+	result.FreezeReasonCode = refGroup.FreezeReasonCode
 	for _, idp := range refGroup.Idps {
 		if funcParam.NodeID == idp.NodeId && idp.Active {
 			result.Ial = idp.Ial
@@ -2750,20 +3103,11 @@ func (app *ABCIApplication) GetAllowedMinIalForRegisterIdentityAtFirstIdp(param
 }
 
 func (app *ABCIApplication) GetAllowedMinIalForRegisterIdentityAtFirstIdpFromStateDB(committedState bool) float64 {
-	allowedMinIalKey := "AllowedMinIalForRegisterIdentityAtFirstIdp"
-	var allowedMinIal data.AllowedMinIalForRegisterIdentityAtFirstIdp
-	allowedMinIalValue, err := app.state.Get([]byte(allowedMinIalKey), committedState)
-	if err != nil {
-		return 0
-	}
-	if allowedMinIalValue == nil {
-		return 0
-	}
-	err = proto.Unmarshal(allowedMinIalValue, &allowedMinIal)
+	value, _, err := app.getParameterValue("AllowedMinIalForRegisterIdentityAtFirstIdp", committedState)
 	if err != nil {
 		return 0
 	}
-	return allowedMinIal.MinIal
+	return value.(float64)
 }
 
 //
@@ -0,0 +1,132 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func TestAccessorExpirationUsesPersistedBlockTime(t *testing.T) {
+	// query result must not depend on time zone of the node
+	local := time.Local
+	time.Local = time.FixedZone("ICT", 7*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1700000000000
+	registerTestNode(t, app, "idp1", "IdP")
+	addTestNamespace(t, app, "citizen_id")
+	expiration := time.UnixMilli(1700000060000)
+	param := newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1")
+	param.AccessorExpiration = &expiration
+	deliverTestTx(t, app, "RegisterIdentity", param, "idp1")
+	commitTestBlock(app)
+	registeredHeight := app.state.Height
+
+	getAccessorKey := func(app *ABCIApplication) GetAccessorKeyResult {
+		var result GetAccessorKeyResult
+		assert.NoError(t, json.Unmarshal(app.getAccessorKey([]byte(`{"accessor_id":"accessor1"}`)).Value, &result))
+		return result
+	}
+
+	// query reads committed state until the block with a later time is committed
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1700000120000
+	assert.True(t, getAccessorKey(app).Active)
+	commitTestBlock(app)
+
+	// block time is loaded from state after restart
+	appState, err := NewAppState(app.state.db)
+	assert.NoError(t, err)
+	app = &ABCIApplication{
+		logger: app.logger,
+		state:  *appState,
+	}
+
+	result := getAccessorKey(app)
+	assert.False(t, result.Active)
+	if assert.NotNil(t, result.AccessorExpiration) {
+		assert.Equal(t, time.UTC, result.AccessorExpiration.Location())
+		assert.True(t, expiration.Equal(*result.AccessorExpiration))
+	}
+
+	// historical query uses block time at queried height
+	result = getAccessorKey(app.historicalView(registeredHeight))
+	assert.True(t, result.Active)
+
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1700000180000
+	renewParam := RenewAccessorParam{
+		AccessorID:         "accessor1",
+		AccessorExpiration: &expiration,
+	}
+	res := callTestTx(t, app, "RenewAccessor", renewParam, "idp1")
+	assert.Equal(t, code.AccessorExpirationMustBeInTheFuture, res.Code)
+}
+
+func TestAddAccessorType(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp1", "IdP")
+	addTestNamespace(t, app, "citizen_id")
+	param := newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1")
+	param.ModeList = []int32{2}
+	deliverTestTx(t, app, "RegisterIdentity", param, "idp1")
+
+	testCases := []struct {
+		accessorID   string
+		accessorType string
+		code         uint32
+		storedType   string
+	}{
+		{"accessor2", "RSA2048", code.OK, "RSA2048"},
+		// accessor type is derived from algorithm when not given
+		{"accessor3", "", code.OK, "RSA"},
+		{"accessor4", "EC", code.AccessorTypeDoesNotMatchAlgorithm, ""},
+	}
+	for _, testCase := range testCases {
+		res := callTestTx(t, app, "AddAccessor", AddAccessorParam{
+			ReferenceGroupCode: "ref1",
+			AccessorID:         testCase.accessorID,
+			AccessorPublicKey:  testPublicKeyPEM(t),
+			AccessorType:       testCase.accessorType,
+		}, "idp1")
+		assert.Equal(t, testCase.code, res.Code, testCase.accessorID)
+		if testCase.code != code.OK {
+			continue
+		}
+		var storedType string
+		for _, accessor := range getTestReferenceGroup(t, app, "ref1").Idps[0].Accessors {
+			if accessor.AccessorId == testCase.accessorID {
+				storedType = accessor.AccessorType
+			}
+		}
+		assert.Equal(t, testCase.storedType, storedType)
+	}
+}
@@ -0,0 +1,750 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// maxIdPAssociationTransferBatchSize limits number of reference groups examined in one batch
+const maxIdPAssociationTransferBatchSize = 100
+
+func (app *ABCIApplication) getIdPAssociationTransfer(transferID string, committedState bool) (*data.IdPAssociationTransfer, error) {
+	key := idpAssociationTransferKeyPrefix + keySeparator + transferID
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if value == nil {
+		return nil, &ApplicationError{
+			Code:    code.IdPAssociationTransferNotFound,
+			Message: "IdP association transfer not found",
+		}
+	}
+	var transfer data.IdPAssociationTransfer
+	err = proto.Unmarshal(value, &transfer)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &transfer, nil
+}
+
+func (app *ABCIApplication) setIdPAssociationTransfer(transfer *data.IdPAssociationTransfer) error {
+	value, err := utils.ProtoDeterministicMarshal(transfer)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
+	}
+	key := idpAssociationTransferKeyPrefix + keySeparator + transfer.TransferId
+	app.state.Set([]byte(key), value)
+	return nil
+}
+
+func (app *ABCIApplication) newIdPAssociationTransferExecTxResult(transfer *data.IdPAssociationTransfer, refGroupCodeList []string) *abcitypes.ExecTxResult {
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "transfer_id"
+	attribute.Value = transfer.TransferId
+	attributes = append(attributes, attribute)
+	attribute.Key = "status"
+	attribute.Value = transfer.Status
+	attributes = append(attributes, attribute)
+	for _, refGroupCode := range refGroupCodeList {
+		attribute.Key = "reference_group_code"
+		attribute.Value = refGroupCode
+		attributes = append(attributes, attribute)
+	}
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type TransferIdPAssociationsParam struct {
+	TransferID        string `json:"transfer_id"`
+	FromNodeID        string `json:"from_node_id"`
+	ToNodeID          string `json:"to_node_id"`
+	TransferAccessors bool   `json:"transfer_accessors"`
+}
+
+func (app *ABCIApplication) validateTransferIdPAssociations(funcParam TransferIdPAssociationsParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.TransferID == "" {
+		return &ApplicationError{
+			Code:    code.TransferIDCannotBeEmpty,
+			Message: "Transfer ID cannot be empty",
+		}
+	}
+
+	if funcParam.FromNodeID == funcParam.ToNodeID {
+		return &ApplicationError{
+			Code:    code.CannotTransferIdPAssociationsToSameNode,
+			Message: "Cannot transfer IdP associations to the same node",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	key := idpAssociationTransferKeyPrefix + keySeparator + funcParam.TransferID
+	exists, err := app.state.Has([]byte(key), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if exists {
+		return &ApplicationError{
+			Code:    code.IdPAssociationTransferAlreadyExists,
+			Message: "IdP association transfer already exists",
+		}
+	}
+
+	fromNode, err := app.getNodeDetail(funcParam.FromNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !hasNodeRole(fromNode, appTypes.NodeRoleIdp) {
+		return &ApplicationError{
+			Code:    code.NotIdPNode,
+			Message: "not an IdP node",
+		}
+	}
+
+	toNode, err := app.getNodeDetail(funcParam.ToNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !app.isIDPNode(toNode) {
+		return &ApplicationError{
+			Code:    code.NotIdPNode,
+			Message: "not an IdP node",
+		}
+	}
+	if !toNode.Active {
+		return &ApplicationError{
+			Code:    code.NodeIsNotActive,
+			Message: "Node is not active",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) transferIdPAssociationsCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam TransferIdPAssociationsParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateTransferIdPAssociations(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) transferIdPAssociations(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("TransferIdPAssociations, Parameter: %s", param)
+	var funcParam TransferIdPAssociationsParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateTransferIdPAssociations(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	transfer := data.IdPAssociationTransfer{
+		TransferId:          funcParam.TransferID,
+		FromNodeId:          funcParam.FromNodeID,
+		ToNodeId:            funcParam.ToNodeID,
+		TransferAccessors:   funcParam.TransferAccessors,
+		Status:              string(appTypes.IdPAssociationTransferStatusPendingAcceptance),
+		CreationBlockHeight: app.state.CurrentBlockHeight,
+	}
+	err = app.setIdPAssociationTransfer(&transfer)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newIdPAssociationTransferExecTxResult(&transfer, nil)
+}
+
+type IdPAssociationTransferIDParam struct {
+	TransferID string `json:"transfer_id"`
+}
+
+func (app *ABCIApplication) validateAcceptIdPAssociationTransfer(funcParam IdPAssociationTransferIDParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isIDPNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallIdPMethod,
+			Message: "This node does not have permission to call IdP method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.TransferID == "" {
+		return &ApplicationError{
+			Code:    code.TransferIDCannotBeEmpty,
+			Message: "Transfer ID cannot be empty",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, committedState)
+	if err != nil {
+		return err
+	}
+	if transfer.ToNodeId != callerNodeID {
+		return &ApplicationError{
+			Code:    code.NotReceivingIdPOfTransfer,
+			Message: "This node is not the receiving IdP of the transfer",
+		}
+	}
+	if transfer.Status != string(appTypes.IdPAssociationTransferStatusPendingAcceptance) {
+		return &ApplicationError{
+			Code:    code.InvalidIdPAssociationTransferStatus,
+			Message: fmt.Sprintf("Invalid IdP association transfer status: %s", transfer.Status),
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) acceptIdPAssociationTransferCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam IdPAssociationTransferIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateAcceptIdPAssociationTransfer(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) acceptIdPAssociationTransfer(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("AcceptIdPAssociationTransfer, Parameter: %s", param)
+	var funcParam IdPAssociationTransferIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateAcceptIdPAssociationTransfer(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, false)
+	if err == nil {
+		transfer.Status = string(appTypes.IdPAssociationTransferStatusInProgress)
+		transfer.AcceptedBlockHeight = app.state.CurrentBlockHeight
+		err = app.setIdPAssociationTransfer(transfer)
+	}
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newIdPAssociationTransferExecTxResult(transfer, nil)
+}
+
+func (app *ABCIApplication) validateCancelIdPAssociationTransfer(funcParam IdPAssociationTransferIDParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.TransferID == "" {
+		return &ApplicationError{
+			Code:    code.TransferIDCannotBeEmpty,
+			Message: "Transfer ID cannot be empty",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, committedState)
+	if err != nil {
+		return err
+	}
+	if transfer.Status != string(appTypes.IdPAssociationTransferStatusPendingAcceptance) &&
+		transfer.Status != string(appTypes.IdPAssociationTransferStatusInProgress) {
+		return &ApplicationError{
+			Code:    code.InvalidIdPAssociationTransferStatus,
+			Message: fmt.Sprintf("Invalid IdP association transfer status: %s", transfer.Status),
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) cancelIdPAssociationTransferCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam IdPAssociationTransferIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateCancelIdPAssociationTransfer(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// cancelIdPAssociationTransfer stops a transfer. Reference groups already
+// transferred by previous batches are not reverted.
+func (app *ABCIApplication) cancelIdPAssociationTransfer(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("CancelIdPAssociationTransfer, Parameter: %s", param)
+	var funcParam IdPAssociationTransferIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateCancelIdPAssociationTransfer(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, false)
+	if err == nil {
+		transfer.Status = string(appTypes.IdPAssociationTransferStatusCancelled)
+		transfer.CompletedBlockHeight = app.state.CurrentBlockHeight
+		err = app.setIdPAssociationTransfer(transfer)
+	}
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newIdPAssociationTransferExecTxResult(transfer, nil)
+}
+
+type ProcessIdPAssociationTransferBatchParam struct {
+	TransferID string `json:"transfer_id"`
+	BatchSize  int    `json:"batch_size"`
+}
+
+func (app *ABCIApplication) validateProcessIdPAssociationTransferBatch(funcParam ProcessIdPAssociationTransferBatchParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if funcParam.TransferID == "" {
+		return &ApplicationError{
+			Code:    code.TransferIDCannotBeEmpty,
+			Message: "Transfer ID cannot be empty",
+		}
+	}
+
+	if funcParam.BatchSize <= 0 || funcParam.BatchSize > maxIdPAssociationTransferBatchSize {
+		return &ApplicationError{
+			Code:    code.InvalidTransferBatchSize,
+			Message: fmt.Sprintf("Batch size must be between 1 and %d", maxIdPAssociationTransferBatchSize),
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, committedState)
+	if err != nil {
+		return err
+	}
+	if transfer.Status != string(appTypes.IdPAssociationTransferStatusInProgress) {
+		return &ApplicationError{
+			Code:    code.InvalidIdPAssociationTransferStatus,
+			Message: fmt.Sprintf("Invalid IdP association transfer status: %s", transfer.Status),
+		}
+	}
+
+	toNode, err := app.getNodeDetail(transfer.ToNodeId, committedState)
+	if err != nil {
+		return err
+	}
+	if !toNode.Active {
+		return &ApplicationError{
+			Code:    code.NodeIsNotActive,
+			Message: "Node is not active",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) processIdPAssociationTransferBatchCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam ProcessIdPAssociationTransferBatchParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateProcessIdPAssociationTransferBatch(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// processIdPAssociationTransferBatch examines up to batch size reference groups
+// following the last examined reference group code of the transfer (in key order of
+// current state) and moves associations of the departing IdP to the receiving IdP.
+// Transfer is completed when there is no more reference group to examine.
+func (app *ABCIApplication) processIdPAssociationTransferBatch(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("ProcessIdPAssociationTransferBatch, Parameter: %s", param)
+	var funcParam ProcessIdPAssociationTransferBatchParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateProcessIdPAssociationTransferBatch(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	toNode, err := app.getNodeDetail(transfer.ToNodeId, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	refGroupKeyIteratorBasePrefix := refGroupCodeKeyPrefix + keySeparator
+	var start []byte
+	if transfer.LastReferenceGroupCode != "" {
+		// start right after last examined reference group code
+		start = []byte(refGroupKeyIteratorBasePrefix + transfer.LastReferenceGroupCode + "\x00")
+	}
+	// look ahead one more reference group to know whether transfer is done
+	refGroupCodeList := make([]string, 0, funcParam.BatchSize+1)
+	err = app.state.IterateKeyPrefix(
+		[]byte(refGroupKeyIteratorBasePrefix),
+		start,
+		false,
+		func(key []byte, value []byte) (bool, error) {
+			refGroupCodeList = append(refGroupCodeList, string(key[len(refGroupKeyIteratorBasePrefix):]))
+			return len(refGroupCodeList) <= funcParam.BatchSize, nil
+		},
+	)
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+	done := len(refGroupCodeList) <= funcParam.BatchSize
+	if !done {
+		refGroupCodeList = refGroupCodeList[:funcParam.BatchSize]
+	}
+
+	transferredRefGroupCodeList := make([]string, 0)
+	for _, refGroupCode := range refGroupCodeList {
+		refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+		refGroupValue, err := app.state.Get([]byte(refGroupKey), false)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		if refGroupValue == nil {
+			continue
+		}
+		var refGroup data.ReferenceGroup
+		err = proto.Unmarshal(refGroupValue, &refGroup)
+		if err != nil {
+			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+		}
+
+		associated, transferred := transferIdPAssociation(&refGroup, transfer, toNode.MaxIal)
+		if !associated {
+			continue
+		}
+		if !transferred {
+			transfer.SkippedCount++
+			continue
+		}
+
+		refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		app.state.Set([]byte(refGroupKey), refGroupValue)
+		err = app.addIdPRefGroupCodeIndex(transfer.ToNodeId, refGroupCode)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+		}
+		transfer.TransferredCount++
+		transferredRefGroupCodeList = append(transferredRefGroupCodeList, refGroupCode)
+	}
+
+	if len(refGroupCodeList) > 0 {
+		transfer.LastReferenceGroupCode = refGroupCodeList[len(refGroupCodeList)-1]
+	}
+	if done {
+		transfer.Status = string(appTypes.IdPAssociationTransferStatusCompleted)
+		transfer.CompletedBlockHeight = app.state.CurrentBlockHeight
+	}
+	err = app.setIdPAssociationTransfer(transfer)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newIdPAssociationTransferExecTxResult(transfer, transferredRefGroupCodeList)
+}
+
+// transferIdPAssociation moves association of transfer's departing IdP in reference group
+// to the receiving IdP. IAL is capped at max IAL of the receiving IdP.
+// Returns whether the departing IdP is actively associated with the reference group
+// and whether the association is transferred (frozen reference group is skipped).
+func transferIdPAssociation(refGroup *data.ReferenceGroup, transfer *data.IdPAssociationTransfer, toNodeMaxIal float64) (associated bool, transferred bool) {
+	var fromIdp *data.IdPInRefGroup
+	var toIdp *data.IdPInRefGroup
+	for _, idp := range refGroup.Idps {
+		if idp.NodeId == transfer.FromNodeId && idp.Active {
+			fromIdp = idp
+		}
+		if idp.NodeId == transfer.ToNodeId {
+			toIdp = idp
+		}
+	}
+	if fromIdp == nil {
+		return false, false
+	}
+	if refGroup.Frozen {
+		return true, false
+	}
+
+	ial := fromIdp.Ial
+	if ial > toNodeMaxIal {
+		ial = toNodeMaxIal
+	}
+
+	if toIdp == nil {
+		toIdp = &data.IdPInRefGroup{
+			NodeId: transfer.ToNodeId,
+		}
+		refGroup.Idps = append(refGroup.Idps, toIdp)
+	}
+	if !toIdp.Active {
+		toIdp.Active = true
+		toIdp.Mode = append([]int32(nil), fromIdp.Mode...)
+		toIdp.Ial = ial
+		if fromIdp.Lial != nil {
+			toIdp.Lial = &wrapperspb.BoolValue{Value: fromIdp.Lial.Value}
+		}
+		if fromIdp.Laal != nil {
+			toIdp.Laal = &wrapperspb.BoolValue{Value: fromIdp.Laal.Value}
+		}
+	}
+
+	if transfer.TransferAccessors {
+		// active accessors are moved, accessor ID stays unique in reference group
+		remainingAccessors := make([]*data.Accessor, 0, len(fromIdp.Accessors))
+		for _, accessor := range fromIdp.Accessors {
+			if !accessor.Active {
+				remainingAccessors = append(remainingAccessors, accessor)
+				continue
+			}
+			accessor.Owner = transfer.ToNodeId
+			toIdp.Accessors = append(toIdp.Accessors, accessor)
+		}
+		fromIdp.Accessors = remainingAccessors
+	}
+
+	fromIdp.Active = false
+
+	return true, true
+}
+
+type GetIdPAssociationTransferResult struct {
+	TransferID             string `json:"transfer_id"`
+	FromNodeID             string `json:"from_node_id"`
+	ToNodeID               string `json:"to_node_id"`
+	TransferAccessors      bool   `json:"transfer_accessors"`
+	Status                 string `json:"status"`
+	LastReferenceGroupCode string `json:"last_reference_group_code"`
+	TransferredCount       int64  `json:"transferred_count"`
+	SkippedCount           int64  `json:"skipped_count"`
+	CreationBlockHeight    int64  `json:"creation_block_height"`
+	AcceptedBlockHeight    int64  `json:"accepted_block_height"`
+	CompletedBlockHeight   int64  `json:"completed_block_height"`
+}
+
+func (app *ABCIApplication) getIdPAssociationTransferDetail(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetIdPAssociationTransfer, Parameter: %s", param)
+	var funcParam IdPAssociationTransferIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	transfer, err := app.getIdPAssociationTransfer(funcParam.TransferID, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok && appErr.Code == code.IdPAssociationTransferNotFound {
+			return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
+		}
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	result := GetIdPAssociationTransferResult{
+		TransferID:             transfer.TransferId,
+		FromNodeID:             transfer.FromNodeId,
+		ToNodeID:               transfer.ToNodeId,
+		TransferAccessors:      transfer.TransferAccessors,
+		Status:                 transfer.Status,
+		LastReferenceGroupCode: transfer.LastReferenceGroupCode,
+		TransferredCount:       transfer.TransferredCount,
+		SkippedCount:           transfer.SkippedCount,
+		CreationBlockHeight:    transfer.CreationBlockHeight,
+		AcceptedBlockHeight:    transfer.AcceptedBlockHeight,
+		CompletedBlockHeight:   transfer.CompletedBlockHeight,
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(returnValue, "success", app.state.Height)
+}
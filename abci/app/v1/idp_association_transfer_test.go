@@ -0,0 +1,220 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestTransferIdPAssociation(t *testing.T) {
+	newRefGroup := func(frozen bool, idps ...*data.IdPInRefGroup) *data.ReferenceGroup {
+		return &data.ReferenceGroup{Idps: idps, Frozen: frozen}
+	}
+	testCases := []struct {
+		name              string
+		refGroup          *data.ReferenceGroup
+		transferAccessors bool
+		associated        bool
+		transferred       bool
+		expected          *data.ReferenceGroup
+	}{
+		{
+			name: "active accessors are moved",
+			refGroup: newRefGroup(false, &data.IdPInRefGroup{
+				NodeId: "idp1", Mode: []int32{2, 3}, Ial: 3, Active: true,
+				Accessors: []*data.Accessor{
+					{AccessorId: "accessor1", Owner: "idp1", Active: true},
+					{AccessorId: "accessor2", Owner: "idp1", Active: false},
+				},
+			}),
+			transferAccessors: true,
+			associated:        true,
+			transferred:       true,
+			// IAL is capped at max IAL of receiving IdP
+			expected: newRefGroup(false,
+				&data.IdPInRefGroup{
+					NodeId: "idp1", Mode: []int32{2, 3}, Ial: 3, Active: false,
+					Accessors: []*data.Accessor{{AccessorId: "accessor2", Owner: "idp1", Active: false}},
+				},
+				&data.IdPInRefGroup{
+					NodeId: "idp2", Mode: []int32{2, 3}, Ial: 2.3, Active: true,
+					Accessors: []*data.Accessor{{AccessorId: "accessor1", Owner: "idp2", Active: true}},
+				},
+			),
+		},
+		{
+			name: "accessors stay without transfer accessors",
+			refGroup: newRefGroup(false, &data.IdPInRefGroup{
+				NodeId: "idp1", Mode: []int32{2}, Ial: 2, Active: true,
+				Accessors: []*data.Accessor{{AccessorId: "accessor1", Owner: "idp1", Active: true}},
+			}),
+			associated:  true,
+			transferred: true,
+			expected: newRefGroup(false,
+				&data.IdPInRefGroup{
+					NodeId: "idp1", Mode: []int32{2}, Ial: 2, Active: false,
+					Accessors: []*data.Accessor{{AccessorId: "accessor1", Owner: "idp1", Active: true}},
+				},
+				&data.IdPInRefGroup{NodeId: "idp2", Mode: []int32{2}, Ial: 2, Active: true},
+			),
+		},
+		{
+			name: "receiving IdP keeps its active association",
+			refGroup: newRefGroup(false,
+				&data.IdPInRefGroup{NodeId: "idp1", Mode: []int32{2, 3}, Ial: 3, Active: true},
+				&data.IdPInRefGroup{NodeId: "idp2", Mode: []int32{2}, Ial: 1.1, Active: true},
+			),
+			associated:  true,
+			transferred: true,
+			expected: newRefGroup(false,
+				&data.IdPInRefGroup{NodeId: "idp1", Mode: []int32{2, 3}, Ial: 3, Active: false},
+				&data.IdPInRefGroup{NodeId: "idp2", Mode: []int32{2}, Ial: 1.1, Active: true},
+			),
+		},
+		{
+			name:       "frozen reference group is skipped",
+			refGroup:   newRefGroup(true, &data.IdPInRefGroup{NodeId: "idp1", Ial: 3, Active: true}),
+			associated: true,
+			expected:   newRefGroup(true, &data.IdPInRefGroup{NodeId: "idp1", Ial: 3, Active: true}),
+		},
+		{
+			name:     "departing IdP is not associated",
+			refGroup: newRefGroup(false, &data.IdPInRefGroup{NodeId: "idp1", Ial: 3, Active: false}),
+			expected: newRefGroup(false, &data.IdPInRefGroup{NodeId: "idp1", Ial: 3, Active: false}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transfer := &data.IdPAssociationTransfer{
+				FromNodeId:        "idp1",
+				ToNodeId:          "idp2",
+				TransferAccessors: testCase.transferAccessors,
+			}
+			associated, transferred := transferIdPAssociation(testCase.refGroup, transfer, 2.3)
+			assert.Equal(t, testCase.associated, associated)
+			assert.Equal(t, testCase.transferred, transferred)
+			assert.True(t, proto.Equal(testCase.expected, testCase.refGroup), "%v", testCase.refGroup)
+		})
+	}
+}
+
+func getTestReferenceGroup(t *testing.T, app *ABCIApplication, refGroupCode string) *data.ReferenceGroup {
+	value, err := app.state.Get([]byte(refGroupCodeKeyPrefix+keySeparator+refGroupCode), false)
+	assert.NoError(t, err)
+	var refGroup data.ReferenceGroup
+	assert.NoError(t, proto.Unmarshal(value, &refGroup))
+	return &refGroup
+}
+
+// transferTestIdPAssociations runs transfer to completion processing one
+// reference group per batch and returns number of batches
+func transferTestIdPAssociations(t *testing.T, app *ABCIApplication, transfer TransferIdPAssociationsParam) int {
+	deliverTestTx(t, app, "TransferIdPAssociations", transfer, testNDIDNodeID)
+	deliverTestTx(t, app, "AcceptIdPAssociationTransfer", IdPAssociationTransferIDParam{TransferID: transfer.TransferID}, transfer.ToNodeID)
+	for batchCount := 1; ; batchCount++ {
+		deliverTestTx(t, app, "ProcessIdPAssociationTransferBatch", ProcessIdPAssociationTransferBatchParam{
+			TransferID: transfer.TransferID,
+			BatchSize:  1,
+		}, testNDIDNodeID)
+		status, err := app.getIdPAssociationTransfer(transfer.TransferID, false)
+		assert.NoError(t, err)
+		if status.Status != "in_progress" {
+			assert.Equal(t, "completed", status.Status)
+			return batchCount
+		}
+	}
+}
+
+func TestTransferIdPAssociationsInSameBlock(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp1", "IdP")
+	registerTestNode(t, app, "idp2", "IdP")
+	registerTestNode(t, app, "idp3", "IdP")
+	addTestNamespace(t, app, "citizen_id")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1"), "idp1")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	// reference group registered in the same block is in uncommitted state only
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref2", "citizen_id", "hash2", "accessor2"), "idp1")
+	for _, transfer := range []TransferIdPAssociationsParam{
+		{TransferID: "transfer1", FromNodeID: "idp1", ToNodeID: "idp2", TransferAccessors: true},
+		{TransferID: "transfer2", FromNodeID: "idp2", ToNodeID: "idp3", TransferAccessors: true},
+	} {
+		assert.Equal(t, 2, transferTestIdPAssociations(t, app, transfer))
+	}
+	commitTestBlock(app)
+
+	for _, refGroupCode := range []string{"ref1", "ref2"} {
+		refGroup := getTestReferenceGroup(t, app, refGroupCode)
+		assert.Len(t, refGroup.Idps, 3)
+		for _, idp := range refGroup.Idps {
+			assert.Equal(t, idp.NodeId == "idp3", idp.Active, idp.NodeId)
+			if idp.NodeId == "idp3" {
+				assert.Len(t, idp.Accessors, 1)
+			} else {
+				assert.Empty(t, idp.Accessors)
+			}
+		}
+	}
+
+	res := app.getAccessorKey([]byte(`{"accessor_id":"accessor1"}`))
+	var accessor GetAccessorKeyResult
+	assert.NoError(t, json.Unmarshal(res.Value, &accessor))
+	assert.Equal(t, "idp3", accessor.OwnerNodeID)
+	assert.True(t, accessor.Active)
+}
+
+func TestTransferIdPAssociationsAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp2", "IdP")
+	assert.Equal(t, 1, transferTestIdPAssociations(t, app, TransferIdPAssociationsParam{
+		TransferID: "transfer1",
+		FromNodeID: "idp1",
+		ToNodeID:   "idp2",
+	}))
+	commitTestBlock(app)
+
+	// reference group registered before migrations is transferred and
+	// indexed for receiving IdP
+	refGroup := getTestReferenceGroup(t, app, "ref1")
+	assert.Len(t, refGroup.Idps, 2)
+	assert.False(t, refGroup.Idps[0].Active)
+	assert.Equal(t, "idp2", refGroup.Idps[1].NodeId)
+	assert.True(t, refGroup.Idps[1].Active)
+	refGroupCodeList, err := app.getNodeIndexValueList(idpRefGroupCodeKeyPrefix, "idp2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ref1"}, refGroupCodeList)
+}
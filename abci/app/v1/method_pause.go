@@ -0,0 +1,478 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"strings"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// methodGroup maps member methods which can be paused to their group.
+// NDID methods cannot be paused.
+var methodGroup = map[string]appTypes.MethodGroup{
+	"CreateRequest":                appTypes.MethodGroupRequest,
+	"CloseRequest":                 appTypes.MethodGroupRequest,
+	"TimeOutRequest":               appTypes.MethodGroupRequest,
+	"SetDataReceived":              appTypes.MethodGroupRequest,
+	"CreateIdpResponse":            appTypes.MethodGroupRequest,
+	"CreateAsResponse":             appTypes.MethodGroupRequest,
+	"RegisterIdentity":             appTypes.MethodGroupIdentity,
+	"AddIdentity":                  appTypes.MethodGroupIdentity,
+	"AddAccessor":                  appTypes.MethodGroupIdentity,
+	"UpdateIdentity":               appTypes.MethodGroupIdentity,
+	"UpdateIdentityModeList":       appTypes.MethodGroupIdentity,
+	"RevokeAccessor":               appTypes.MethodGroupIdentity,
+	"RevokeAndAddAccessor":         appTypes.MethodGroupIdentity,
+	"RenewAccessor":                appTypes.MethodGroupIdentity,
+	"RevokeIdentityAssociation":    appTypes.MethodGroupIdentity,
+	"RegisterServiceDestination":   appTypes.MethodGroupService,
+	"UpdateServiceDestination":     appTypes.MethodGroupService,
+	"DisableServiceDestination":    appTypes.MethodGroupService,
+	"EnableServiceDestination":     appTypes.MethodGroupService,
+	"SetServicePrice":              appTypes.MethodGroupService,
+	"CreateMessage":                appTypes.MethodGroupMessage,
+	"SetMqAddresses":               appTypes.MethodGroupNode,
+	"UpdateNode":                   appTypes.MethodGroupNode,
+	"Heartbeat":                    appTypes.MethodGroupNode,
+	"AcceptIdPAssociationTransfer": appTypes.MethodGroupNode,
+}
+
+func isMethodGroup(group string) bool {
+	for _, g := range methodGroup {
+		if string(g) == group {
+			return true
+		}
+	}
+	return false
+}
+
+func (app *ABCIApplication) getPausedMethodList(committedState bool) (*data.PausedMethodList, error) {
+	value, err := app.state.Get(pausedMethodListKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var pausedMethodList data.PausedMethodList
+	if value == nil {
+		return &pausedMethodList, nil
+	}
+	err = proto.Unmarshal(value, &pausedMethodList)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &pausedMethodList, nil
+}
+
+func (app *ABCIApplication) setPausedMethodList(pausedMethodList *data.PausedMethodList) error {
+	value, err := utils.ProtoDeterministicMarshal(pausedMethodList)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
+	}
+	app.state.Set(pausedMethodListKeyBytes, value)
+	return nil
+}
+
+// isMethodPausedForNode checks whether pause applies to method called by node.
+// Pause without roles and node IDs applies to every node.
+func isMethodPausedForNode(pausedMethod *data.PausedMethod, method string, node *data.NodeDetail, nodeID string) bool {
+	if pausedMethod.Method != "" {
+		if pausedMethod.Method != method {
+			return false
+		}
+	} else if pausedMethod.MethodGroup != string(methodGroup[method]) {
+		return false
+	}
+
+	if len(pausedMethod.Roles) == 0 && len(pausedMethod.NodeIds) == 0 {
+		return true
+	}
+	for _, pausedNodeID := range pausedMethod.NodeIds {
+		if pausedNodeID == nodeID || (node.ProxyNodeId != "" && pausedNodeID == node.ProxyNodeId) {
+			return true
+		}
+	}
+	for _, role := range pausedMethod.Roles {
+		if hasNodeRole(node, appTypes.NodeRole(role)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMethodNotPaused is called in commonValidate for both CheckTx and DeliverTx
+func (app *ABCIApplication) checkMethodNotPaused(method string, node *data.NodeDetail, nodeID string, committedState bool) error {
+	if _, ok := methodGroup[method]; !ok {
+		return nil
+	}
+	pausedMethodList, err := app.getPausedMethodList(committedState)
+	if err != nil {
+		return err
+	}
+	for _, pausedMethod := range pausedMethodList.PausedMethods {
+		if isMethodPausedForNode(pausedMethod, method, node, nodeID) {
+			return &ApplicationError{
+				Code:    code.MethodIsPaused,
+				Message: "Method is paused by NDID",
+			}
+		}
+	}
+	return nil
+}
+
+func isSamePauseTarget(pausedMethod *data.PausedMethod, method string, group string) bool {
+	return pausedMethod.Method == method && pausedMethod.MethodGroup == group
+}
+
+// parsePauseRoleList normalizes role names (case-insensitive)
+func parsePauseRoleList(roles []string) ([]string, error) {
+	result := make([]string, 0, len(roles))
+	for _, role := range roles {
+		found := false
+		for _, validRole := range []appTypes.NodeRole{
+			appTypes.NodeRoleRp,
+			appTypes.NodeRoleIdp,
+			appTypes.NodeRoleAs,
+			appTypes.NodeRoleProxy,
+		} {
+			if strings.EqualFold(role, string(validRole)) {
+				result = append(result, string(validRole))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &ApplicationError{
+				Code:    code.InvalidNodeRole,
+				Message: "Invalid node role: " + role,
+			}
+		}
+	}
+	return result, nil
+}
+
+func validatePauseTarget(method string, group string) error {
+	if (method == "") == (group == "") {
+		return &ApplicationError{
+			Code:    code.InvalidPauseTarget,
+			Message: "Either method or method group must be set",
+		}
+	}
+	if method != "" {
+		if _, ok := methodGroup[method]; !ok {
+			return &ApplicationError{
+				Code:    code.InvalidPauseTarget,
+				Message: "Method cannot be paused: " + method,
+			}
+		}
+	}
+	if group != "" && !isMethodGroup(group) {
+		return &ApplicationError{
+			Code:    code.InvalidPauseTarget,
+			Message: "Invalid method group: " + group,
+		}
+	}
+	return nil
+}
+
+func (app *ABCIApplication) newPausedMethodExecTxResult(action string, method string, group string) *abcitypes.ExecTxResult {
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "action"
+	attribute.Value = action
+	attributes = append(attributes, attribute)
+	attribute.Key = "method"
+	attribute.Value = method
+	attributes = append(attributes, attribute)
+	attribute.Key = "method_group"
+	attribute.Value = group
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type PauseMethodParam struct {
+	Method      string   `json:"method"`
+	MethodGroup string   `json:"method_group"`
+	Roles       []string `json:"roles"`
+	NodeIDList  []string `json:"node_id_list"`
+	Reason      string   `json:"reason"`
+}
+
+func (app *ABCIApplication) validatePauseMethod(funcParam PauseMethodParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeChain, committedState)
+	if err != nil {
+		return err
+	}
+
+	// stateless
+
+	err = validatePauseTarget(funcParam.Method, funcParam.MethodGroup)
+	if err != nil {
+		return err
+	}
+	_, err = parsePauseRoleList(funcParam.Roles)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) pauseMethodCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam PauseMethodParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validatePauseMethod(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// pauseMethod pauses method or method group. Pausing the same target again replaces its scope.
+func (app *ABCIApplication) pauseMethod(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("PauseMethod, Parameter: %s", param)
+	var funcParam PauseMethodParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validatePauseMethod(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	pausedMethodList, err := app.getPausedMethodList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	roles, _ := parsePauseRoleList(funcParam.Roles)
+	pausedMethod := data.PausedMethod{
+		Method:            funcParam.Method,
+		MethodGroup:       funcParam.MethodGroup,
+		Roles:             roles,
+		NodeIds:           funcParam.NodeIDList,
+		Reason:            funcParam.Reason,
+		PausedBlockHeight: app.state.CurrentBlockHeight,
+		PausedByNodeId:    callerNodeID,
+	}
+	replaced := false
+	for i, existing := range pausedMethodList.PausedMethods {
+		if isSamePauseTarget(existing, funcParam.Method, funcParam.MethodGroup) {
+			pausedMethodList.PausedMethods[i] = &pausedMethod
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		pausedMethodList.PausedMethods = append(pausedMethodList.PausedMethods, &pausedMethod)
+	}
+	err = app.setPausedMethodList(pausedMethodList)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newPausedMethodExecTxResult("pause", funcParam.Method, funcParam.MethodGroup)
+}
+
+type ResumeMethodParam struct {
+	Method      string `json:"method"`
+	MethodGroup string `json:"method_group"`
+}
+
+func (app *ABCIApplication) validateResumeMethod(funcParam ResumeMethodParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeChain, committedState)
+	if err != nil {
+		return err
+	}
+
+	// stateless
+
+	err = validatePauseTarget(funcParam.Method, funcParam.MethodGroup)
+	if err != nil {
+		return err
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	pausedMethodList, err := app.getPausedMethodList(committedState)
+	if err != nil {
+		return err
+	}
+	for _, pausedMethod := range pausedMethodList.PausedMethods {
+		if isSamePauseTarget(pausedMethod, funcParam.Method, funcParam.MethodGroup) {
+			return nil
+		}
+	}
+
+	return &ApplicationError{
+		Code:    code.MethodIsNotPaused,
+		Message: "Method is not paused",
+	}
+}
+
+func (app *ABCIApplication) resumeMethodCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam ResumeMethodParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateResumeMethod(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) resumeMethod(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("ResumeMethod, Parameter: %s", param)
+	var funcParam ResumeMethodParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateResumeMethod(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	pausedMethodList, err := app.getPausedMethodList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	for i, pausedMethod := range pausedMethodList.PausedMethods {
+		if isSamePauseTarget(pausedMethod, funcParam.Method, funcParam.MethodGroup) {
+			pausedMethodList.PausedMethods = append(pausedMethodList.PausedMethods[:i], pausedMethodList.PausedMethods[i+1:]...)
+			break
+		}
+	}
+	err = app.setPausedMethodList(pausedMethodList)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.newPausedMethodExecTxResult("resume", funcParam.Method, funcParam.MethodGroup)
+}
+
+type PausedMethod struct {
+	Method            string   `json:"method"`
+	MethodGroup       string   `json:"method_group"`
+	Roles             []string `json:"roles"`
+	NodeIDList        []string `json:"node_id_list"`
+	Reason            string   `json:"reason"`
+	PausedBlockHeight int64    `json:"paused_block_height"`
+	PausedByNodeID    string   `json:"paused_by_node_id"`
+}
+
+type GetPausedMethodListResult struct {
+	PausedMethodList []PausedMethod `json:"paused_method_list"`
+}
+
+func (app *ABCIApplication) getPausedMethodListQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetPausedMethodList, Parameter: %s", param)
+	pausedMethodList, err := app.getPausedMethodList(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	result := GetPausedMethodListResult{
+		PausedMethodList: make([]PausedMethod, 0, len(pausedMethodList.PausedMethods)),
+	}
+	for _, pausedMethod := range pausedMethodList.PausedMethods {
+		roles := pausedMethod.Roles
+		if roles == nil {
+			roles = make([]string, 0)
+		}
+		nodeIDList := pausedMethod.NodeIds
+		if nodeIDList == nil {
+			nodeIDList = make([]string, 0)
+		}
+		result.PausedMethodList = append(result.PausedMethodList, PausedMethod{
+			Method:            pausedMethod.Method,
+			MethodGroup:       pausedMethod.MethodGroup,
+			Roles:             roles,
+			NodeIDList:        nodeIDList,
+			Reason:            pausedMethod.Reason,
+			PausedBlockHeight: pausedMethod.PausedBlockHeight,
+			PausedByNodeID:    pausedMethod.PausedByNodeId,
+		})
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
@@ -0,0 +1,114 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestIsMethodPausedForNode(t *testing.T) {
+	rpNode := &data.NodeDetail{Role: "RP"}
+	idpNode := &data.NodeDetail{Role: "IdP", ProxyNodeId: "proxy1"}
+
+	testCases := []struct {
+		name           string
+		pausedMethod   *data.PausedMethod
+		method         string
+		node           *data.NodeDetail
+		nodeID         string
+		expectedPaused bool
+	}{
+		{"paused method", &data.PausedMethod{Method: "CreateRequest"}, "CreateRequest", rpNode, "rp1", true},
+		{"other method", &data.PausedMethod{Method: "CreateRequest"}, "CloseRequest", rpNode, "rp1", false},
+		{"method group for role", &data.PausedMethod{MethodGroup: "request", Roles: []string{"IdP"}}, "CreateIdpResponse", idpNode, "idp1", true},
+		{"method group for other role", &data.PausedMethod{MethodGroup: "request", Roles: []string{"IdP"}}, "CreateRequest", rpNode, "rp1", false},
+		{"other method group", &data.PausedMethod{MethodGroup: "request", Roles: []string{"IdP"}}, "RegisterIdentity", idpNode, "idp1", false},
+		{"multiple roles node", &data.PausedMethod{MethodGroup: "request", Roles: []string{"IdP"}}, "CreateIdpResponse", &data.NodeDetail{Role: "RP", Roles: []string{"RP", "IdP"}}, "node1", true},
+		// nodes behind paused proxy node are also paused
+		{"node behind paused proxy", &data.PausedMethod{MethodGroup: "identity", NodeIds: []string{"proxy1"}}, "RegisterIdentity", idpNode, "idp1", true},
+		{"node not behind paused proxy", &data.PausedMethod{MethodGroup: "identity", NodeIds: []string{"proxy1"}}, "RegisterIdentity", &data.NodeDetail{Role: "IdP"}, "idp2", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedPaused, isMethodPausedForNode(tc.pausedMethod, tc.method, tc.node, tc.nodeID))
+		})
+	}
+}
+
+func TestValidatePauseTarget(t *testing.T) {
+	testCases := []struct {
+		name         string
+		method       string
+		methodGroup  string
+		expectedCode uint32
+	}{
+		{"method", "CreateRequest", "", code.OK},
+		{"method group", "", "identity", code.OK},
+		{"no target", "", "", code.InvalidPauseTarget},
+		{"both method and method group", "CreateRequest", "request", code.InvalidPauseTarget},
+		{"method cannot be paused", "SetLastBlock", "", code.InvalidPauseTarget},
+		{"unknown method group", "", "ndid", code.InvalidPauseTarget},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePauseTarget(tc.method, tc.methodGroup)
+			if tc.expectedCode == code.OK {
+				assert.NoError(t, err)
+			} else {
+				assertApplicationErrorCode(t, tc.expectedCode, err)
+			}
+		})
+	}
+}
+
+func TestParsePauseRoleList(t *testing.T) {
+	roles, err := parsePauseRoleList([]string{"rp", "IDP"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"RP", "IdP"}, roles)
+	_, err = parsePauseRoleList([]string{"ndid"})
+	assertApplicationErrorCode(t, code.InvalidNodeRole, err)
+}
+
+func TestMethodPauseAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	rpNode, err := app.getNodeDetail("rp1", true)
+	assert.NoError(t, err)
+	idpNode, err := app.getNodeDetail("idp1", true)
+	assert.NoError(t, err)
+
+	// role pause applies to baseline node by migrated roles
+	beginTestBlock(app)
+	deliverTestTx(t, app, "PauseMethod", PauseMethodParam{MethodGroup: "request", Roles: []string{"RP"}, Reason: "maintenance"}, testNDIDNodeID)
+	commitTestBlock(app)
+	assertApplicationErrorCode(t, code.MethodIsPaused, app.checkMethodNotPaused("CreateRequest", rpNode, "rp1", true))
+	assert.NoError(t, app.checkMethodNotPaused("CreateIdpResponse", idpNode, "idp1", true))
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "ResumeMethod", ResumeMethodParam{MethodGroup: "request"}, testNDIDNodeID)
+	commitTestBlock(app)
+	assert.NoError(t, app.checkMethodNotPaused("CreateRequest", rpNode, "rp1", true))
+}
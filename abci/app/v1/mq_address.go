@@ -0,0 +1,122 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+const maxMqHostnameLength = 253
+
+var (
+	mqHostnameRegexp                  = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	mqTLSCertificateFingerprintRegexp = regexp.MustCompile(`^([0-9a-fA-F]{64}|([0-9a-fA-F]{2}:){31}[0-9a-fA-F]{2})$`)
+)
+
+// validateMqAddress checks MQ address. Address in old format (IP and port only) is still valid.
+func validateMqAddress(address MsqAddress) error {
+	if address.IP == "" && address.Hostname == "" {
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: "MQ address must have IP or hostname",
+		}
+	}
+	if address.IP != "" && net.ParseIP(address.IP) == nil {
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: fmt.Sprintf("Invalid MQ IP address: %s", address.IP),
+		}
+	}
+	if address.Hostname != "" {
+		if len(address.Hostname) > maxMqHostnameLength || !mqHostnameRegexp.MatchString(address.Hostname) {
+			return &ApplicationError{
+				Code:    code.InvalidMqAddress,
+				Message: fmt.Sprintf("Invalid MQ hostname: %s", address.Hostname),
+			}
+		}
+		if net.ParseIP(address.Hostname) != nil {
+			return &ApplicationError{
+				Code:    code.InvalidMqAddress,
+				Message: "MQ hostname must not be an IP address",
+			}
+		}
+	}
+	if address.Port < 1 || address.Port > 65535 {
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: fmt.Sprintf("Invalid MQ port: %d", address.Port),
+		}
+	}
+	switch appTypes.MQProtocol(address.Protocol) {
+	case "", appTypes.MQProtocolTCP, appTypes.MQProtocolGRPC, appTypes.MQProtocolHTTPS:
+	default:
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: fmt.Sprintf("Invalid MQ protocol: %s", address.Protocol),
+		}
+	}
+	if address.TLSCertificateFingerprint != "" &&
+		!mqTLSCertificateFingerprintRegexp.MatchString(address.TLSCertificateFingerprint) {
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: "MQ TLS certificate fingerprint must be SHA-256 hex string",
+		}
+	}
+	if address.Priority < 0 || address.Weight < 0 {
+		return &ApplicationError{
+			Code:    code.InvalidMqAddress,
+			Message: "MQ priority and weight cannot be negative",
+		}
+	}
+	return nil
+}
+
+func newMQ(address MsqAddress) *data.MQ {
+	return &data.MQ{
+		Ip:                        address.IP,
+		Port:                      address.Port,
+		Hostname:                  address.Hostname,
+		Protocol:                  address.Protocol,
+		TlsCertificateFingerprint: address.TLSCertificateFingerprint,
+		Priority:                  address.Priority,
+		Weight:                    address.Weight,
+	}
+}
+
+func getMsqAddress(mq *data.MQ) MsqAddress {
+	return MsqAddress{
+		IP:                        mq.Ip,
+		Port:                      mq.Port,
+		Hostname:                  mq.Hostname,
+		Protocol:                  mq.Protocol,
+		TLSCertificateFingerprint: mq.TlsCertificateFingerprint,
+		Priority:                  mq.Priority,
+		Weight:                    mq.Weight,
+	}
+}
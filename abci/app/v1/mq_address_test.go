@@ -0,0 +1,75 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func TestValidateMqAddress(t *testing.T) {
+	assert.NoError(t, validateMqAddress(MsqAddress{IP: "192.168.3.99", Port: 8000}))
+	assert.NoError(t, validateMqAddress(MsqAddress{IP: "2001:db8::1", Port: 8000}))
+	assert.NoError(t, validateMqAddress(MsqAddress{
+		Hostname:                  "mq.idp1.example.com",
+		Port:                      443,
+		Protocol:                  "grpc",
+		TLSCertificateFingerprint: "AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89:AB:CD:EF:01:23:45:67:89",
+		Priority:                  1,
+		Weight:                    10,
+	}))
+
+	invalidAddresses := []MsqAddress{
+		{Port: 8000},
+		{IP: "192.168.3.256", Port: 8000},
+		{IP: "mq.idp1.example.com", Port: 8000},
+		{IP: "192.168.3.99:8000", Port: 8000},
+		{IP: "192.168.3.99", Port: 0},
+		{IP: "192.168.3.99", Port: 65536},
+		{Hostname: "-invalid-.example.com", Port: 8000},
+		{Hostname: "10.0.0.1", Port: 8000},
+		{IP: "192.168.3.99", Port: 8000, Protocol: "udp"},
+		{IP: "192.168.3.99", Port: 8000, TLSCertificateFingerprint: "abcd"},
+		{IP: "192.168.3.99", Port: 8000, Priority: -1},
+		{IP: "192.168.3.99", Port: 8000, Weight: -1},
+	}
+	for _, address := range invalidAddresses {
+		assertApplicationErrorCode(t, code.InvalidMqAddress, validateMqAddress(address))
+	}
+}
+
+func TestMsqAddressRoundTrip(t *testing.T) {
+	address := MsqAddress{
+		IP:                        "192.168.3.99",
+		Port:                      8000,
+		Hostname:                  "mq.rp1.example.com",
+		Protocol:                  "https",
+		TLSCertificateFingerprint: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		Priority:                  2,
+		Weight:                    5,
+	}
+	assert.Equal(t, address, getMsqAddress(newMQ(address)))
+}
@@ -31,8 +31,26 @@ import (
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
 )
 
+type GetNamespaceListParam struct {
+	PaginationParam
+	// Active filters by namespace active flag, active namespaces only when not set
+	Active *bool `json:"active"`
+}
+
 func (app *ABCIApplication) getNamespaceList(param []byte) *abcitypes.ResponseQuery {
 	app.logger.Infof("GetNamespaceList, Parameter: %s", param)
+	var funcParam GetNamespaceListParam
+	if len(param) > 0 {
+		err := json.Unmarshal(param, &funcParam)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+	}
+	active := true
+	if funcParam.Active != nil {
+		active = *funcParam.Active
+	}
+
 	value, err := app.state.Get(allNamespaceKeyBytes, true)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
@@ -43,18 +61,30 @@ func (app *ABCIApplication) getNamespaceList(param []byte) *abcitypes.ResponseQu
 	}
 
 	result := make([]*data.Namespace, 0)
-	// filter flag==true
 	var namespaces data.NamespaceList
 	err = proto.Unmarshal([]byte(value), &namespaces)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	namespaceIDs := make([]string, 0)
 	for _, namespace := range namespaces.Namespaces {
-		if namespace.Active {
+		if namespace.Active == active {
 			result = append(result, namespace)
+			namespaceIDs = append(namespaceIDs, namespace.Namespace)
 		}
 	}
-	returnValue, err := json.Marshal(result)
+
+	// namespaces are kept in single list record (not separate keys)
+	var returnValue []byte
+	if funcParam.isPaginated() {
+		start, end, nextCursor := paginateIDList(namespaceIDs, funcParam.PaginationParam)
+		returnValue, err = json.Marshal(PaginatedListResult{
+			Items:      result[start:end],
+			NextCursor: nextCursor,
+		})
+	} else {
+		returnValue, err = json.Marshal(result)
+	}
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
@@ -65,4 +65,24 @@ var regulatorMethod = map[string]bool{
 	"RemoveSuppressedIdentityModificationNotificationNode": true,
 	"AddAllowedNodeSupportedFeature":                       true,
 	"RemoveAllowedNodeSupportedFeature":                    true,
+	"BulkRegisterIdentityByNDID":                           true,
+	"FreezeReferenceGroup":                                 true,
+	"UnfreezeReferenceGroup":                               true,
+	"TransferIdPAssociations":                              true,
+	"ProcessIdPAssociationTransferBatch":                   true,
+	"CancelIdPAssociationTransfer":                         true,
+	"AddNodeCertificateAuthority":                          true,
+	"RemoveNodeCertificateAuthority":                       true,
+	"DecommissionNode":                                     true,
+	"SetValidatorPowerCap":                                 true,
+	"GrantAdminPermission":                                 true,
+	"RevokeAdminPermission":                                true,
+	"SetGovernanceConfig":                                  true,
+	"CreateProposal":                                       true,
+	"ApproveProposal":                                      true,
+	"CancelScheduledChange":                                true,
+	"PauseMethod":                                          true,
+	"ResumeMethod":                                         true,
+	"SetUpgradePlan":                                       true,
+	"SetParameter":                                         true,
 }
@@ -67,6 +67,7 @@ func (app *ABCIApplication) validateInitNDID(funcParam InitNDIDParam, callerNode
 	err = checkPubKeyForSigning(
 		funcParam.SigningMasterPublicKey,
 		appTypes.SignatureAlgorithm(funcParam.SigningMasterAlgorithm),
+		nil,
 	)
 	if err != nil {
 		return err
@@ -76,13 +77,14 @@ func (app *ABCIApplication) validateInitNDID(funcParam InitNDIDParam, callerNode
 	err = checkPubKeyForSigning(
 		funcParam.SigningPublicKey,
 		appTypes.SignatureAlgorithm(funcParam.SigningAlgorithm),
+		nil,
 	)
 	if err != nil {
 		return err
 	}
 
 	// Validate encryption public key format
-	err = checkPubKeyForEncryption(funcParam.EncryptionPublicKey)
+	err = checkPubKeyForEncryption(funcParam.EncryptionPublicKey, nil)
 	if err != nil {
 		return err
 	}
@@ -194,11 +196,10 @@ func (app *ABCIApplication) initNDID(param []byte, callerNodeID string) *abcityp
 	}
 
 	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
-	chainHistoryInfoKey := "ChainHistoryInfo"
 	app.state.Set(masterNDIDKeyBytes, []byte(callerNodeID))
 	app.state.Set([]byte(nodeDetailKey), []byte(nodeDetailByte))
 	app.state.Set(initStateKeyBytes, []byte("true"))
-	app.state.Set([]byte(chainHistoryInfoKey), []byte(funcParam.ChainHistoryInfo))
+	app.state.Set(chainHistoryInfoKeyBytes, []byte(funcParam.ChainHistoryInfo))
 
 	return app.NewExecTxResult(code.OK, "success", "")
 }
@@ -412,16 +413,10 @@ type SetLastBlockParam struct {
 }
 
 func (app *ABCIApplication) validateSetLastBlock(funcParam SetLastBlockParam, callerNodeID string, committedState bool) error {
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeChain, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	return nil
 }
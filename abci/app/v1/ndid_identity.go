@@ -24,9 +24,12 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
@@ -86,18 +89,17 @@ func (app *ABCIApplication) setTimeOutBlockRegisterIdentity(param []byte, caller
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	key := "TimeOutBlockRegisterIdentity"
-	var timeOut data.TimeOutBlockRegisterIdentity
-	timeOut.TimeOutBlock = funcParam.TimeOutBlock
 	// Check time out block > 0
-	if timeOut.TimeOutBlock <= 0 {
+	if funcParam.TimeOutBlock <= 0 {
 		return app.NewExecTxResult(code.TimeOutBlockIsMustGreaterThanZero, "Time out block is must greater than 0", "")
 	}
-	value, err := utils.ProtoDeterministicMarshal(&timeOut)
+	err = app.storeParameter("TimeOutBlockRegisterIdentity", funcParam.TimeOutBlock)
 	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
-	app.state.Set([]byte(key), []byte(value))
 	return app.NewExecTxResult(code.OK, "success", "")
 }
 
@@ -155,13 +157,424 @@ func (app *ABCIApplication) setAllowedMinIalForRegisterIdentityAtFirstIdp(param
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	allowedMinIalKey := "AllowedMinIalForRegisterIdentityAtFirstIdp"
-	var allowedMinIal data.AllowedMinIalForRegisterIdentityAtFirstIdp
-	allowedMinIal.MinIal = funcParam.MinIal
-	allowedMinIalByte, err := utils.ProtoDeterministicMarshal(&allowedMinIal)
+	err = app.storeParameter("AllowedMinIalForRegisterIdentityAtFirstIdp", funcParam.MinIal)
 	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
-	app.state.Set([]byte(allowedMinIalKey), allowedMinIalByte)
 	return app.NewExecTxResult(code.OK, "success", "")
 }
+
+// maxBulkRegisterIdentityListSize limits number of entries in one BulkRegisterIdentityByNDID transaction
+const maxBulkRegisterIdentityListSize = 500
+
+type BulkRegisterIdentityByNDIDParam struct {
+	NodeID       string                  `json:"node_id"`
+	IdentityList []RegisterIdentityParam `json:"identity_list"`
+}
+
+type BulkRegisterIdentityResult struct {
+	ReferenceGroupCode string `json:"reference_group_code"`
+	AccessorID         string `json:"accessor_id"`
+	Success            bool   `json:"success"`
+	ErrorCode          uint32 `json:"error_code,omitempty"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+func (app *ABCIApplication) validateBulkRegisterIdentityByNDID(funcParam BulkRegisterIdentityByNDIDParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &ApplicationError{
+			Code:    code.NoPermissionForCallNDIDMethod,
+			Message: "This node does not have permission to call NDID method",
+		}
+	}
+
+	// stateless
+
+	if len(funcParam.IdentityList) == 0 {
+		return &ApplicationError{
+			Code:    code.IdentityListCannotBeEmpty,
+			Message: "Identity list cannot be empty",
+		}
+	}
+
+	if len(funcParam.IdentityList) > maxBulkRegisterIdentityListSize {
+		return &ApplicationError{
+			Code:    code.IdentityListTooLarge,
+			Message: fmt.Sprintf("Identity list cannot have more than %d entries", maxBulkRegisterIdentityListSize),
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	// check if node ID exists and is an active IdP
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
+	nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if nodeDetailValue == nil {
+		return &ApplicationError{
+			Code:    code.NodeIDNotFound,
+			Message: "Node ID not found",
+		}
+	}
+	var nodeDetail data.NodeDetail
+	err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	if !app.isIDPNode(&nodeDetail) {
+		return &ApplicationError{
+			Code:    code.NotIdPNode,
+			Message: "not an IdP node",
+		}
+	}
+	if !nodeDetail.Active {
+		return &ApplicationError{
+			Code:    code.NodeIsNotActive,
+			Message: "Node is not active",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) bulkRegisterIdentityByNDIDCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam BulkRegisterIdentityByNDIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateBulkRegisterIdentityByNDID(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// bulkRegisterIdentityByNDID imports identities to an IdP node on behalf of the IdP.
+// Each entry is validated and applied in order; invalid entries are skipped and
+// reported in the result list without failing the whole transaction.
+// Reference group code can appear only once in the list, later entries with
+// the same code are rejected.
+// Request (mode 3) checks are not applied since the import is authorized by NDID.
+func (app *ABCIApplication) bulkRegisterIdentityByNDID(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("BulkRegisterIdentityByNDID, Parameter: %s", param)
+	var funcParam BulkRegisterIdentityByNDIDParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateBulkRegisterIdentityByNDID(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	var attributes []abcitypes.EventAttribute
+	resultList := make([]BulkRegisterIdentityResult, 0, len(funcParam.IdentityList))
+	refGroupCodes := make(map[string]struct{}, len(funcParam.IdentityList))
+	for _, identity := range funcParam.IdentityList {
+		result := BulkRegisterIdentityResult{
+			ReferenceGroupCode: identity.ReferenceGroupCode,
+			AccessorID:         identity.AccessorID,
+		}
+
+		var err error
+		if _, ok := refGroupCodes[identity.ReferenceGroupCode]; ok {
+			err = &ApplicationError{
+				Code:    code.DuplicateReferenceGroupCodeInIdentityList,
+				Message: "Duplicate reference group code in identity list",
+			}
+		} else {
+			refGroupCodes[identity.ReferenceGroupCode] = struct{}{}
+			err = app.validateRegisterIdentityAtIdP(identity, funcParam.NodeID, false, false, false)
+		}
+		if err == nil {
+			err = app.setRegisterIdentity(identity, funcParam.NodeID, false)
+		}
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				result.ErrorCode = appErr.Code
+				result.ErrorMessage = appErr.Message
+			} else {
+				result.ErrorCode = code.UnknownError
+				result.ErrorMessage = err.Error()
+			}
+			resultList = append(resultList, result)
+			continue
+		}
+
+		result.Success = true
+		resultList = append(resultList, result)
+
+		var attribute abcitypes.EventAttribute
+		attribute.Key = "reference_group_code"
+		attribute.Value = identity.ReferenceGroupCode
+		attributes = append(attributes, attribute)
+	}
+
+	resultListJSON, err := json.Marshal(resultList)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+
+	result := app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+	result.Data = resultListJSON
+	return result
+}
+
+type FreezeReferenceGroupParam struct {
+	ReferenceGroupCode     string `json:"reference_group_code"`
+	IdentityNamespace      string `json:"identity_namespace"`
+	IdentityIdentifierHash string `json:"identity_identifier_hash"`
+	ReasonCode             string `json:"reason_code"`
+}
+
+// getReferenceGroupForFreeze resolves reference group from either
+// reference group code or identity in the parameter
+func (app *ABCIApplication) getReferenceGroupForFreeze(funcParam FreezeReferenceGroupParam, committedState bool) (refGroupCode string, refGroup *data.ReferenceGroup, err error) {
+	if funcParam.ReferenceGroupCode != "" {
+		refGroupCode = funcParam.ReferenceGroupCode
+	} else {
+		identityToRefCodeKey := identityToRefCodeKeyPrefix + keySeparator + funcParam.IdentityNamespace + keySeparator + funcParam.IdentityIdentifierHash
+		refGroupCodeFromDB, err := app.state.Get([]byte(identityToRefCodeKey), committedState)
+		if err != nil {
+			return "", nil, &ApplicationError{
+				Code:    code.AppStateError,
+				Message: err.Error(),
+			}
+		}
+		if refGroupCodeFromDB == nil {
+			return "", nil, &ApplicationError{
+				Code:    code.RefGroupNotFound,
+				Message: "Reference group not found",
+			}
+		}
+		refGroupCode = string(refGroupCodeFromDB)
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+	refGroupValue, err := app.state.Get([]byte(refGroupKey), committedState)
+	if err != nil {
+		return "", nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if refGroupValue == nil {
+		return "", nil, &ApplicationError{
+			Code:    code.RefGroupNotFound,
+			Message: "Reference group not found",
+		}
+	}
+	refGroup = &data.ReferenceGroup{}
+	err = proto.Unmarshal(refGroupValue, refGroup)
+	if err != nil {
+		return "", nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return refGroupCode, refGroup, nil
+}
+
+func (app *ABCIApplication) validateFreezeReferenceGroup(funcParam FreezeReferenceGroupParam, callerNodeID string, committedState bool, checktx bool, freeze bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
+	if err != nil {
+		return err
+	}
+
+	// stateless
+
+	if funcParam.ReferenceGroupCode != "" && funcParam.IdentityNamespace != "" && funcParam.IdentityIdentifierHash != "" {
+		return &ApplicationError{
+			Code:    code.GotRefGroupCodeAndIdentity,
+			Message: "Found reference group code and identity detail in parameter",
+		}
+	}
+
+	if funcParam.ReferenceGroupCode == "" && (funcParam.IdentityNamespace == "" || funcParam.IdentityIdentifierHash == "") {
+		return &ApplicationError{
+			Code:    code.RefGroupCodeOrIdentityIsRequired,
+			Message: "Reference group code or identity detail is required",
+		}
+	}
+
+	if funcParam.ReasonCode == "" {
+		return &ApplicationError{
+			Code:    code.FreezeReasonCodeCannotBeEmpty,
+			Message: "Reason code cannot be empty",
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	_, refGroup, err := app.getReferenceGroupForFreeze(funcParam, committedState)
+	if err != nil {
+		return err
+	}
+
+	if freeze && refGroup.Frozen {
+		return &ApplicationError{
+			Code:    code.ReferenceGroupIsFrozen,
+			Message: "Reference group is frozen",
+		}
+	}
+	if !freeze && !refGroup.Frozen {
+		return &ApplicationError{
+			Code:    code.ReferenceGroupIsNotFrozen,
+			Message: "Reference group is not frozen",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) freezeReferenceGroupCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam FreezeReferenceGroupParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateFreezeReferenceGroup(funcParam, callerNodeID, true, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) freezeReferenceGroup(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("FreezeReferenceGroup, Parameter: %s", param)
+	var funcParam FreezeReferenceGroupParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateFreezeReferenceGroup(funcParam, callerNodeID, false, false, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	refGroupCode, refGroup, err := app.getReferenceGroupForFreeze(funcParam, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	refGroup.Frozen = true
+	refGroup.FreezeReasonCode = funcParam.ReasonCode
+	refGroup.FreezeBlockHeight = app.state.CurrentBlockHeight
+
+	return app.setFrozenReferenceGroup(refGroupCode, refGroup, funcParam.ReasonCode)
+}
+
+func (app *ABCIApplication) unfreezeReferenceGroupCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam FreezeReferenceGroupParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateFreezeReferenceGroup(funcParam, callerNodeID, true, true, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) unfreezeReferenceGroup(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("UnfreezeReferenceGroup, Parameter: %s", param)
+	var funcParam FreezeReferenceGroupParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateFreezeReferenceGroup(funcParam, callerNodeID, false, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	refGroupCode, refGroup, err := app.getReferenceGroupForFreeze(funcParam, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	refGroup.Frozen = false
+	refGroup.FreezeReasonCode = ""
+	refGroup.FreezeBlockHeight = 0
+
+	return app.setFrozenReferenceGroup(refGroupCode, refGroup, funcParam.ReasonCode)
+}
+
+func (app *ABCIApplication) setFrozenReferenceGroup(refGroupCode string, refGroup *data.ReferenceGroup, reasonCode string) *abcitypes.ExecTxResult {
+	refGroupValue, err := utils.ProtoDeterministicMarshal(refGroup)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+	app.state.Set([]byte(refGroupKey), refGroupValue)
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "reference_group_code"
+	attribute.Value = refGroupCode
+	attributes = append(attributes, attribute)
+	attribute.Key = "reason_code"
+	attribute.Value = reasonCode
+	attributes = append(attributes, attribute)
+
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
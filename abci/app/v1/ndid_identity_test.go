@@ -0,0 +1,234 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func newTestAppForBulkRegisterIdentity(t *testing.T) *ABCIApplication {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp1", "IdP")
+	addTestNamespace(t, app, "citizen_id")
+	commitTestBlock(app)
+	return app
+}
+
+func getTestBulkRegisterIdentityResult(t *testing.T, res []byte) []BulkRegisterIdentityResult {
+	var resultList []BulkRegisterIdentityResult
+	assert.NoError(t, json.Unmarshal(res, &resultList))
+	return resultList
+}
+
+func getTestRefGroupCodeOfIdentity(t *testing.T, app *ABCIApplication, namespace string, identifierHash string) string {
+	key := identityToRefCodeKeyPrefix + keySeparator + namespace + keySeparator + identifierHash
+	value, err := app.state.Get([]byte(key), false)
+	assert.NoError(t, err)
+	return string(value)
+}
+
+func TestBulkRegisterIdentityByNDID(t *testing.T) {
+	identityList := make([]RegisterIdentityParam, 0, maxBulkRegisterIdentityListSize+1)
+	for i := 0; i <= maxBulkRegisterIdentityListSize; i++ {
+		identityList = append(identityList, newTestRegisterIdentityParam(
+			t,
+			fmt.Sprintf("ref%d", i),
+			"citizen_id",
+			fmt.Sprintf("hash%d", i),
+			fmt.Sprintf("accessor%d", i),
+		))
+	}
+
+	testCases := []struct {
+		name         string
+		callerNodeID string
+		identityList []RegisterIdentityParam
+		code         uint32
+		// error code of each failed entry by index, other entries are applied
+		failedEntries map[int]uint32
+	}{
+		{
+			name:         "partial failure",
+			callerNodeID: testNDIDNodeID,
+			identityList: []RegisterIdentityParam{
+				newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1"),
+				newTestRegisterIdentityParam(t, "ref2", "passport", "hash2", "accessor2"),
+				newTestRegisterIdentityParam(t, "ref3", "citizen_id", "hash3", "accessor3"),
+			},
+			code:          code.OK,
+			failedEntries: map[int]uint32{1: code.InvalidNamespace},
+		},
+		{
+			name:         "duplicate in list",
+			callerNodeID: testNDIDNodeID,
+			identityList: []RegisterIdentityParam{
+				newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1"),
+				// same reference group code
+				newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash2", "accessor2"),
+				// same identity in other reference group
+				newTestRegisterIdentityParam(t, "ref3", "citizen_id", "hash1", "accessor3"),
+				// same accessor ID in other reference group
+				newTestRegisterIdentityParam(t, "ref4", "citizen_id", "hash4", "accessor1"),
+			},
+			code: code.OK,
+			failedEntries: map[int]uint32{
+				1: code.DuplicateReferenceGroupCodeInIdentityList,
+				2: code.IdentityAlreadyExists,
+				3: code.DuplicateAccessorID,
+			},
+		},
+		{
+			name:         "max list size",
+			callerNodeID: testNDIDNodeID,
+			identityList: identityList[:maxBulkRegisterIdentityListSize],
+			code:         code.OK,
+		},
+		{
+			name:         "list too large",
+			callerNodeID: testNDIDNodeID,
+			identityList: identityList,
+			code:         code.IdentityListTooLarge,
+		},
+		{
+			name:         "empty list",
+			callerNodeID: testNDIDNodeID,
+			code:         code.IdentityListCannotBeEmpty,
+		},
+		{
+			name:         "not NDID",
+			callerNodeID: "idp1",
+			identityList: identityList[:1],
+			code:         code.NoPermissionForCallNDIDMethod,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			app := newTestAppForBulkRegisterIdentity(t)
+			beginTestBlock(app)
+			res := callTestTx(t, app, "BulkRegisterIdentityByNDID", BulkRegisterIdentityByNDIDParam{
+				NodeID:       "idp1",
+				IdentityList: testCase.identityList,
+			}, testCase.callerNodeID)
+			commitTestBlock(app)
+			assert.Equal(t, testCase.code, res.Code, res.Log)
+
+			var resultList []BulkRegisterIdentityResult
+			var appliedRefGroupCodes []string
+			if res.Code == code.OK {
+				resultList = getTestBulkRegisterIdentityResult(t, res.Data)
+				assert.Len(t, resultList, len(testCase.identityList))
+			}
+			for i, identity := range testCase.identityList {
+				errorCode, failed := testCase.failedEntries[i]
+				applied := res.Code == code.OK && !failed
+				if res.Code == code.OK {
+					assert.Equal(t, applied, resultList[i].Success, i)
+					assert.Equal(t, errorCode, resultList[i].ErrorCode, i)
+				}
+				refGroupCode := getTestRefGroupCodeOfIdentity(t, app, identity.NewIdentityList[0].IdentityNamespace, identity.NewIdentityList[0].IdentityIdentifierHash)
+				if applied {
+					assert.Equal(t, identity.ReferenceGroupCode, refGroupCode, i)
+					appliedRefGroupCodes = append(appliedRefGroupCodes, identity.ReferenceGroupCode)
+				} else {
+					assert.NotEqual(t, identity.ReferenceGroupCode, refGroupCode, i)
+				}
+			}
+
+			// only applied entries are in event attributes
+			if res.Code == code.OK {
+				var refGroupCodes []string
+				for _, attribute := range res.Events[0].Attributes {
+					if attribute.Key == "reference_group_code" {
+						refGroupCodes = append(refGroupCodes, attribute.Value)
+					}
+				}
+				assert.Equal(t, appliedRefGroupCodes, refGroupCodes)
+			}
+		})
+	}
+}
+
+func TestBulkRegisterIdentityByNDIDAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	beginTestBlock(app)
+	addTestNamespace(t, app, "citizen_id")
+	res := deliverTestTx(t, app, "BulkRegisterIdentityByNDID", BulkRegisterIdentityByNDIDParam{
+		NodeID: "idp1",
+		IdentityList: []RegisterIdentityParam{
+			newTestRegisterIdentityParam(t, "ref2", "citizen_id", "hash2", "accessor2"),
+		},
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	// IdP role of node registered before multi-role support is migrated
+	resultList := getTestBulkRegisterIdentityResult(t, res.Data)
+	assert.True(t, resultList[0].Success, resultList[0].ErrorMessage)
+	assert.Equal(t, "ref2", getTestRefGroupCodeOfIdentity(t, app, "citizen_id", "hash2"))
+
+	// index has reference groups from before and after migration
+	refGroupCodeList, err := app.getNodeIndexValueList(idpRefGroupCodeKeyPrefix, "idp1")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ref1", "ref2"}, refGroupCodeList)
+}
+
+func TestFreezeReferenceGroupParam(t *testing.T) {
+	app := newTestAppForBulkRegisterIdentity(t)
+	beginTestBlock(app)
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1"), "idp1")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	res := callTestTx(t, app, "FreezeReferenceGroup", FreezeReferenceGroupParam{
+		ReasonCode: "fraud",
+	}, testNDIDNodeID)
+	assert.Equal(t, code.RefGroupCodeOrIdentityIsRequired, res.Code)
+
+	res = callTestTx(t, app, "FreezeReferenceGroup", FreezeReferenceGroupParam{
+		IdentityNamespace: "citizen_id",
+		ReasonCode:        "fraud",
+	}, testNDIDNodeID)
+	assert.Equal(t, code.RefGroupCodeOrIdentityIsRequired, res.Code)
+
+	res = callTestTx(t, app, "UnfreezeReferenceGroup", FreezeReferenceGroupParam{
+		ReasonCode: "resolved",
+	}, testNDIDNodeID)
+	assert.Equal(t, code.RefGroupCodeOrIdentityIsRequired, res.Code)
+
+	deliverTestTx(t, app, "FreezeReferenceGroup", FreezeReferenceGroupParam{
+		IdentityNamespace:      "citizen_id",
+		IdentityIdentifierHash: "hash1",
+		ReasonCode:             "fraud",
+	}, testNDIDNodeID)
+	deliverTestTx(t, app, "UnfreezeReferenceGroup", FreezeReferenceGroupParam{
+		ReferenceGroupCode: "ref1",
+		ReasonCode:         "resolved",
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+}
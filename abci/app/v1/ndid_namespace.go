@@ -28,6 +28,7 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"google.golang.org/protobuf/proto"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
@@ -43,16 +44,10 @@ type AddNamespaceParam struct {
 
 func (app *ABCIApplication) validateAddNamespace(funcParam AddNamespaceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNamespaces, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -164,16 +159,10 @@ type EnableNamespaceParam struct {
 
 func (app *ABCIApplication) validateEnableNamespace(funcParam EnableNamespaceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNamespaces, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -284,16 +273,10 @@ type DisableNamespaceParam struct {
 
 func (app *ABCIApplication) validateDisableNamespace(funcParam DisableNamespaceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNamespaces, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -408,16 +391,10 @@ type UpdateNamespaceParam struct {
 
 func (app *ABCIApplication) validateUpdateNamespace(funcParam UpdateNamespaceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNamespaces, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
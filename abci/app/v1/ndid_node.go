@@ -25,7 +25,6 @@ package app
 import (
 	"encoding/json"
 	"strconv"
-	"strings"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"google.golang.org/protobuf/proto"
@@ -46,6 +45,7 @@ type RegisterNodeParam struct {
 	EncryptionAlgorithm    string   `json:"encryption_algorithm"`
 	NodeName               string   `json:"node_name"`
 	Role                   string   `json:"role"`
+	Roles                  []string `json:"roles"`
 	MaxIal                 float64  `json:"max_ial"` // IdP only attribute
 	MaxAal                 float64  `json:"max_aal"` // IdP only attribute
 	SupportedFeatureList   []string `json:"supported_feature_list"`
@@ -56,23 +56,23 @@ type RegisterNodeParam struct {
 
 func (app *ABCIApplication) validateRegisterNode(funcParam RegisterNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	// stateless
 
+	certOpts, err := app.getNodeCertificateVerifyOptions(committedState)
+	if err != nil {
+		return err
+	}
+
 	// Validate master public key format
 	err = checkPubKeyForSigning(
 		funcParam.SigningMasterPublicKey,
 		appTypes.SignatureAlgorithm(funcParam.SigningMasterAlgorithm),
+		certOpts,
 	)
 	if err != nil {
 		return err
@@ -82,26 +82,22 @@ func (app *ABCIApplication) validateRegisterNode(funcParam RegisterNodeParam, ca
 	err = checkPubKeyForSigning(
 		funcParam.SigningPublicKey,
 		appTypes.SignatureAlgorithm(funcParam.SigningAlgorithm),
+		certOpts,
 	)
 	if err != nil {
 		return err
 	}
 
 	// Validate encryption public key format
-	err = checkPubKeyForEncryption(funcParam.EncryptionPublicKey)
+	err = checkPubKeyForEncryption(funcParam.EncryptionPublicKey, certOpts)
 	if err != nil {
 		return err
 	}
 
 	// check role is valid
-	if !(strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleRp)) ||
-		strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleIdp)) ||
-		strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleAs)) ||
-		strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleProxy))) {
-		return &ApplicationError{
-			Code:    code.InvalidNodeRole,
-			Message: "Invalid node role",
-		}
+	roles, err := parseNodeRoleList(funcParam.Role, funcParam.Roles)
+	if err != nil {
+		return err
 	}
 
 	if checktx {
@@ -110,6 +106,12 @@ func (app *ABCIApplication) validateRegisterNode(funcParam RegisterNodeParam, ca
 
 	// stateful
 
+	// decommissioned node ID can never be registered again
+	err = app.checkNodeNotDecommissioned(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
 	key := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
 	// check Duplicate Node ID
 	chkExists, err := app.state.Get([]byte(key), committedState)
@@ -145,8 +147,9 @@ func (app *ABCIApplication) validateRegisterNode(funcParam RegisterNodeParam, ca
 	}
 
 	// if node is Idp or rp, set use_whitelist and whitelist
-	if strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleRp)) ||
-		strings.EqualFold(funcParam.Role, string(appTypes.NodeRoleIdp)) {
+	var node data.NodeDetail
+	setNodeRoles(&node, roles)
+	if hasAnyNodeRole(&node, appTypes.NodeRoleIdp, appTypes.NodeRoleRp) {
 		if funcParam.Whitelist != nil {
 			// check if all node in whitelist exists
 			for _, whitelistNode := range funcParam.Whitelist {
@@ -207,13 +210,12 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 
 	// create node detail
 	var nodeDetail data.NodeDetail
-	nodeDetail.SigningPublicKey = &data.NodeKey{
-		PublicKey:           funcParam.SigningPublicKey,
-		Algorithm:           funcParam.SigningAlgorithm,
-		Version:             1,
-		CreationBlockHeight: app.state.CurrentBlockHeight,
-		CreationChainId:     app.CurrentChain,
-		Active:              true,
+	nodeDetail.SigningPublicKey, err = app.newNodeKey(funcParam.SigningPublicKey, funcParam.SigningAlgorithm, 1)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 	// create key version history
 	nodeKeyKey :=
@@ -227,13 +229,12 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 	}
 	app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 
-	nodeDetail.SigningMasterPublicKey = &data.NodeKey{
-		PublicKey:           funcParam.SigningMasterPublicKey,
-		Algorithm:           funcParam.SigningMasterAlgorithm,
-		Version:             1,
-		CreationBlockHeight: app.state.CurrentBlockHeight,
-		CreationChainId:     app.CurrentChain,
-		Active:              true,
+	nodeDetail.SigningMasterPublicKey, err = app.newNodeKey(funcParam.SigningMasterPublicKey, funcParam.SigningMasterAlgorithm, 1)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 	// create key version history
 	nodeKeyKey =
@@ -247,13 +248,12 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 	}
 	app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 
-	nodeDetail.EncryptionPublicKey = &data.NodeKey{
-		PublicKey:           funcParam.EncryptionPublicKey,
-		Algorithm:           funcParam.EncryptionAlgorithm,
-		Version:             1,
-		CreationBlockHeight: app.state.CurrentBlockHeight,
-		CreationChainId:     app.CurrentChain,
-		Active:              true,
+	nodeDetail.EncryptionPublicKey, err = app.newNodeKey(funcParam.EncryptionPublicKey, funcParam.EncryptionAlgorithm, 1)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 	// create key version history
 	nodeKeyKey =
@@ -269,31 +269,28 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 
 	nodeDetail.NodeName = funcParam.NodeName
 
-	switch strings.ToLower(funcParam.Role) {
-	case "rp":
-		nodeDetail.Role = string(appTypes.NodeRoleRp)
-	case "idp":
-		nodeDetail.Role = string(appTypes.NodeRoleIdp)
-	case "as":
-		nodeDetail.Role = string(appTypes.NodeRoleAs)
-	case "proxy":
-		nodeDetail.Role = string(appTypes.NodeRoleProxy)
+	roles, err := parseNodeRoleList(funcParam.Role, funcParam.Roles)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
+	setNodeRoles(&nodeDetail, roles)
 
 	nodeDetail.Active = true
 
 	nodeDetail.SupportedFeatureList = funcParam.SupportedFeatureList
 
 	// if node is IdP, set max_aal, min_ial, on_the_fly_support, is_idp_agent, and supported_request_message_type_list
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp {
+	if hasNodeRole(&nodeDetail, appTypes.NodeRoleIdp) {
 		nodeDetail.MaxAal = funcParam.MaxAal
 		nodeDetail.MaxIal = funcParam.MaxIal
 		nodeDetail.IsIdpAgent = funcParam.IsIdPAgent != nil && *funcParam.IsIdPAgent
 		nodeDetail.SupportedRequestMessageDataUrlTypeList = make([]string, 0)
 	}
 	// if node is Idp or rp, set use_whitelist and whitelist
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp ||
-		appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleRp {
+	if hasAnyNodeRole(&nodeDetail, appTypes.NodeRoleIdp, appTypes.NodeRoleRp) {
 		if funcParam.UseWhitelist != nil && *funcParam.UseWhitelist {
 			nodeDetail.UseWhitelist = true
 		} else {
@@ -306,70 +303,13 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 		}
 	}
 
-	// if node is IdP, add node id to IdPList
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp {
-		var idpsList data.IdPList
-		idpsKey := "IdPList"
-		idpsValue, err := app.state.Get([]byte(idpsKey), false)
-		if err != nil {
-			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
-		}
-		if idpsValue != nil {
-			err := proto.Unmarshal(idpsValue, &idpsList)
-			if err != nil {
-				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		idpsList.NodeId = append(idpsList.NodeId, funcParam.NodeID)
-		idpsListByte, err := utils.ProtoDeterministicMarshal(&idpsList)
-		if err != nil {
-			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
-		}
-		app.state.Set(idpListKeyBytes, []byte(idpsListByte))
-	}
-
-	// if node is rp, add node id to rpList
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleRp {
-		var rpsList data.RPList
-		rpsKey := "rpList"
-		rpsValue, err := app.state.Get([]byte(rpsKey), false)
-		if err != nil {
-			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
-		}
-		if rpsValue != nil {
-			err := proto.Unmarshal(rpsValue, &rpsList)
-			if err != nil {
-				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		rpsList.NodeId = append(rpsList.NodeId, funcParam.NodeID)
-		rpsListByte, err := utils.ProtoDeterministicMarshal(&rpsList)
-		if err != nil {
-			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
-		}
-		app.state.Set([]byte(rpsKey), []byte(rpsListByte))
-	}
-
-	// if node is as, add node id to asList
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleAs {
-		var asList data.ASList
-		asKey := "asList"
-		asValue, err := app.state.Get([]byte(asKey), false)
-		if err != nil {
-			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
-		}
-		if asValue != nil {
-			err := proto.Unmarshal(asValue, &asList)
-			if err != nil {
-				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
-			}
-		}
-		asList.NodeId = append(asList.NodeId, funcParam.NodeID)
-		asListByte, err := utils.ProtoDeterministicMarshal(&asList)
-		if err != nil {
-			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	// add node id to IdPList, rpList and asList according to its roles
+	err = app.addNodeToRoleLists(funcParam.NodeID, roles)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
 		}
-		app.state.Set([]byte(asKey), []byte(asListByte))
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
 	var allList data.AllList
@@ -403,26 +343,37 @@ func (app *ABCIApplication) registerNode(param []byte, callerNodeID string) *abc
 }
 
 type UpdateNodeByNDIDParam struct {
-	NodeID               string   `json:"node_id"`
-	MaxIal               float64  `json:"max_ial"`
-	MaxAal               float64  `json:"max_aal"`
-	SupportedFeatureList []string `json:"supported_feature_list"`
-	NodeName             string   `json:"node_name"`
-	IsIdPAgent           *bool    `json:"agent"`
-	UseWhitelist         *bool    `json:"node_id_whitelist_active"`
-	Whitelist            []string `json:"node_id_whitelist"`
+	NodeID               string            `json:"node_id"`
+	MaxIal               float64           `json:"max_ial"`
+	MaxAal               float64           `json:"max_aal"`
+	SupportedFeatureList []string          `json:"supported_feature_list"`
+	NodeName             string            `json:"node_name"`
+	IsIdPAgent           *bool             `json:"agent"`
+	UseWhitelist         *bool             `json:"node_id_whitelist_active"`
+	Whitelist            []string          `json:"node_id_whitelist"`
+	Profile              *NodeProfileParam `json:"profile"`
+	Roles                []string          `json:"roles"`
 }
 
 func (app *ABCIApplication) validateUpdateNodeByNDID(funcParam UpdateNodeByNDIDParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
+
+	// stateless
+
+	err = validateNodeProfileParam(funcParam.Profile, true)
+	if err != nil {
+		return err
+	}
+
+	var roles []appTypes.NodeRole
+	if len(funcParam.Roles) > 0 {
+		roles, err = parseNodeRoleList("", funcParam.Roles)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -432,6 +383,11 @@ func (app *ABCIApplication) validateUpdateNodeByNDID(funcParam UpdateNodeByNDIDP
 
 	// stateful
 
+	err = app.checkCanManageNode(callerNodeID, funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
 	// Get node detail by NodeID
 	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
 	nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), committedState)
@@ -456,6 +412,27 @@ func (app *ABCIApplication) validateUpdateNodeByNDID(funcParam UpdateNodeByNDIDP
 		}
 	}
 
+	err = app.checkNodeNotDecommissioned(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
+	// roles can only be added
+	for _, role := range getNodeRoles(&node) {
+		if roles != nil && !containsNodeRole(roles, role) {
+			return &ApplicationError{
+				Code:    code.NodeRoleCannotBeRemoved,
+				Message: "Node role cannot be removed",
+			}
+		}
+	}
+	if roles != nil {
+		_, err = parseNodeRoleList("", append(getNodeRoleStringList(&node), funcParam.Roles...))
+		if err != nil {
+			return err
+		}
+	}
+
 	if funcParam.SupportedFeatureList != nil {
 		// check if supported feature are valid/allowed
 		for _, supportedFeature := range funcParam.SupportedFeatureList {
@@ -476,8 +453,7 @@ func (app *ABCIApplication) validateUpdateNodeByNDID(funcParam UpdateNodeByNDIDP
 		}
 	}
 
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp ||
-		appTypes.NodeRole(node.Role) == appTypes.NodeRoleRp {
+	if hasAnyNodeRole(&node, appTypes.NodeRoleIdp, appTypes.NodeRoleRp) {
 		if funcParam.Whitelist != nil {
 			// check if all node in whitelist exists
 			for _, whitelistNode := range funcParam.Whitelist {
@@ -552,11 +528,36 @@ func (app *ABCIApplication) updateNodeByNDID(param []byte, callerNodeID string)
 	if funcParam.NodeName != "" {
 		node.NodeName = funcParam.NodeName
 	}
+	if len(funcParam.Roles) > 0 {
+		// existing roles (including primary role) are kept in order, new roles are appended
+		roles := getNodeRoles(&node)
+		newRoles, err := parseNodeRoleList("", funcParam.Roles)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+		}
+		addedRoles := make([]appTypes.NodeRole, 0)
+		for _, role := range newRoles {
+			if !containsNodeRole(roles, role) {
+				addedRoles = append(addedRoles, role)
+			}
+		}
+		setNodeRoles(&node, append(roles, addedRoles...))
+		err = app.addNodeToRoleLists(funcParam.NodeID, addedRoles)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+		}
+	}
 	if funcParam.SupportedFeatureList != nil {
 		node.SupportedFeatureList = funcParam.SupportedFeatureList
 	}
 	// If node is IdP then update max_ial, max_aal and is_idp_agent
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp {
+	if hasNodeRole(&node, appTypes.NodeRoleIdp) {
 		if funcParam.MaxIal > 0 {
 			node.MaxIal = funcParam.MaxIal
 		}
@@ -568,8 +569,7 @@ func (app *ABCIApplication) updateNodeByNDID(param []byte, callerNodeID string)
 		}
 	}
 	// If node is Idp or rp, update use_whitelist and whitelist
-	if appTypes.NodeRole(node.Role) == appTypes.NodeRoleIdp ||
-		appTypes.NodeRole(node.Role) == appTypes.NodeRoleRp {
+	if hasAnyNodeRole(&node, appTypes.NodeRoleIdp, appTypes.NodeRoleRp) {
 		if funcParam.UseWhitelist != nil {
 			node.UseWhitelist = *funcParam.UseWhitelist
 		}
@@ -577,6 +577,7 @@ func (app *ABCIApplication) updateNodeByNDID(param []byte, callerNodeID string)
 			node.Whitelist = funcParam.Whitelist
 		}
 	}
+	applyNodeProfileParam(&node, funcParam.Profile, app.state.CurrentBlockHeight)
 	nodeDetailValue, err = utils.ProtoDeterministicMarshal(&node)
 	if err != nil {
 		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
@@ -592,16 +593,10 @@ type DisableNodeParam struct {
 
 func (app *ABCIApplication) validateDisableNode(funcParam DisableNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -609,6 +604,11 @@ func (app *ABCIApplication) validateDisableNode(funcParam DisableNodeParam, call
 
 	// stateful
 
+	err = app.checkCanManageNode(callerNodeID, funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
 	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
 	nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), committedState)
 	if err != nil {
@@ -687,16 +687,10 @@ type EnableNodeParam struct {
 
 func (app *ABCIApplication) validateEnableNode(funcParam EnableNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -719,6 +713,11 @@ func (app *ABCIApplication) validateEnableNode(funcParam EnableNodeParam, caller
 		}
 	}
 
+	err = app.checkNodeNotDecommissioned(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -784,16 +783,10 @@ type AddNodeToProxyNodeParam struct {
 
 func (app *ABCIApplication) validateAddNodeToProxyNode(funcParam AddNodeToProxyNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -957,16 +950,10 @@ type UpdateNodeProxyNodeParam struct {
 
 func (app *ABCIApplication) validateUpdateNodeProxyNode(funcParam UpdateNodeProxyNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -1148,16 +1135,10 @@ type RemoveNodeFromProxyNode struct {
 
 func (app *ABCIApplication) validateRemoveNodeFromProxyNode(funcParam RemoveNodeFromProxyNode, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -43,16 +43,10 @@ type AddServiceParam struct {
 
 func (app *ABCIApplication) validateAddService(funcParam AddServiceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -165,16 +159,10 @@ type EnableServiceParam struct {
 
 func (app *ABCIApplication) validateEnableService(funcParam EnableServiceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -282,16 +270,10 @@ type DisableServiceParam struct {
 
 func (app *ABCIApplication) validateDisableService(funcParam DisableServiceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -405,16 +387,10 @@ type UpdateServiceParam struct {
 
 func (app *ABCIApplication) validateUpdateService(funcParam UpdateServiceParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -537,16 +513,10 @@ type RegisterServiceDestinationByNDIDParam struct {
 
 func (app *ABCIApplication) validateRegisterServiceDestinationByNDID(funcParam RegisterServiceDestinationByNDIDParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -593,7 +563,7 @@ func (app *ABCIApplication) validateRegisterServiceDestinationByNDID(funcParam R
 		}
 	}
 	// Check role is AS
-	if appTypes.NodeRole(nodeDetail.Role) != appTypes.NodeRoleAs {
+	if !hasNodeRole(&nodeDetail, appTypes.NodeRoleAs) {
 		return &ApplicationError{
 			Code:    code.RoleIsNotAS,
 			Message: "Role of node ID is not AS",
@@ -667,16 +637,10 @@ type DisableServiceDestinationByNDIDParam struct {
 
 func (app *ABCIApplication) validateDisableServiceDestinationByNDID(funcParam DisableServiceDestinationByNDIDParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -723,7 +687,7 @@ func (app *ABCIApplication) validateDisableServiceDestinationByNDID(funcParam Di
 		}
 	}
 	// Check role is AS
-	if appTypes.NodeRole(nodeDetail.Role) != appTypes.NodeRoleAs {
+	if !hasNodeRole(&nodeDetail, appTypes.NodeRoleAs) {
 		return &ApplicationError{
 			Code:    code.RoleIsNotAS,
 			Message: "Role of node ID is not AS",
@@ -809,16 +773,10 @@ type EnableServiceDestinationByNDIDParam struct {
 
 func (app *ABCIApplication) validateEnableServiceDestinationByNDID(funcParam EnableServiceDestinationByNDIDParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -865,7 +823,7 @@ func (app *ABCIApplication) validateEnableServiceDestinationByNDID(funcParam Ena
 		}
 	}
 	// Check role is AS
-	if appTypes.NodeRole(nodeDetail.Role) != appTypes.NodeRoleAs {
+	if !hasNodeRole(&nodeDetail, appTypes.NodeRoleAs) {
 		return &ApplicationError{
 			Code:    code.RoleIsNotAS,
 			Message: "Role of node ID is not AS",
@@ -24,11 +24,11 @@ package app
 
 import (
 	"encoding/json"
-	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"google.golang.org/protobuf/proto"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
@@ -46,16 +46,10 @@ type PriceCeilingByCurrency struct {
 
 func (app *ABCIApplication) validateSetServicePriceCeiling(funcParam SetServicePriceCeilingParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -213,16 +207,10 @@ type SetServicePriceMinEffectiveDatetimeDelayParam struct {
 
 func (app *ABCIApplication) validateSetServicePriceMinEffectiveDatetimeDelay(funcParam SetServicePriceMinEffectiveDatetimeDelayParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeServices, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -285,20 +273,26 @@ func (app *ABCIApplication) setServicePriceMinEffectiveDatetimeDelay(param []byt
 		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 	}
 
-	servicePriceMinEffectiveDatetimeDelay := data.ServicePriceMinEffectiveDatetimeDelay{
-		DurationSecond: funcParam.DurationSecond,
-	}
-	servicePriceMinEffectiveDatetimeDelayBytes, err := utils.ProtoDeterministicMarshal(&servicePriceMinEffectiveDatetimeDelay)
-	if err != nil {
-		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
-	}
-
 	if funcParam.ServiceID != "" {
+		servicePriceMinEffectiveDatetimeDelay := data.ServicePriceMinEffectiveDatetimeDelay{
+			DurationSecond: funcParam.DurationSecond,
+		}
+		servicePriceMinEffectiveDatetimeDelayBytes, err := utils.ProtoDeterministicMarshal(&servicePriceMinEffectiveDatetimeDelay)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+
 		key := servicePriceMinEffectiveDatetimeDelayKeyPrefix + keySeparator + funcParam.ServiceID
 		app.state.Set([]byte(key), servicePriceMinEffectiveDatetimeDelayBytes)
 	} else {
 		// global / fallback from specific service ID
-		app.state.Set(servicePriceMinEffectiveDatetimeDelayKeyBytes, servicePriceMinEffectiveDatetimeDelayBytes)
+		err = app.storeParameter("ServicePriceMinEffectiveDatetimeDelay", int64(funcParam.DurationSecond))
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+		}
 	}
 
 	return app.NewExecTxResult(code.OK, "success", "")
@@ -336,24 +330,17 @@ func (app *ABCIApplication) getServicePriceMinEffectiveDatetimeDelay(param []byt
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
+	}
 
-		// get global / fallback from specific service ID
-		if servicePriceMinEffectiveDatetimeDelayBytes == nil {
-			servicePriceMinEffectiveDatetimeDelayBytes, err = app.state.Get(servicePriceMinEffectiveDatetimeDelayKeyBytes, false)
-			if err != nil {
-				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-			}
-		}
-	} else {
-		servicePriceMinEffectiveDatetimeDelayBytes, err = app.state.Get(servicePriceMinEffectiveDatetimeDelayKeyBytes, false)
+	// get global / fallback from specific service ID
+	if servicePriceMinEffectiveDatetimeDelayBytes == nil {
+		value, _, err := app.getParameterValue("ServicePriceMinEffectiveDatetimeDelay", false)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-	}
 
-	if servicePriceMinEffectiveDatetimeDelayBytes == nil {
 		var retVal GetServicePriceMinEffectiveDatetimeDelayResult
-		retVal.DurationSecond = uint32(12 * time.Hour / time.Second) // return default (12 hrs)
+		retVal.DurationSecond = uint32(value.(int64))
 		retValJSON, err := json.Marshal(retVal)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
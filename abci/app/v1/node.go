@@ -28,7 +28,6 @@ import (
 	"strings"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 	"google.golang.org/protobuf/proto"
 
 	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
@@ -38,8 +37,13 @@ import (
 )
 
 type MsqAddress struct {
-	IP   string `json:"ip"`
-	Port int64  `json:"port"`
+	IP                        string `json:"ip"`
+	Port                      int64  `json:"port"`
+	Hostname                  string `json:"hostname,omitempty"`
+	Protocol                  string `json:"protocol,omitempty"`
+	TLSCertificateFingerprint string `json:"tls_certificate_fingerprint,omitempty"`
+	Priority                  int32  `json:"priority,omitempty"`
+	Weight                    int32  `json:"weight,omitempty"`
 }
 
 type SetMqAddressesParam struct {
@@ -64,16 +68,21 @@ func (app *ABCIApplication) validateSetMqAddresses(funcParam SetMqAddressesParam
 			Message: err.Error(),
 		}
 	}
-	if appTypes.NodeRole(node.Role) != appTypes.NodeRoleRp &&
-		appTypes.NodeRole(node.Role) != appTypes.NodeRoleIdp &&
-		appTypes.NodeRole(node.Role) != appTypes.NodeRoleAs &&
-		appTypes.NodeRole(node.Role) != appTypes.NodeRoleProxy {
+	if !hasAnyNodeRole(&node, appTypes.NodeRoleRp, appTypes.NodeRoleIdp, appTypes.NodeRoleAs, appTypes.NodeRoleProxy) {
 		return &ApplicationError{
 			Code:    code.NoPermissionForSetMqAddresses,
 			Message: "This node does not have permission to set MQ addresses",
 		}
 	}
 
+	// stateless
+	for _, address := range funcParam.Addresses {
+		err = validateMqAddress(address)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -123,10 +132,7 @@ func (app *ABCIApplication) setMqAddresses(param []byte, callerNodeID string) *a
 	}
 	var msqAddress []*data.MQ
 	for _, address := range funcParam.Addresses {
-		var msq data.MQ
-		msq.Ip = address.IP
-		msq.Port = address.Port
-		msqAddress = append(msqAddress, &msq)
+		msqAddress = append(msqAddress, newMQ(address))
 	}
 	nodeDetail.Mq = msqAddress
 
@@ -309,6 +315,7 @@ type GetIdpNodesParam struct {
 	NodeIDList                             []string `json:"node_id_list"`
 	SupportedRequestMessageDataUrlTypeList []string `json:"supported_request_message_data_url_type_list"`
 	ModeList                               []int32  `json:"mode_list"`
+	MaxHeartbeatStaleness                  *int64   `json:"max_heartbeat_staleness"` // seconds
 }
 
 type GetIdpNodesResult struct {
@@ -336,6 +343,10 @@ func (app *ABCIApplication) getIdpNodes(param []byte) *abcitypes.ResponseQuery {
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	err = validateMaxHeartbeatStaleness(funcParam.MaxHeartbeatStaleness)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
 
 	// fetch Filter RP node detail
 	var nodeToFilterForDetail *data.NodeDetail
@@ -380,6 +391,10 @@ func (app *ABCIApplication) getIdpNodes(param []byte) *abcitypes.ResponseQuery {
 		if !nodeDetail.Active {
 			return nil
 		}
+		// Filter by heartbeat staleness
+		if app.isNodeStale(&nodeDetail, nodeID, funcParam.MaxHeartbeatStaleness) {
+			return nil
+		}
 		// check Max IAL && AAL
 		if !(nodeDetail.MaxIal >= funcParam.MinIal &&
 			nodeDetail.MaxAal >= funcParam.MinAal) {
@@ -481,8 +496,13 @@ func (app *ABCIApplication) getIdpNodes(param []byte) *abcitypes.ResponseQuery {
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-		returnNodes.Node = make([]MsqDestinationNode, 0, len(refGroup.Idps))
-		for _, idp := range refGroup.Idps {
+		idps := refGroup.Idps
+		// frozen reference group has no IdP to serve requests
+		if refGroup.Frozen {
+			idps = nil
+		}
+		returnNodes.Node = make([]MsqDestinationNode, 0, len(idps))
+		for _, idp := range idps {
 			// check IdP has Association with Identity
 			if !idp.Active {
 				continue
@@ -534,8 +554,9 @@ func (app *ABCIApplication) getIdpNodes(param []byte) *abcitypes.ResponseQuery {
 }
 
 type GetAsNodesByServiceIdParam struct {
-	ServiceID  string   `json:"service_id"`
-	NodeIDList []string `json:"node_id_list"`
+	ServiceID             string   `json:"service_id"`
+	NodeIDList            []string `json:"node_id_list"`
+	MaxHeartbeatStaleness *int64   `json:"max_heartbeat_staleness"` // seconds
 }
 
 type GetAsNodesByServiceIdResult struct {
@@ -570,6 +591,10 @@ func (app *ABCIApplication) getAsNodesByServiceId(param []byte) *abcitypes.Respo
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	err = validateMaxHeartbeatStaleness(funcParam.MaxHeartbeatStaleness)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
 	key := serviceDestinationKeyPrefix + keySeparator + funcParam.ServiceID
 	value, err := app.state.Get([]byte(key), true)
 	if err != nil {
@@ -667,6 +692,10 @@ func (app *ABCIApplication) getAsNodesByServiceId(param []byte) *abcitypes.Respo
 		if !nodeDetail.Active {
 			continue
 		}
+		// filter by heartbeat staleness
+		if app.isNodeStale(&nodeDetail, storedData.Node[index].NodeId, funcParam.MaxHeartbeatStaleness) {
+			continue
+		}
 		var newRow = ASNodeResult{
 			storedData.Node[index].NodeId,
 			nodeDetail.NodeName,
@@ -715,10 +744,7 @@ func (app *ABCIApplication) getMqAddresses(param []byte) *abcitypes.ResponseQuer
 	}
 	var result GetMqAddressesResult
 	for _, msq := range nodeDetail.Mq {
-		var newRow MsqAddress
-		newRow.IP = msq.Ip
-		newRow.Port = msq.Port
-		result = append(result, newRow)
+		result = append(result, getMsqAddress(msq))
 	}
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -731,23 +757,35 @@ func (app *ABCIApplication) getMqAddresses(param []byte) *abcitypes.ResponseQuer
 }
 
 type UpdateNodeParam struct {
-	SigningPublicKey                       string   `json:"signing_public_key"`
-	SigningAlgorithm                       string   `json:"signing_algorithm"`
-	SigningMasterPublicKey                 string   `json:"signing_master_public_key"`
-	SigningMasterAlgorithm                 string   `json:"signing_master_algorithm"`
-	EncryptionPublicKey                    string   `json:"encryption_public_key"`
-	EncryptionAlgorithm                    string   `json:"encryption_algorithm"`
-	SupportedRequestMessageDataUrlTypeList []string `json:"supported_request_message_data_url_type_list"`
+	SigningPublicKey                       string            `json:"signing_public_key"`
+	SigningAlgorithm                       string            `json:"signing_algorithm"`
+	SigningMasterPublicKey                 string            `json:"signing_master_public_key"`
+	SigningMasterAlgorithm                 string            `json:"signing_master_algorithm"`
+	EncryptionPublicKey                    string            `json:"encryption_public_key"`
+	EncryptionAlgorithm                    string            `json:"encryption_algorithm"`
+	SupportedRequestMessageDataUrlTypeList []string          `json:"supported_request_message_data_url_type_list"`
+	Profile                                *NodeProfileParam `json:"profile"`
 }
 
 func (app *ABCIApplication) validateUpdateNode(funcParam UpdateNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// stateless
 
+	certOpts, err := app.getNodeCertificateVerifyOptions(committedState)
+	if err != nil {
+		return err
+	}
+
+	err = validateNodeProfileParam(funcParam.Profile, false)
+	if err != nil {
+		return err
+	}
+
 	// Validate master public key format
 	if funcParam.SigningMasterPublicKey != "" {
 		err := checkPubKeyForSigning(
 			funcParam.SigningMasterPublicKey,
 			appTypes.SignatureAlgorithm(funcParam.SigningMasterAlgorithm),
+			certOpts,
 		)
 		if err != nil {
 			return err
@@ -759,6 +797,7 @@ func (app *ABCIApplication) validateUpdateNode(funcParam UpdateNodeParam, caller
 		err := checkPubKeyForSigning(
 			funcParam.SigningPublicKey,
 			appTypes.SignatureAlgorithm(funcParam.SigningAlgorithm),
+			certOpts,
 		)
 		if err != nil {
 			return err
@@ -767,7 +806,7 @@ func (app *ABCIApplication) validateUpdateNode(funcParam UpdateNodeParam, caller
 
 	// Validate encryption public key format
 	if funcParam.EncryptionPublicKey != "" {
-		err := checkPubKeyForEncryption(funcParam.EncryptionPublicKey)
+		err := checkPubKeyForEncryption(funcParam.EncryptionPublicKey, certOpts)
 		if err != nil {
 			return err
 		}
@@ -857,13 +896,12 @@ func (app *ABCIApplication) updateNode(param []byte, callerNodeID string) *abcit
 		app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 
 		// new key
-		nodeDetail.SigningMasterPublicKey = &data.NodeKey{
-			PublicKey:           funcParam.SigningMasterPublicKey,
-			Algorithm:           funcParam.SigningMasterAlgorithm,
-			Version:             nodeDetail.SigningMasterPublicKey.Version + 1,
-			CreationBlockHeight: app.state.CurrentBlockHeight,
-			CreationChainId:     app.CurrentChain,
-			Active:              true,
+		nodeDetail.SigningMasterPublicKey, err = app.newNodeKey(funcParam.SigningMasterPublicKey, funcParam.SigningMasterAlgorithm, nodeDetail.SigningMasterPublicKey.Version+1)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 		}
 		// create key version history
 		nodeKeyKey =
@@ -893,13 +931,12 @@ func (app *ABCIApplication) updateNode(param []byte, callerNodeID string) *abcit
 		app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 
 		// new key
-		nodeDetail.SigningPublicKey = &data.NodeKey{
-			PublicKey:           funcParam.SigningPublicKey,
-			Algorithm:           funcParam.SigningAlgorithm,
-			Version:             nodeDetail.SigningPublicKey.Version + 1,
-			CreationBlockHeight: app.state.CurrentBlockHeight,
-			CreationChainId:     app.CurrentChain,
-			Active:              true,
+		nodeDetail.SigningPublicKey, err = app.newNodeKey(funcParam.SigningPublicKey, funcParam.SigningAlgorithm, nodeDetail.SigningPublicKey.Version+1)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 		}
 		// create key version history
 		nodeKeyKey =
@@ -929,13 +966,12 @@ func (app *ABCIApplication) updateNode(param []byte, callerNodeID string) *abcit
 		app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 
 		// new key
-		nodeDetail.EncryptionPublicKey = &data.NodeKey{
-			PublicKey:           funcParam.EncryptionPublicKey,
-			Algorithm:           funcParam.EncryptionAlgorithm,
-			Version:             nodeDetail.EncryptionPublicKey.Version + 1,
-			CreationBlockHeight: app.state.CurrentBlockHeight,
-			CreationChainId:     app.CurrentChain,
-			Active:              true,
+		nodeDetail.EncryptionPublicKey, err = app.newNodeKey(funcParam.EncryptionPublicKey, funcParam.EncryptionAlgorithm, nodeDetail.EncryptionPublicKey.Version+1)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
 		}
 		// create key version history
 		nodeKeyKey =
@@ -950,9 +986,10 @@ func (app *ABCIApplication) updateNode(param []byte, callerNodeID string) *abcit
 		app.state.Set([]byte(nodeKeyKey), []byte(nodeKeyValue))
 	}
 	// update SupportedRequestMessageDataUrlTypeList and Role of node ID is IdP
-	if funcParam.SupportedRequestMessageDataUrlTypeList != nil && appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp {
+	if funcParam.SupportedRequestMessageDataUrlTypeList != nil && hasNodeRole(&nodeDetail, appTypes.NodeRoleIdp) {
 		nodeDetail.SupportedRequestMessageDataUrlTypeList = funcParam.SupportedRequestMessageDataUrlTypeList
 	}
+	applyNodeProfileParam(&nodeDetail, funcParam.Profile, app.state.CurrentBlockHeight)
 	nodeDetailValue, err := utils.ProtoDeterministicMarshal(&nodeDetail)
 	if err != nil {
 		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
@@ -967,11 +1004,12 @@ type GetNodeInfoParam struct {
 }
 
 type GetNodeInfoResult struct {
-	SigningPublicKey       NodeKey `json:"signing_public_key"`
-	SigningMasterPublicKey NodeKey `json:"signing_master_public_key"`
-	EncryptionPublicKey    NodeKey `json:"encryption_public_key"`
-	NodeName               string  `json:"node_name"`
-	Role                   string  `json:"role"`
+	SigningPublicKey       NodeKey  `json:"signing_public_key"`
+	SigningMasterPublicKey NodeKey  `json:"signing_master_public_key"`
+	EncryptionPublicKey    NodeKey  `json:"encryption_public_key"`
+	NodeName               string   `json:"node_name"`
+	Role                   string   `json:"role"`
+	Roles                  []string `json:"roles"`
 	// for IdP
 	MaxIal                                 *float64  `json:"max_ial,omitempty"`
 	MaxAal                                 *float64  `json:"max_aal,omitempty"`
@@ -984,17 +1022,19 @@ type GetNodeInfoResult struct {
 	// for node behind proxy
 	Proxy *ProxyNodeInfo `json:"proxy,omitempty"`
 	// for all
-	Mq     []MsqAddress `json:"mq"`
-	Active bool         `json:"active"`
+	Mq      []MsqAddress `json:"mq"`
+	Active  bool         `json:"active"`
+	Profile *NodeProfile `json:"profile,omitempty"`
 }
 
 type NodeKey struct {
-	PublicKey           string `json:"public_key"`
-	Algorithm           string `json:"algorithm"`
-	Version             int64  `json:"version"`
-	CreationBlockHeight int64  `json:"creation_block_height"`
-	CreationChainID     string `json:"creation_chain_id"`
-	Active              bool   `json:"active"`
+	PublicKey           string                  `json:"public_key"`
+	Algorithm           string                  `json:"algorithm"`
+	Version             int64                   `json:"version"`
+	CreationBlockHeight int64                   `json:"creation_block_height"`
+	CreationChainID     string                  `json:"creation_chain_id"`
+	Active              bool                    `json:"active"`
+	CertificateSubject  *NodeCertificateSubject `json:"certificate_subject,omitempty"`
 }
 
 type ProxyNodeInfo struct {
@@ -1037,6 +1077,7 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 			CreationBlockHeight: nodeDetail.SigningPublicKey.CreationBlockHeight,
 			CreationChainID:     nodeDetail.SigningPublicKey.CreationChainId,
 			Active:              nodeDetail.SigningPublicKey.Active,
+			CertificateSubject:  getNodeCertificateSubject(nodeDetail.SigningPublicKey),
 		},
 		SigningMasterPublicKey: NodeKey{
 			PublicKey:           nodeDetail.SigningMasterPublicKey.PublicKey,
@@ -1045,6 +1086,7 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 			CreationBlockHeight: nodeDetail.SigningMasterPublicKey.CreationBlockHeight,
 			CreationChainID:     nodeDetail.SigningMasterPublicKey.CreationChainId,
 			Active:              nodeDetail.SigningMasterPublicKey.Active,
+			CertificateSubject:  getNodeCertificateSubject(nodeDetail.SigningMasterPublicKey),
 		},
 		EncryptionPublicKey: NodeKey{
 			PublicKey:           nodeDetail.EncryptionPublicKey.PublicKey,
@@ -1053,16 +1095,16 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 			CreationBlockHeight: nodeDetail.EncryptionPublicKey.CreationBlockHeight,
 			CreationChainID:     nodeDetail.EncryptionPublicKey.CreationChainId,
 			Active:              nodeDetail.EncryptionPublicKey.Active,
+			CertificateSubject:  getNodeCertificateSubject(nodeDetail.EncryptionPublicKey),
 		},
 		NodeName: nodeDetail.NodeName,
 		Role:     nodeDetail.Role,
+		Roles:    getNodeRoleStringList(&nodeDetail),
 		Active:   nodeDetail.Active,
+		Profile:  getNodeProfile(&nodeDetail),
 	}
 	for _, mq := range nodeDetail.Mq {
-		result.Mq = append(result.Mq, MsqAddress{
-			IP:   mq.Ip,
-			Port: mq.Port,
-		})
+		result.Mq = append(result.Mq, getMsqAddress(mq))
 	}
 
 	// If node behind proxy
@@ -1113,10 +1155,7 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 			Config: nodeDetail.ProxyConfig,
 		}
 		for _, mq := range proxyNode.Mq {
-			proxy.Mq = append(proxy.Mq, MsqAddress{
-				IP:   mq.Ip,
-				Port: mq.Port,
-			})
+			proxy.Mq = append(proxy.Mq, getMsqAddress(mq))
 		}
 
 		result.Proxy = &proxy
@@ -1125,7 +1164,7 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 	supportedFeatureList := append(make([]string, 0), nodeDetail.SupportedFeatureList...)
 	result.SupportedFeatureList = supportedFeatureList
 
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp {
+	if hasNodeRole(&nodeDetail, appTypes.NodeRoleIdp) {
 		result.MaxIal = &nodeDetail.MaxIal
 		result.MaxAal = &nodeDetail.MaxAal
 		supportedRequestMessageDataUrlTypeList := append(make([]string, 0), nodeDetail.SupportedRequestMessageDataUrlTypeList...)
@@ -1133,8 +1172,7 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 		result.IsIdpAgent = &nodeDetail.IsIdpAgent
 	}
 
-	if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp ||
-		appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleRp {
+	if hasAnyNodeRole(&nodeDetail, appTypes.NodeRoleIdp, appTypes.NodeRoleRp) {
 		result.UseWhitelist = &nodeDetail.UseWhitelist
 		if nodeDetail.UseWhitelist {
 			result.Whitelist = &nodeDetail.Whitelist
@@ -1148,8 +1186,14 @@ func (app *ABCIApplication) getNodeInfo(param []byte) *abcitypes.ResponseQuery {
 	return app.NewResponseQuery(value, "success", app.state.Height)
 }
 
+type GetIdpNodesInfoParam struct {
+	GetIdpNodesParam
+	PaginationParam
+}
+
 type GetIdpNodesInfoResult struct {
-	Node []IdpNode `json:"node"`
+	Node       []IdpNode `json:"node"`
+	NextCursor string    `json:"next_cursor,omitempty"`
 }
 
 type IdpNode struct {
@@ -1168,6 +1212,7 @@ type IdpNode struct {
 	ModeList                               *[]int32      `json:"mode_list,omitempty"`
 	SupportedRequestMessageDataUrlTypeList []string      `json:"supported_request_message_data_url_type_list"`
 	Proxy                                  *IdpNodeProxy `json:"proxy,omitempty"`
+	Profile                                *NodeProfile  `json:"profile,omitempty"`
 }
 
 type IdpNodeProxy struct {
@@ -1180,11 +1225,15 @@ type IdpNodeProxy struct {
 
 func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQuery {
 	app.logger.Infof("GetIdpNodesInfo, Parameter: %s", param)
-	var funcParam GetIdpNodesParam
+	var funcParam GetIdpNodesInfoParam
 	err := json.Unmarshal(param, &funcParam)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	err = validateMaxHeartbeatStaleness(funcParam.MaxHeartbeatStaleness)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
 
 	// fetch Filter RP node detail
 	var nodeToFilterForDetail *data.NodeDetail
@@ -1229,6 +1278,10 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 		if !nodeDetail.Active {
 			return nil
 		}
+		// Filter by heartbeat staleness
+		if app.isNodeStale(&nodeDetail, nodeID, funcParam.MaxHeartbeatStaleness) {
+			return nil
+		}
 		// check Max IAL && AAL
 		if !(nodeDetail.MaxIal >= funcParam.MinIal &&
 			nodeDetail.MaxAal >= funcParam.MinAal) {
@@ -1311,10 +1364,7 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 				Config: nodeDetail.ProxyConfig,
 			}
 			for _, mq := range proxyNode.Mq {
-				proxy.Mq = append(proxy.Mq, MsqAddress{
-					IP:   mq.Ip,
-					Port: mq.Port,
-				})
+				proxy.Mq = append(proxy.Mq, getMsqAddress(mq))
 			}
 		}
 
@@ -1350,13 +1400,11 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 			Whitelist:                              whitelist,
 			SupportedRequestMessageDataUrlTypeList: append(make([]string, 0), nodeDetail.SupportedRequestMessageDataUrlTypeList...),
 			Proxy:                                  proxy,
+			Profile:                                getNodeProfile(&nodeDetail),
 		}
 
 		for _, mq := range nodeDetail.Mq {
-			idpNode.Mq = append(idpNode.Mq, MsqAddress{
-				IP:   mq.Ip,
-				Port: mq.Port,
-			})
+			idpNode.Mq = append(idpNode.Mq, getMsqAddress(mq))
 		}
 
 		return idpNode
@@ -1365,16 +1413,18 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 	var returnNodes GetIdpNodesInfoResult
 
 	if funcParam.ReferenceGroupCode == "" && funcParam.IdentityNamespace == "" && funcParam.IdentityIdentifierHash == "" {
-		var idpsList data.IdPList
+		// fetch every idp nodes from IdPList
+		returnNodes.Node = make([]IdpNode, 0)
 		idpsValue, err := app.state.Get(idpListKeyBytes, true)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
 		if idpsValue != nil {
-			if err := proto.Unmarshal(idpsValue, &idpsList); err != nil {
+			var idpsList data.IdPList
+			err := proto.Unmarshal(idpsValue, &idpsList)
+			if err != nil {
 				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 			}
-			returnNodes.Node = make([]IdpNode, 0, len(idpsList.NodeId))
 			for _, idp := range idpsList.NodeId {
 				if idpNode := getIdpNode(idp); idpNode != nil {
 					returnNodes.Node = append(returnNodes.Node, *idpNode)
@@ -1409,7 +1459,12 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-		for _, idp := range refGroup.Idps {
+		idps := refGroup.Idps
+		// frozen reference group has no IdP to serve requests
+		if refGroup.Frozen {
+			idps = nil
+		}
+		for _, idp := range idps {
 			// check IdP has Association with Identity
 			if !idp.Active {
 				continue
@@ -1443,6 +1498,15 @@ func (app *ABCIApplication) getIdpNodesInfo(param []byte) *abcitypes.ResponseQue
 			}
 		}
 	}
+
+	nodeIDs := make([]string, 0, len(returnNodes.Node))
+	for _, idpNode := range returnNodes.Node {
+		nodeIDs = append(nodeIDs, idpNode.NodeID)
+	}
+	start, end, nextCursor := paginateIDList(nodeIDs, funcParam.PaginationParam)
+	returnNodes.Node = returnNodes.Node[start:end]
+	returnNodes.NextCursor = nextCursor
+
 	value, err := json.Marshal(returnNodes)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
@@ -1466,6 +1530,7 @@ type ASWithMqNode struct {
 	EncryptionPublicKey    NodeKey      `json:"encryption_public_key"`
 	Mq                     []MsqAddress `json:"mq"`
 	SupportedNamespaceList []string     `json:"supported_namespace_list"`
+	Profile                *NodeProfile `json:"profile,omitempty"`
 }
 
 type ASWithMqNodeBehindProxy struct {
@@ -1483,6 +1548,7 @@ type ASWithMqNodeBehindProxy struct {
 		Mq                  []MsqAddress `json:"mq"`
 		Config              string       `json:"config"`
 	} `json:"proxy"`
+	Profile *NodeProfile `json:"profile,omitempty"`
 }
 
 func (app *ABCIApplication) getAsNodesInfoByServiceId(param []byte) *abcitypes.ResponseQuery {
@@ -1492,6 +1558,10 @@ func (app *ABCIApplication) getAsNodesInfoByServiceId(param []byte) *abcitypes.R
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	err = validateMaxHeartbeatStaleness(funcParam.MaxHeartbeatStaleness)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
 	key := serviceDestinationKeyPrefix + keySeparator + funcParam.ServiceID
 	value, err := app.state.Get([]byte(key), true)
 	if err != nil {
@@ -1592,6 +1662,10 @@ func (app *ABCIApplication) getAsNodesInfoByServiceId(param []byte) *abcitypes.R
 		if !nodeDetail.Active {
 			continue
 		}
+		// filter by heartbeat staleness
+		if app.isNodeStale(&nodeDetail, storedData.Node[index].NodeId, funcParam.MaxHeartbeatStaleness) {
+			continue
+		}
 		// If node is behind proxy
 		if nodeDetail.ProxyNodeId != "" {
 			proxyNodeID := nodeDetail.ProxyNodeId
@@ -1654,21 +1728,16 @@ func (app *ABCIApplication) getAsNodesInfoByServiceId(param []byte) *abcitypes.R
 			}
 			if proxyNode.Mq != nil {
 				for _, mq := range proxyNode.Mq {
-					var msq MsqAddress
-					msq.IP = mq.Ip
-					msq.Port = mq.Port
-					as.Proxy.Mq = append(as.Proxy.Mq, msq)
+					as.Proxy.Mq = append(as.Proxy.Mq, getMsqAddress(mq))
 				}
 			}
 			as.Proxy.Config = nodeDetail.ProxyConfig
+			as.Profile = getNodeProfile(&nodeDetail)
 			result.Node = append(result.Node, as)
 		} else {
 			var msqAddress []MsqAddress
 			for _, mq := range nodeDetail.Mq {
-				var msq MsqAddress
-				msq.IP = mq.Ip
-				msq.Port = mq.Port
-				msqAddress = append(msqAddress, msq)
+				msqAddress = append(msqAddress, getMsqAddress(mq))
 			}
 			var newRow = ASWithMqNode{
 				ID:     storedData.Node[index].NodeId,
@@ -1693,6 +1762,7 @@ func (app *ABCIApplication) getAsNodesInfoByServiceId(param []byte) *abcitypes.R
 				},
 				Mq:                     msqAddress,
 				SupportedNamespaceList: storedData.Node[index].SupportedNamespaceList,
+				Profile:                getNodeProfile(&nodeDetail),
 			}
 			result.Node = append(result.Node, newRow)
 		}
@@ -1716,6 +1786,7 @@ type IdPBehindProxy struct {
 	NodeID                                 string   `json:"node_id"`
 	NodeName                               string   `json:"node_name"`
 	Role                                   string   `json:"role"`
+	Roles                                  []string `json:"roles"`
 	SigningPublicKey                       NodeKey  `json:"signing_public_key"`
 	SigningMasterPublicKey                 NodeKey  `json:"signing_master_public_key"`
 	EncryptionPublicKey                    NodeKey  `json:"encryption_public_key"`
@@ -1731,6 +1802,7 @@ type ASorRPBehindProxy struct {
 	NodeID                 string   `json:"node_id"`
 	NodeName               string   `json:"node_name"`
 	Role                   string   `json:"role"`
+	Roles                  []string `json:"roles"`
 	SigningPublicKey       NodeKey  `json:"signing_public_key"`
 	SigningMasterPublicKey NodeKey  `json:"signing_master_public_key"`
 	EncryptionPublicKey    NodeKey  `json:"encryption_public_key"`
@@ -1785,11 +1857,12 @@ func (app *ABCIApplication) getNodesBehindProxyNode(param []byte) *abcitypes.Res
 			continue
 		}
 
-		if appTypes.NodeRole(nodeDetail.Role) == appTypes.NodeRoleIdp {
+		if hasNodeRole(&nodeDetail, appTypes.NodeRoleIdp) {
 			var row IdPBehindProxy
 			row.NodeID = node
 			row.NodeName = nodeDetail.NodeName
 			row.Role = nodeDetail.Role
+			row.Roles = getNodeRoleStringList(&nodeDetail)
 			row.SigningPublicKey = NodeKey{
 				PublicKey:           nodeDetail.SigningPublicKey.PublicKey,
 				Algorithm:           nodeDetail.SigningPublicKey.Algorithm,
@@ -1826,6 +1899,7 @@ func (app *ABCIApplication) getNodesBehindProxyNode(param []byte) *abcitypes.Res
 			row.NodeID = node
 			row.NodeName = nodeDetail.NodeName
 			row.Role = nodeDetail.Role
+			row.Roles = getNodeRoleStringList(&nodeDetail)
 			row.SigningPublicKey = NodeKey{
 				PublicKey:           nodeDetail.SigningPublicKey.PublicKey,
 				Algorithm:           nodeDetail.SigningPublicKey.Algorithm,
@@ -1867,11 +1941,32 @@ func (app *ABCIApplication) getNodesBehindProxyNode(param []byte) *abcitypes.Res
 }
 
 type GetNodeIDListParam struct {
+	PaginationParam
 	Role string `json:"role"`
+	// Active filters by node active flag, active nodes only when not set
+	Active      *bool  `json:"active"`
+	BehindProxy *bool  `json:"behind_proxy"`
+	ProxyNodeID string `json:"proxy_node_id"`
 }
 
 type GetNodeIDListResult struct {
 	NodeIDList []string `json:"node_id_list"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// getNodeIDListKey returns key of node ID list to list nodes of given role from.
+// Node IDs are in registration order. Role other than RP, IdP and AS lists all nodes.
+func getNodeIDListKey(role string) []byte {
+	switch strings.ToLower(role) {
+	case "rp":
+		return []byte("rpList")
+	case "idp":
+		return idpListKeyBytes
+	case "as":
+		return []byte("asList")
+	default:
+		return []byte("allList")
+	}
 }
 
 func (app *ABCIApplication) getNodeIDList(param []byte) *abcitypes.ResponseQuery {
@@ -1881,128 +1976,55 @@ func (app *ABCIApplication) getNodeIDList(param []byte) *abcitypes.ResponseQuery
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	var result GetNodeIDListResult
-	result.NodeIDList = make([]string, 0)
-	if strings.ToLower(funcParam.Role) == "rp" {
-		var rpsList data.RPList
-		rpsKey := "rpList"
-		rpsValue, err := app.state.Get([]byte(rpsKey), true)
+	active := true
+	if funcParam.Active != nil {
+		active = *funcParam.Active
+	}
+
+	// IdPList, RPList, ASList and AllList have the same message structure
+	var nodeIDList data.AllList
+	nodeIDListValue, err := app.state.Get(getNodeIDListKey(funcParam.Role), true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if nodeIDListValue != nil {
+		err := proto.Unmarshal(nodeIDListValue, &nodeIDList)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-		if rpsValue != nil {
-			err := proto.Unmarshal(rpsValue, &rpsList)
-			if err != nil {
-				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-			}
-			for _, nodeID := range rpsList.NodeId {
-				nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
-				nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), true)
-				if err != nil {
-					return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-				}
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
-		}
-	} else if strings.ToLower(funcParam.Role) == "idp" {
-		var idpsList data.IdPList
-		idpsValue, err := app.state.Get(idpListKeyBytes, true)
+	}
+
+	var result GetNodeIDListResult
+	result.NodeIDList = make([]string, 0)
+	for _, nodeID := range nodeIDList.NodeId {
+		nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
+		nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), true)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-		if idpsValue != nil {
-			err := proto.Unmarshal(idpsValue, &idpsList)
-			if err != nil {
-				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-			}
-			for _, nodeID := range idpsList.NodeId {
-				nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
-				nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), true)
-				if err != nil {
-					return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-				}
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		if nodeDetailValue == nil {
+			continue
 		}
-	} else if strings.ToLower(funcParam.Role) == "as" {
-		var asList data.ASList
-		asKey := "asList"
-		asValue, err := app.state.Get([]byte(asKey), true)
+		var nodeDetail data.NodeDetail
+		err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
 		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+			continue
 		}
-		if asValue != nil {
-			err := proto.Unmarshal(asValue, &asList)
-			if err != nil {
-				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-			}
-			for _, nodeID := range asList.NodeId {
-				nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
-				nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), true)
-				if err != nil {
-					return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-				}
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		if nodeDetail.Active != active {
+			continue
 		}
-	} else {
-		var allList data.AllList
-		allKey := "allList"
-		allValue, err := app.state.Get([]byte(allKey), true)
-		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		if funcParam.BehindProxy != nil && (nodeDetail.ProxyNodeId != "") != *funcParam.BehindProxy {
+			continue
 		}
-		if allValue != nil {
-			err := proto.Unmarshal(allValue, &allList)
-			if err != nil {
-				return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-			}
-			for _, nodeID := range allList.NodeId {
-				nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
-				nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), true)
-				if err != nil {
-					return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-				}
-				if nodeDetailValue != nil {
-					var nodeDetail data.NodeDetail
-					err := proto.Unmarshal([]byte(nodeDetailValue), &nodeDetail)
-					if err != nil {
-						continue
-					}
-					if nodeDetail.Active {
-						result.NodeIDList = append(result.NodeIDList, nodeID)
-					}
-				}
-			}
+		if funcParam.ProxyNodeID != "" && nodeDetail.ProxyNodeId != funcParam.ProxyNodeID {
+			continue
 		}
+		result.NodeIDList = append(result.NodeIDList, nodeID)
 	}
+	start, end, nextCursor := paginateIDList(result.NodeIDList, funcParam.PaginationParam)
+	result.NodeIDList = result.NodeIDList[start:end]
+	result.NextCursor = nextCursor
+
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
@@ -2014,13 +2036,17 @@ func (app *ABCIApplication) getNodeIDList(param []byte) *abcitypes.ResponseQuery
 }
 
 type getNodePublicKeyListParam struct {
-	NodeID string `json:"node_id"`
+	PaginationParam
+	NodeID  string `json:"node_id"`
+	KeyType string `json:"key_type"` // signing, signing_master or encryption
+	Active  *bool  `json:"active"`
 }
 
 type getNodePublicKeyListResult struct {
 	SigningPublicKeyList       []NodeKey `json:"signing_public_key_list"`
 	SigningMasterPublicKeyList []NodeKey `json:"signing_master_public_key_list"`
 	EncryptionPublicKeyList    []NodeKey `json:"encryption_public_key_list"`
+	NextCursor                 string    `json:"next_cursor,omitempty"`
 }
 
 func (app *ABCIApplication) getNodePublicKeyList(param []byte) *abcitypes.ResponseQuery {
@@ -2041,118 +2067,137 @@ func (app *ABCIApplication) getNodePublicKeyList(param []byte) *abcitypes.Respon
 		return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
 	}
 
-	// signing public keys
-	signingPublicKeyList := make([]NodeKey, 0)
-
-	nodeKeyKeyIteratorPrefix :=
-		nodeKeyKeyPrefix + keySeparator +
-			"signing" + keySeparator +
-			funcParam.NodeID + keySeparator
-	r := goleveldbutil.BytesPrefix([]byte(nodeKeyKeyIteratorPrefix))
-	iter, err := app.state.db.Iterator(r.Start, r.Limit)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	for ; iter.Valid(); iter.Next() {
-		value := iter.Value()
-
-		var nodeKey data.NodeKey
-		err = proto.Unmarshal(value, &nodeKey)
-		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	result := &getNodePublicKeyListResult{
+		SigningPublicKeyList:       make([]NodeKey, 0),
+		SigningMasterPublicKeyList: make([]NodeKey, 0),
+		EncryptionPublicKeyList:    make([]NodeKey, 0),
+	}
+	keyLists := []struct {
+		keyType string
+		list    *[]NodeKey
+	}{
+		{"signing", &result.SigningPublicKeyList},
+		{"signing_master", &result.SigningMasterPublicKeyList},
+		{"encryption", &result.EncryptionPublicKeyList},
+	}
+
+	// cursor is key type and key ID of last item of previous page
+	var cursorKeyType, cursorKeyID string
+	if funcParam.Cursor != "" {
+		cursor := strings.SplitN(funcParam.Cursor, keySeparator, 2)
+		if len(cursor) != 2 {
+			return app.NewResponseQuery(nil, "invalid cursor", app.state.Height)
+		}
+		cursorKeyType, cursorKeyID = cursor[0], cursor[1]
+	}
+	limit := funcParam.pageLimit()
+	remaining := limit
+	// cursor of next page when page is full before the last key type.
+	// Next page exists only when a later key type has a matching key.
+	var pageEndCursor string
+
+	for _, keyList := range keyLists {
+		if funcParam.KeyType != "" && keyList.keyType != funcParam.KeyType {
+			continue
 		}
-
-		retValNodeKey := NodeKey{
-			PublicKey:           nodeKey.PublicKey,
-			Algorithm:           nodeKey.Algorithm,
-			Version:             nodeKey.Version,
-			CreationBlockHeight: nodeKey.CreationBlockHeight,
-			CreationChainID:     nodeKey.CreationChainId,
-			Active:              nodeKey.Active,
+		pagination := PaginationParam{Limit: remaining}
+		if cursorKeyType != "" {
+			if keyList.keyType != cursorKeyType {
+				continue
+			}
+			pagination.Cursor = cursorKeyID
+			cursorKeyType = ""
+		}
+		if pageEndCursor != "" {
+			// look for one matching key without adding it to result
+			pagination.Limit = 1
 		}
 
-		signingPublicKeyList = append(signingPublicKeyList, retValNodeKey)
-	}
-	iter.Close()
-
-	// signing master public keys
-	signingMasterPublicKeyList := make([]NodeKey, 0)
-
-	nodeKeyKeyIteratorPrefix =
-		nodeKeyKeyPrefix + keySeparator +
-			"signing_master" + keySeparator +
-			funcParam.NodeID + keySeparator
-	r = goleveldbutil.BytesPrefix([]byte(nodeKeyKeyIteratorPrefix))
-	iter, err = app.state.db.Iterator(r.Start, r.Limit)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	for ; iter.Valid(); iter.Next() {
-		value := iter.Value()
-
-		var nodeKey data.NodeKey
-		err = proto.Unmarshal(value, &nodeKey)
+		nodeKeyKeyIteratorPrefix :=
+			nodeKeyKeyPrefix + keySeparator +
+				keyList.keyType + keySeparator +
+				funcParam.NodeID + keySeparator
+		lastID, hasMore, err := app.iterateCommittedKeyPrefix(
+			nodeKeyKeyIteratorPrefix,
+			pagination,
+			func(id string, value []byte) (func(), error) {
+				var nodeKey data.NodeKey
+				err := proto.Unmarshal(value, &nodeKey)
+				if err != nil {
+					return nil, err
+				}
+				if funcParam.Active != nil && nodeKey.Active != *funcParam.Active {
+					return nil, nil
+				}
+				if pageEndCursor != "" {
+					return func() {}, nil
+				}
+				return func() {
+					*keyList.list = append(*keyList.list, NodeKey{
+						PublicKey:           nodeKey.PublicKey,
+						Algorithm:           nodeKey.Algorithm,
+						Version:             nodeKey.Version,
+						CreationBlockHeight: nodeKey.CreationBlockHeight,
+						CreationChainID:     nodeKey.CreationChainId,
+						Active:              nodeKey.Active,
+					})
+				}, nil
+			},
+		)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-
-		retValNodeKey := NodeKey{
-			PublicKey:           nodeKey.PublicKey,
-			Algorithm:           nodeKey.Algorithm,
-			Version:             nodeKey.Version,
-			CreationBlockHeight: nodeKey.CreationBlockHeight,
-			CreationChainID:     nodeKey.CreationChainId,
-			Active:              nodeKey.Active,
+		if pageEndCursor != "" {
+			if lastID != "" {
+				result.NextCursor = pageEndCursor
+				break
+			}
+			continue
+		}
+		if hasMore {
+			result.NextCursor = keyList.keyType + keySeparator + lastID
+			break
+		}
+		if limit > 0 {
+			remaining -= len(*keyList.list)
+			if remaining == 0 {
+				// continue with next key type on next page
+				pageEndCursor = keyList.keyType + keySeparator + lastID
+			}
 		}
-
-		signingMasterPublicKeyList = append(signingMasterPublicKeyList, retValNodeKey)
 	}
-	iter.Close()
 
-	// encryption public keys
-	encryptionPublicKeyList := make([]NodeKey, 0)
-
-	nodeKeyKeyIteratorPrefix =
-		nodeKeyKeyPrefix + keySeparator +
-			"encryption" + keySeparator +
-			funcParam.NodeID + keySeparator
-	r = goleveldbutil.BytesPrefix([]byte(nodeKeyKeyIteratorPrefix))
-	iter, err = app.state.db.Iterator(r.Start, r.Limit)
+	resultJSON, err := json.Marshal(result)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	for ; iter.Valid(); iter.Next() {
-		value := iter.Value()
 
-		var nodeKey data.NodeKey
-		err = proto.Unmarshal(value, &nodeKey)
-		if err != nil {
-			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-		}
+	return app.NewResponseQuery(resultJSON, "success", app.state.Height)
+}
 
-		retValNodeKey := NodeKey{
-			PublicKey:           nodeKey.PublicKey,
-			Algorithm:           nodeKey.Algorithm,
-			Version:             nodeKey.Version,
-			CreationBlockHeight: nodeKey.CreationBlockHeight,
-			CreationChainID:     nodeKey.CreationChainId,
-			Active:              nodeKey.Active,
+// getNodeDetail returns node detail of given node ID. Returns NodeIDNotFound error when node does not exist.
+func (app *ABCIApplication) getNodeDetail(nodeID string, committedState bool) (*data.NodeDetail, error) {
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
+	nodeDetailValue, err := app.state.Get([]byte(nodeDetailKey), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
 		}
-
-		encryptionPublicKeyList = append(encryptionPublicKeyList, retValNodeKey)
 	}
-	iter.Close()
-
-	result := &getNodePublicKeyListResult{
-		SigningPublicKeyList:       signingPublicKeyList,
-		SigningMasterPublicKeyList: signingMasterPublicKeyList,
-		EncryptionPublicKeyList:    encryptionPublicKeyList,
+	if nodeDetailValue == nil {
+		return nil, &ApplicationError{
+			Code:    code.NodeIDNotFound,
+			Message: "Node ID not found",
+		}
 	}
-
-	resultJSON, err := json.Marshal(result)
+	var nodeDetail data.NodeDetail
+	err = proto.Unmarshal(nodeDetailValue, &nodeDetail)
 	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
 	}
-
-	return app.NewResponseQuery(resultJSON, "success", app.state.Height)
+	return &nodeDetail, nil
 }
@@ -0,0 +1,537 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+const certificatePEMBlockType = "CERTIFICATE"
+
+// nodeCertificateVerifyOptions holds NDID trusted CAs and time used to verify node certificate chain.
+// nil options means certificate is not accepted in place of public key.
+//
+// Certificate chain is verified only when node key is registered or updated.
+// Public key of the leaf certificate is stored as node key and transaction
+// signatures are verified against it without checking certificate validity
+// period again, so a node keeps signing with its key after the certificate
+// expires or its CA is removed until the key is replaced with UpdateNode.
+type nodeCertificateVerifyOptions struct {
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	currentTime   time.Time
+}
+
+func (app *ABCIApplication) getNodeCertificateAuthorityList(committedState bool) (*data.NodeCertificateAuthorityList, error) {
+	value, err := app.state.Get(nodeCertificateAuthorityListKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var caList data.NodeCertificateAuthorityList
+	if value != nil {
+		err = proto.Unmarshal(value, &caList)
+		if err != nil {
+			return nil, &ApplicationError{
+				Code:    code.UnmarshalError,
+				Message: err.Error(),
+			}
+		}
+	}
+	return &caList, nil
+}
+
+func (app *ABCIApplication) getNodeCertificateVerifyOptions(committedState bool) (*nodeCertificateVerifyOptions, error) {
+	caList, err := app.getNodeCertificateAuthorityList(committedState)
+	if err != nil {
+		return nil, err
+	}
+	opts := &nodeCertificateVerifyOptions{
+		roots:         x509.NewCertPool(),
+		intermediates: x509.NewCertPool(),
+		currentTime:   time.UnixMilli(app.state.GetBlockTime(committedState)),
+	}
+	for _, ca := range caList.Authorities {
+		certs, err := parseCertificateChain(ca.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		if ca.Root {
+			opts.roots.AddCert(certs[0])
+		} else {
+			opts.intermediates.AddCert(certs[0])
+		}
+	}
+	return opts, nil
+}
+
+func isCertificatePEM(key string) bool {
+	block, _ := pem.Decode([]byte(key))
+	return block != nil && block.Type == certificatePEMBlockType
+}
+
+// parseCertificateChain parses PEM encoded certificates. First certificate is the leaf.
+func parseCertificateChain(chain string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != certificatePEMBlockType {
+			return nil, &ApplicationError{
+				Code:    code.InvalidCertificate,
+				Message: "Invalid certificate. Unexpected PEM block type: " + block.Type,
+			}
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, &ApplicationError{
+				Code:    code.InvalidCertificate,
+				Message: err.Error(),
+			}
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, &ApplicationError{
+			Code:    code.InvalidCertificate,
+			Message: "Invalid certificate. Cannot decode PEM.",
+		}
+	}
+	return certs, nil
+}
+
+// verifyCertificateChain verifies leaf certificate of the chain against NDID trusted CAs
+// at time in options and checks that leaf certificate allows given key usage.
+// Returns leaf certificate.
+func verifyCertificateChain(chain string, keyUsage x509.KeyUsage, opts *nodeCertificateVerifyOptions) (*x509.Certificate, error) {
+	if opts == nil {
+		return nil, &ApplicationError{
+			Code:    code.CertificateNotAccepted,
+			Message: "Certificate is not accepted",
+		}
+	}
+	certs, err := parseCertificateChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	leaf := certs[0]
+
+	intermediates := opts.intermediates.Clone()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         opts.roots,
+		Intermediates: intermediates,
+		CurrentTime:   opts.currentTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		var certInvalidErr x509.CertificateInvalidError
+		if errors.As(err, &certInvalidErr) && certInvalidErr.Reason == x509.Expired {
+			return nil, &ApplicationError{
+				Code:    code.CertificateNotValidAtThisTime,
+				Message: "Certificate is expired or not yet valid",
+			}
+		}
+		var unknownAuthorityErr x509.UnknownAuthorityError
+		if errors.As(err, &unknownAuthorityErr) {
+			return nil, &ApplicationError{
+				Code:    code.CertificateNotTrusted,
+				Message: "Certificate is not signed by trusted certificate authority",
+			}
+		}
+		return nil, &ApplicationError{
+			Code:    code.InvalidCertificate,
+			Message: err.Error(),
+		}
+	}
+
+	if leaf.KeyUsage&keyUsage == 0 {
+		return nil, &ApplicationError{
+			Code:    code.CertificateKeyUsageNotAllowed,
+			Message: "Certificate key usage does not allow this key purpose",
+		}
+	}
+
+	return leaf, nil
+}
+
+// splitNodeKeyParam returns public key PEM and certificate chain to store in node key
+// from key parameter which can be either public key or certificate chain
+func splitNodeKeyParam(key string) (publicKey string, certificate string, err error) {
+	if !isCertificatePEM(key) {
+		return key, "", nil
+	}
+	certs, err := parseCertificateChain(key)
+	if err != nil {
+		return "", "", err
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(certs[0].PublicKey)
+	if err != nil {
+		return "", "", &ApplicationError{
+			Code:    code.InvalidCertificate,
+			Message: err.Error(),
+		}
+	}
+	publicKey = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKeyBytes,
+	}))
+	return publicKey, key, nil
+}
+
+type NodeCertificateSubject struct {
+	Subject            string    `json:"subject"`
+	CommonName         string    `json:"common_name"`
+	Organization       []string  `json:"organization"`
+	OrganizationalUnit []string  `json:"organizational_unit"`
+	Country            []string  `json:"country"`
+	SerialNumber       string    `json:"serial_number"`
+	Issuer             string    `json:"issuer"`
+	NotBefore          time.Time `json:"not_before"`
+	NotAfter           time.Time `json:"not_after"`
+}
+
+func getNodeCertificateSubject(nodeKey *data.NodeKey) *NodeCertificateSubject {
+	if nodeKey == nil || nodeKey.Certificate == "" {
+		return nil
+	}
+	certs, err := parseCertificateChain(nodeKey.Certificate)
+	if err != nil {
+		return nil
+	}
+	leaf := certs[0]
+	return &NodeCertificateSubject{
+		Subject:            leaf.Subject.String(),
+		CommonName:         leaf.Subject.CommonName,
+		Organization:       leaf.Subject.Organization,
+		OrganizationalUnit: leaf.Subject.OrganizationalUnit,
+		Country:            leaf.Subject.Country,
+		SerialNumber:       leaf.SerialNumber.String(),
+		Issuer:             leaf.Issuer.String(),
+		NotBefore:          leaf.NotBefore,
+		NotAfter:           leaf.NotAfter,
+	}
+}
+
+type AddNodeCertificateAuthorityParam struct {
+	CAID        string `json:"ca_id"`
+	Certificate string `json:"certificate"`
+	Root        bool   `json:"root"`
+}
+
+func (app *ABCIApplication) validateAddNodeCertificateAuthority(funcParam AddNodeCertificateAuthorityParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
+	if err != nil {
+		return err
+	}
+
+	// stateless
+
+	if funcParam.CAID == "" {
+		return &ApplicationError{
+			Code:    code.NodeCertificateAuthorityIDCannotBeEmpty,
+			Message: "Node certificate authority ID cannot be empty",
+		}
+	}
+
+	certs, err := parseCertificateChain(funcParam.Certificate)
+	if err != nil {
+		return err
+	}
+	if len(certs) != 1 {
+		return &ApplicationError{
+			Code:    code.InvalidCertificate,
+			Message: "Invalid certificate. Expected exactly one certificate.",
+		}
+	}
+	cert := certs[0]
+	if !cert.IsCA || cert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return &ApplicationError{
+			Code:    code.CertificateKeyUsageNotAllowed,
+			Message: "Certificate is not a CA certificate",
+		}
+	}
+	if funcParam.Root {
+		if err := cert.CheckSignatureFrom(cert); err != nil {
+			return &ApplicationError{
+				Code:    code.InvalidCertificate,
+				Message: "Root certificate must be self-signed",
+			}
+		}
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	caList, err := app.getNodeCertificateAuthorityList(committedState)
+	if err != nil {
+		return err
+	}
+	for _, ca := range caList.Authorities {
+		if ca.CaId == funcParam.CAID {
+			return &ApplicationError{
+				Code:    code.NodeCertificateAuthorityAlreadyExists,
+				Message: "Node certificate authority already exists",
+			}
+		}
+	}
+
+	opts, err := app.getNodeCertificateVerifyOptions(committedState)
+	if err != nil {
+		return err
+	}
+	if funcParam.Root {
+		opts.roots.AddCert(cert)
+	}
+	_, err = verifyCertificateChain(funcParam.Certificate, x509.KeyUsageCertSign, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) addNodeCertificateAuthorityCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam AddNodeCertificateAuthorityParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateAddNodeCertificateAuthority(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) addNodeCertificateAuthority(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("AddNodeCertificateAuthority, Parameter: %s", param)
+	var funcParam AddNodeCertificateAuthorityParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateAddNodeCertificateAuthority(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	caList, err := app.getNodeCertificateAuthorityList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	caList.Authorities = append(caList.Authorities, &data.NodeCertificateAuthority{
+		CaId:                funcParam.CAID,
+		Certificate:         funcParam.Certificate,
+		Root:                funcParam.Root,
+		CreationBlockHeight: app.state.CurrentBlockHeight,
+	})
+	caListValue, err := utils.ProtoDeterministicMarshal(caList)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(nodeCertificateAuthorityListKeyBytes, caListValue)
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type RemoveNodeCertificateAuthorityParam struct {
+	CAID string `json:"ca_id"`
+}
+
+func (app *ABCIApplication) validateRemoveNodeCertificateAuthority(funcParam RemoveNodeCertificateAuthorityParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
+	if err != nil {
+		return err
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	caList, err := app.getNodeCertificateAuthorityList(committedState)
+	if err != nil {
+		return err
+	}
+	for _, ca := range caList.Authorities {
+		if ca.CaId == funcParam.CAID {
+			return nil
+		}
+	}
+
+	return &ApplicationError{
+		Code:    code.NodeCertificateAuthorityNotFound,
+		Message: "Node certificate authority not found",
+	}
+}
+
+func (app *ABCIApplication) removeNodeCertificateAuthorityCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam RemoveNodeCertificateAuthorityParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateRemoveNodeCertificateAuthority(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// removeNodeCertificateAuthority removes CA from trusted list. Node keys already registered
+// with certificate issued by the CA are kept; new keys must chain to remaining CAs.
+func (app *ABCIApplication) removeNodeCertificateAuthority(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("RemoveNodeCertificateAuthority, Parameter: %s", param)
+	var funcParam RemoveNodeCertificateAuthorityParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateRemoveNodeCertificateAuthority(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	caList, err := app.getNodeCertificateAuthorityList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	authorities := make([]*data.NodeCertificateAuthority, 0, len(caList.Authorities))
+	for _, ca := range caList.Authorities {
+		if ca.CaId != funcParam.CAID {
+			authorities = append(authorities, ca)
+		}
+	}
+	caList.Authorities = authorities
+	caListValue, err := utils.ProtoDeterministicMarshal(caList)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(nodeCertificateAuthorityListKeyBytes, caListValue)
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type NodeCertificateAuthorityResult struct {
+	CAID                string `json:"ca_id"`
+	Certificate         string `json:"certificate"`
+	Root                bool   `json:"root"`
+	Subject             string `json:"subject"`
+	CreationBlockHeight int64  `json:"creation_block_height"`
+}
+
+func (app *ABCIApplication) getNodeCertificateAuthorityListQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetNodeCertificateAuthorityList, Parameter: %s", param)
+	caList, err := app.getNodeCertificateAuthorityList(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	result := make([]NodeCertificateAuthorityResult, 0, len(caList.Authorities))
+	for _, ca := range caList.Authorities {
+		subject := ""
+		if certs, err := parseCertificateChain(ca.Certificate); err == nil {
+			subject = certs[0].Subject.String()
+		}
+		result = append(result, NodeCertificateAuthorityResult{
+			CAID:                ca.CaId,
+			Certificate:         ca.Certificate,
+			Root:                ca.Root,
+			Subject:             subject,
+			CreationBlockHeight: ca.CreationBlockHeight,
+		})
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(returnValue, "success", app.state.Height)
+}
+
+// newNodeKey creates active node key at current block from key parameter
+// which can be either public key or certificate chain
+func (app *ABCIApplication) newNodeKey(key string, algorithm string, version int64) (*data.NodeKey, error) {
+	publicKey, certificate, err := splitNodeKeyParam(key)
+	if err != nil {
+		return nil, err
+	}
+	return &data.NodeKey{
+		PublicKey:           publicKey,
+		Algorithm:           algorithm,
+		Version:             version,
+		CreationBlockHeight: app.state.CurrentBlockHeight,
+		CreationChainId:     app.CurrentChain,
+		Active:              true,
+		Certificate:         certificate,
+	}, nil
+}
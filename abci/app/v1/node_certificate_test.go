@@ -0,0 +1,124 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func createTestCertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert, key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestVerifyCertificateChain(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	rootCert, rootKey, _ := createTestCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root CA"},
+		NotBefore:             now.AddDate(-1, 0, 0),
+		NotAfter:              now.AddDate(10, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+
+	_, _, signingCertPEM := createTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "node1", Organization: []string{"Test IdP"}},
+		NotBefore:    now.AddDate(0, -1, 0),
+		NotAfter:     now.AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, rootCert, rootKey)
+
+	opts := &nodeCertificateVerifyOptions{
+		roots:         x509.NewCertPool(),
+		intermediates: x509.NewCertPool(),
+		currentTime:   now,
+	}
+	opts.roots.AddCert(rootCert)
+
+	leaf, err := verifyCertificateChain(signingCertPEM, x509.KeyUsageDigitalSignature, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "node1", leaf.Subject.CommonName)
+
+	err = checkPubKeyForSigning(signingCertPEM, appTypes.SignatureAlgorithmRSAPKCS1V15SHA256, opts)
+	assert.NoError(t, err)
+
+	_, err = verifyCertificateChain(signingCertPEM, x509.KeyUsageKeyEncipherment, opts)
+	assertApplicationErrorCode(t, code.CertificateKeyUsageNotAllowed, err)
+
+	_, err = verifyCertificateChain(signingCertPEM, x509.KeyUsageDigitalSignature, nil)
+	assertApplicationErrorCode(t, code.CertificateNotAccepted, err)
+
+	expiredOpts := *opts
+	expiredOpts.currentTime = now.AddDate(2, 0, 0)
+	_, err = verifyCertificateChain(signingCertPEM, x509.KeyUsageDigitalSignature, &expiredOpts)
+	assertApplicationErrorCode(t, code.CertificateNotValidAtThisTime, err)
+
+	untrustedOpts := *opts
+	untrustedOpts.roots = x509.NewCertPool()
+	_, err = verifyCertificateChain(signingCertPEM, x509.KeyUsageDigitalSignature, &untrustedOpts)
+	assertApplicationErrorCode(t, code.CertificateNotTrusted, err)
+
+	publicKey, certificate, err := splitNodeKeyParam(signingCertPEM)
+	assert.NoError(t, err)
+	assert.Equal(t, signingCertPEM, certificate)
+	assert.NoError(t, checkPubKeyForSigning(publicKey, appTypes.SignatureAlgorithmRSAPKCS1V15SHA256, nil))
+}
+
+func TestNodeCertificateVerifyOptionsUseBlockTime(t *testing.T) {
+	app := newTestApp(t)
+	app.state.BlockTime = 1700000000000
+	app.state.CurrentBlockTime = 1700000060000
+
+	opts, err := app.getNodeCertificateVerifyOptions(true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000000000), opts.currentTime.UnixMilli())
+
+	opts, err = app.getNodeCertificateVerifyOptions(false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000060000), opts.currentTime.UnixMilli())
+}
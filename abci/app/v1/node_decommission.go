@@ -0,0 +1,569 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func (app *ABCIApplication) getDecommissionedNode(nodeID string, committedState bool) (*data.DecommissionedNode, error) {
+	key := decommissionedNodeKeyPrefix + keySeparator + nodeID
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if value == nil {
+		return nil, nil
+	}
+	var decommissionedNode data.DecommissionedNode
+	err = proto.Unmarshal(value, &decommissionedNode)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &decommissionedNode, nil
+}
+
+// checkNodeNotDecommissioned returns error if node ID has been permanently retired
+func (app *ABCIApplication) checkNodeNotDecommissioned(nodeID string, committedState bool) error {
+	key := decommissionedNodeKeyPrefix + keySeparator + nodeID
+	exists, err := app.state.Has([]byte(key), committedState)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if exists {
+		return &ApplicationError{
+			Code:    code.NodeIsDecommissioned,
+			Message: "Node has been decommissioned",
+		}
+	}
+	return nil
+}
+
+// getCommittedKeyList returns keys (without prefix) in committed state which start with given prefix
+func (app *ABCIApplication) getCommittedKeyList(prefix string) ([]string, error) {
+	r := goleveldbutil.BytesPrefix([]byte(prefix))
+	iter, err := app.state.db.Iterator(r.Start, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	keyList := make([]string, 0)
+	for ; iter.Valid(); iter.Next() {
+		keyList = append(keyList, string(iter.Key()[len(prefix):]))
+	}
+	return keyList, nil
+}
+
+// addIdPRefGroupCodeIndex records that IdP has been associated with reference group
+// so reference groups of a node can be found without scanning all reference groups.
+// Entries are kept after association is deactivated or transferred.
+// Entries for records written before the index existed are added by state migration
+// (schema version 2) which runs before any transaction of the first block of this app version.
+func (app *ABCIApplication) addIdPRefGroupCodeIndex(nodeID string, refGroupCode string) error {
+	key := []byte(idpRefGroupCodeKeyPrefix + keySeparator + nodeID + keySeparator + refGroupCode)
+	exists, err := app.state.Has(key, false)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if !exists {
+		app.state.Set(key, []byte(refGroupCode))
+	}
+	return nil
+}
+
+// addOwnedOpenRequestIndex records open request of owner node
+func (app *ABCIApplication) addOwnedOpenRequestIndex(nodeID string, requestID string) {
+	key := ownedOpenRequestKeyPrefix + keySeparator + nodeID + keySeparator + requestID
+	app.state.Set([]byte(key), []byte(requestID))
+}
+
+// removeOwnedOpenRequestIndex removes request from open requests of owner node when it is closed or timed out
+func (app *ABCIApplication) removeOwnedOpenRequestIndex(nodeID string, requestID string) error {
+	key := ownedOpenRequestKeyPrefix + keySeparator + nodeID + keySeparator + requestID
+	err := app.state.Delete([]byte(key))
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	return nil
+}
+
+// getNodeIndexValueList returns values of node index (reference group codes or request IDs)
+// in current state including changes made earlier in the block
+func (app *ABCIApplication) getNodeIndexValueList(indexKeyPrefix string, nodeID string) ([]string, error) {
+	prefix := indexKeyPrefix + keySeparator + nodeID + keySeparator
+	valueList := make([]string, 0)
+	err := app.state.IterateKeyPrefix([]byte(prefix), nil, false, func(key []byte, value []byte) (bool, error) {
+		valueList = append(valueList, string(value))
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return valueList, nil
+}
+
+func removeNodeIDFromList(nodeIDList []string, nodeID string) ([]string, bool) {
+	for i, id := range nodeIDList {
+		if id == nodeID {
+			return append(nodeIDList[:i], nodeIDList[i+1:]...), true
+		}
+	}
+	return nodeIDList, false
+}
+
+// disableIdPAssociation deactivates IdP association of node in reference group.
+// Frozen reference groups are included: freezing stops IdPs from modifying the
+// group, but the association of a decommissioned node must not stay active
+// when the group is unfrozen. Frozen state and reason are kept as is.
+// Returns true if reference group is modified.
+func disableIdPAssociation(refGroup *data.ReferenceGroup, nodeID string) bool {
+	for _, idp := range refGroup.Idps {
+		if idp.NodeId == nodeID && idp.Active {
+			idp.Active = false
+			return true
+		}
+	}
+	return false
+}
+
+// closeOwnedRequest closes request if it is still open and owned by node.
+// Returns true if request is modified.
+func closeOwnedRequest(request *data.Request, nodeID string) bool {
+	if request.Owner != nodeID || request.Closed || request.TimedOut {
+		return false
+	}
+	request.Closed = true
+	return true
+}
+
+type DecommissionNodeParam struct {
+	NodeID string `json:"node_id"`
+}
+
+func (app *ABCIApplication) validateDecommissionNode(funcParam DecommissionNodeParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
+	if err != nil {
+		return err
+	}
+
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	nodeDetail, err := app.getNodeDetail(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+	if app.isNDIDNode(nodeDetail) {
+		return &ApplicationError{
+			Code:    code.CannotDecommissionNDIDNode,
+			Message: "NDID node cannot be decommissioned",
+		}
+	}
+
+	err = app.checkNodeNotDecommissioned(funcParam.NodeID, committedState)
+	if err != nil {
+		return err
+	}
+
+	// nodes behind proxy must be moved or removed before proxy node is decommissioned
+	if app.isProxyNode(nodeDetail) {
+		behindProxyNodeKey := behindProxyNodeKeyPrefix + keySeparator + funcParam.NodeID
+		behindProxyNodeValue, err := app.state.Get([]byte(behindProxyNodeKey), committedState)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.AppStateError,
+				Message: err.Error(),
+			}
+		}
+		var nodes data.BehindNodeList
+		err = proto.Unmarshal(behindProxyNodeValue, &nodes)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.UnmarshalError,
+				Message: err.Error(),
+			}
+		}
+		if len(nodes.Nodes) > 0 {
+			return &ApplicationError{
+				Code:    code.ProxyNodeStillHasNodesBehind,
+				Message: "Proxy node still has nodes behind it",
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) decommissionNodeCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam DecommissionNodeParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateDecommissionNode(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// decommissionNode permanently retires node. Node is removed from node lists and proxy,
+// its service destinations and IdP associations are disabled, its open requests are closed
+// and its token balance is recorded for refund and set to zero.
+// Reference groups and requests of node are looked up from per-node indexes in current state.
+func (app *ABCIApplication) decommissionNode(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("DecommissionNode, Parameter: %s", param)
+	var funcParam DecommissionNodeParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateDecommissionNode(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	nodeDetail, err := app.getNodeDetail(funcParam.NodeID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	decommissionedNode := data.DecommissionedNode{
+		NodeId:                  funcParam.NodeID,
+		Role:                    nodeDetail.Role,
+		DecommissionBlockHeight: app.state.CurrentBlockHeight,
+	}
+
+	// Remove from node lists
+	var idpList data.IdPList
+	var rpList data.RPList
+	var asList data.ASList
+	var allList data.AllList
+	for _, nodeList := range []struct {
+		key     []byte
+		list    proto.Message
+		nodeIDs *[]string
+	}{
+		{idpListKeyBytes, &idpList, &idpList.NodeId},
+		{[]byte("rpList"), &rpList, &rpList.NodeId},
+		{[]byte("asList"), &asList, &asList.NodeId},
+		{[]byte("allList"), &allList, &allList.NodeId},
+	} {
+		value, err := app.state.Get(nodeList.key, false)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		if value == nil {
+			continue
+		}
+		err = proto.Unmarshal(value, nodeList.list)
+		if err != nil {
+			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+		}
+		var removed bool
+		*nodeList.nodeIDs, removed = removeNodeIDFromList(*nodeList.nodeIDs, funcParam.NodeID)
+		if !removed {
+			continue
+		}
+		value, err = utils.ProtoDeterministicMarshal(nodeList.list)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		app.state.Set(nodeList.key, value)
+	}
+
+	// Remove from proxy
+	if nodeDetail.ProxyNodeId != "" {
+		behindProxyNodeKey := behindProxyNodeKeyPrefix + keySeparator + nodeDetail.ProxyNodeId
+		behindProxyNodeValue, err := app.state.Get([]byte(behindProxyNodeKey), false)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		var nodes data.BehindNodeList
+		err = proto.Unmarshal(behindProxyNodeValue, &nodes)
+		if err != nil {
+			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+		}
+		nodes.Nodes, _ = removeNodeIDFromList(nodes.Nodes, funcParam.NodeID)
+		behindProxyNodeValue, err = utils.ProtoDeterministicMarshal(&nodes)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		app.state.Set([]byte(behindProxyNodeKey), behindProxyNodeValue)
+		nodeDetail.ProxyNodeId = ""
+		nodeDetail.ProxyConfig = ""
+	}
+
+	// Disable service destinations
+	provideServiceKey := providedServicesKeyPrefix + keySeparator + funcParam.NodeID
+	provideServiceValue, err := app.state.Get([]byte(provideServiceKey), false)
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+	if provideServiceValue != nil {
+		var services data.ServiceList
+		err = proto.Unmarshal(provideServiceValue, &services)
+		if err != nil {
+			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+		}
+		for _, service := range services.Services {
+			serviceDestinationKey := serviceDestinationKeyPrefix + keySeparator + service.ServiceId
+			serviceDestinationValue, err := app.state.Get([]byte(serviceDestinationKey), false)
+			if err != nil {
+				return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+			}
+			var nodes data.ServiceDesList
+			err = proto.Unmarshal(serviceDestinationValue, &nodes)
+			if err != nil {
+				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+			}
+			for _, node := range nodes.Node {
+				if node.NodeId == funcParam.NodeID && node.Active {
+					node.Active = false
+					decommissionedNode.DisabledServiceDestinationCount++
+				}
+			}
+			serviceDestinationValue, err = utils.ProtoDeterministicMarshal(&nodes)
+			if err != nil {
+				return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+			}
+			app.state.Set([]byte(serviceDestinationKey), serviceDestinationValue)
+			service.Active = false
+		}
+		provideServiceValue, err = utils.ProtoDeterministicMarshal(&services)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		app.state.Set([]byte(provideServiceKey), provideServiceValue)
+	}
+
+	// Disable IdP associations
+	if app.isIDPorIDPAgentNode(nodeDetail) {
+		refGroupCodeList, err := app.getNodeIndexValueList(idpRefGroupCodeKeyPrefix, funcParam.NodeID)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		for _, refGroupCode := range refGroupCodeList {
+			refGroupKey := refGroupCodeKeyPrefix + keySeparator + refGroupCode
+			refGroupValue, err := app.state.Get([]byte(refGroupKey), false)
+			if err != nil {
+				return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+			}
+			if refGroupValue == nil {
+				continue
+			}
+			var refGroup data.ReferenceGroup
+			err = proto.Unmarshal(refGroupValue, &refGroup)
+			if err != nil {
+				return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+			}
+			if !disableIdPAssociation(&refGroup, funcParam.NodeID) {
+				continue
+			}
+			refGroupValue, err = utils.ProtoDeterministicMarshal(&refGroup)
+			if err != nil {
+				return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+			}
+			app.state.Set([]byte(refGroupKey), refGroupValue)
+			decommissionedNode.DisabledIdpAssociationCount++
+		}
+	}
+
+	// Close open requests
+	requestIDList, err := app.getNodeIndexValueList(ownedOpenRequestKeyPrefix, funcParam.NodeID)
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+	for _, requestID := range requestIDList {
+		err = app.removeOwnedOpenRequestIndex(funcParam.NodeID, requestID)
+		if err != nil {
+			if appErr, ok := err.(*ApplicationError); ok {
+				return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+			}
+			return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+		}
+		requestKey := requestKeyPrefix + keySeparator + requestID
+		requestValue, err := app.state.GetVersioned([]byte(requestKey), 0, false)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		if requestValue == nil {
+			continue
+		}
+		var request data.Request
+		err = proto.Unmarshal(requestValue, &request)
+		if err != nil {
+			return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+		}
+		if !closeOwnedRequest(&request, funcParam.NodeID) {
+			continue
+		}
+		requestValue, err = utils.ProtoDeterministicMarshal(&request)
+		if err != nil {
+			return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+		}
+		err = app.state.SetVersioned([]byte(requestKey), requestValue)
+		if err != nil {
+			return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+		}
+		decommissionedNode.ClosedRequestCount++
+	}
+
+	// Record token balance for refund
+	tokenAmount, err := app.getToken(funcParam.NodeID, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	decommissionedNode.RefundTokenAmount = tokenAmount
+	err = app.setToken(funcParam.NodeID, 0)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	// Deactivate node
+	nodeDetail.Active = false
+	nodeDetail.Mq = make([]*data.MQ, 0)
+	nodeDetailValue, err := utils.ProtoDeterministicMarshal(nodeDetail)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	nodeDetailKey := nodeIDKeyPrefix + keySeparator + funcParam.NodeID
+	app.state.Set([]byte(nodeDetailKey), nodeDetailValue)
+
+	// Drop delegated admin permission
+	adminPermissionKey := adminPermissionKeyPrefix + keySeparator + funcParam.NodeID
+	err = app.state.Delete([]byte(adminPermissionKey))
+	if err != nil {
+		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
+	}
+
+	decommissionedNodeValue, err := utils.ProtoDeterministicMarshal(&decommissionedNode)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	decommissionedNodeKey := decommissionedNodeKeyPrefix + keySeparator + funcParam.NodeID
+	app.state.Set([]byte(decommissionedNodeKey), decommissionedNodeValue)
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "node_id"
+	attribute.Value = funcParam.NodeID
+	attributes = append(attributes, attribute)
+	attribute.Key = "refund_token_amount"
+	attribute.Value = strconv.FormatFloat(tokenAmount, 'f', -1, 64)
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+type GetDecommissionedNodeInfoParam struct {
+	NodeID string `json:"node_id"`
+}
+
+type GetDecommissionedNodeInfoResult struct {
+	NodeID                          string  `json:"node_id"`
+	Role                            string  `json:"role"`
+	RefundTokenAmount               float64 `json:"refund_token_amount"`
+	ClosedRequestCount              int64   `json:"closed_request_count"`
+	DisabledServiceDestinationCount int64   `json:"disabled_service_destination_count"`
+	DisabledIdPAssociationCount     int64   `json:"disabled_idp_association_count"`
+	DecommissionBlockHeight         int64   `json:"decommission_block_height"`
+}
+
+func (app *ABCIApplication) getDecommissionedNodeInfo(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetDecommissionedNodeInfo, Parameter: %s", param)
+	var funcParam GetDecommissionedNodeInfoParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	decommissionedNode, err := app.getDecommissionedNode(funcParam.NodeID, true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if decommissionedNode == nil {
+		return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
+	}
+
+	result := GetDecommissionedNodeInfoResult{
+		NodeID:                          decommissionedNode.NodeId,
+		Role:                            decommissionedNode.Role,
+		RefundTokenAmount:               decommissionedNode.RefundTokenAmount,
+		ClosedRequestCount:              decommissionedNode.ClosedRequestCount,
+		DisabledServiceDestinationCount: decommissionedNode.DisabledServiceDestinationCount,
+		DisabledIdPAssociationCount:     decommissionedNode.DisabledIdpAssociationCount,
+		DecommissionBlockHeight:         decommissionedNode.DecommissionBlockHeight,
+	}
+	returnValue, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(returnValue, "success", app.state.Height)
+}
@@ -0,0 +1,237 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestRemoveNodeIDFromList(t *testing.T) {
+	testCases := []struct {
+		name            string
+		nodeIDList      []string
+		nodeID          string
+		expectedList    []string
+		expectedRemoved bool
+	}{
+		{"listed", []string{"idp1", "idp2", "idp3"}, "idp2", []string{"idp1", "idp3"}, true},
+		{"not listed", []string{"idp1"}, "idp2", []string{"idp1"}, false},
+		{"empty list", []string{}, "idp1", []string{}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nodeIDList, removed := removeNodeIDFromList(tc.nodeIDList, tc.nodeID)
+			assert.Equal(t, tc.expectedRemoved, removed)
+			assert.Equal(t, tc.expectedList, nodeIDList)
+		})
+	}
+}
+
+func TestDisableIdPAssociation(t *testing.T) {
+	testCases := []struct {
+		name             string
+		idps             []*data.IdPInRefGroup
+		nodeID           string
+		expectedDisabled bool
+		expectedActive   []bool
+	}{
+		{
+			name:             "active association",
+			idps:             []*data.IdPInRefGroup{{NodeId: "idp1", Active: true}, {NodeId: "idp2", Active: true}},
+			nodeID:           "idp1",
+			expectedDisabled: true,
+			expectedActive:   []bool{false, true},
+		},
+		{
+			name:             "already disabled",
+			idps:             []*data.IdPInRefGroup{{NodeId: "idp1", Active: false}, {NodeId: "idp2", Active: true}},
+			nodeID:           "idp1",
+			expectedDisabled: false,
+			expectedActive:   []bool{false, true},
+		},
+		{
+			name:             "not associated",
+			idps:             []*data.IdPInRefGroup{{NodeId: "idp1", Active: true}, {NodeId: "idp2", Active: true}},
+			nodeID:           "idp3",
+			expectedDisabled: false,
+			expectedActive:   []bool{true, true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refGroup := &data.ReferenceGroup{Idps: tc.idps}
+			assert.Equal(t, tc.expectedDisabled, disableIdPAssociation(refGroup, tc.nodeID))
+			for i, idp := range refGroup.Idps {
+				assert.Equal(t, tc.expectedActive[i], idp.Active, idp.NodeId)
+			}
+		})
+	}
+}
+
+func TestCloseOwnedRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		request        *data.Request
+		expectedClosed bool
+	}{
+		{"open request", &data.Request{Owner: "rp1"}, true},
+		{"already closed", &data.Request{Owner: "rp1", Closed: true}, false},
+		{"timed out", &data.Request{Owner: "rp1", TimedOut: true}, false},
+		{"owned by other node", &data.Request{Owner: "rp2"}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wasClosed := tc.request.Closed
+			assert.Equal(t, tc.expectedClosed, closeOwnedRequest(tc.request, "rp1"))
+			assert.Equal(t, tc.expectedClosed || wasClosed, tc.request.Closed)
+		})
+	}
+}
+
+func newTestCreateRequestParam(requestID string) CreateRequestParam {
+	return CreateRequestParam{
+		RequestID:   requestID,
+		MinIdp:      1,
+		MinAal:      1,
+		MinIal:      1.1,
+		Timeout:     3600,
+		IdPIDList:   []string{"idp2"},
+		MessageHash: "hash",
+		Mode:        2,
+	}
+}
+
+func getTestRequest(t *testing.T, app *ABCIApplication, requestID string) *data.Request {
+	value, err := app.state.GetVersioned([]byte(requestKeyPrefix+keySeparator+requestID), 0, false)
+	assert.NoError(t, err)
+	var request data.Request
+	assert.NoError(t, proto.Unmarshal(value, &request))
+	return &request
+}
+
+func TestDecommissionNode(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp1", "IdP")
+	registerTestNode(t, app, "idp2", "IdP")
+	registerTestNode(t, app, "rp1", "RP")
+	addTestNamespace(t, app, "citizen_id")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref1", "citizen_id", "hash1", "accessor1"), "idp1")
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref2", "citizen_id", "hash2", "accessor2"), "idp2")
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request1"), "rp1")
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request2"), "rp1")
+	deliverTestTx(t, app, "CloseRequest", CloseRequestParam{RequestID: "request2"}, "rp1")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	// reference group and request created in the same block are in uncommitted state only
+	deliverTestTx(t, app, "RegisterIdentity", newTestRegisterIdentityParam(t, "ref3", "citizen_id", "hash3", "accessor3"), "idp1")
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request3"), "rp1")
+	// association in frozen reference group is disabled too
+	deliverTestTx(t, app, "FreezeReferenceGroup", FreezeReferenceGroupParam{ReferenceGroupCode: "ref1", ReasonCode: "fraud"}, testNDIDNodeID)
+	deliverTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: "idp1"}, testNDIDNodeID)
+	deliverTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: "rp1"}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	for _, refGroupCode := range []string{"ref1", "ref3"} {
+		refGroup := getTestReferenceGroup(t, app, refGroupCode)
+		assert.False(t, refGroup.Idps[0].Active, refGroupCode)
+	}
+	assert.True(t, getTestReferenceGroup(t, app, "ref1").Frozen)
+	assert.True(t, getTestReferenceGroup(t, app, "ref2").Idps[0].Active)
+
+	for _, requestID := range []string{"request1", "request2", "request3"} {
+		assert.True(t, getTestRequest(t, app, requestID).Closed, requestID)
+	}
+	requestIDList, err := app.getNodeIndexValueList(ownedOpenRequestKeyPrefix, "rp1")
+	assert.NoError(t, err)
+	assert.Empty(t, requestIDList)
+
+	decommissionedNode, err := app.getDecommissionedNode("idp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), decommissionedNode.DisabledIdpAssociationCount)
+	decommissionedNode, err = app.getDecommissionedNode("rp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), decommissionedNode.ClosedRequestCount)
+}
+
+func TestDecommissionNodeRejected(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp1", "IdP")
+	registerTestNode(t, app, "rp1", "RP")
+	registerTestNode(t, app, "proxy1", "Proxy")
+	deliverTestTx(t, app, "AddNodeToProxyNode", AddNodeToProxyNodeParam{NodeID: "rp1", ProxyNodeID: "proxy1", Config: "KEY_ON_PROXY"}, testNDIDNodeID)
+	deliverTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: "idp1"}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	testCases := []struct {
+		name         string
+		nodeID       string
+		callerNodeID string
+		expectedCode uint32
+	}{
+		{"caller is not admin", "rp1", "rp1", code.NoPermissionForCallNDIDMethod},
+		{"NDID node", testNDIDNodeID, testNDIDNodeID, code.CannotDecommissionNDIDNode},
+		{"proxy node with nodes behind", "proxy1", testNDIDNodeID, code.ProxyNodeStillHasNodesBehind},
+		{"already decommissioned", "idp1", testNDIDNodeID, code.NodeIsDecommissioned},
+		{"unknown node", "unknown", testNDIDNodeID, code.NodeIDNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			beginTestBlock(app)
+			res := callTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: tc.nodeID}, tc.callerNodeID)
+			commitTestBlock(app)
+			assert.Equal(t, tc.expectedCode, res.Code, res.Log)
+		})
+	}
+}
+
+func TestOwnedOpenRequestIndex(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp2", "IdP")
+	registerTestNode(t, app, "rp1", "RP")
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request1"), "rp1")
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request2"), "rp1")
+	deliverTestTx(t, app, "CreateRequest", newTestCreateRequestParam("request3"), "rp1")
+	deliverTestTx(t, app, "CloseRequest", CloseRequestParam{RequestID: "request1"}, "rp1")
+	deliverTestTx(t, app, "TimeOutRequest", TimeOutRequestParam{RequestID: "request2"}, "rp1")
+	commitTestBlock(app)
+
+	requestIDList, err := app.getNodeIndexValueList(ownedOpenRequestKeyPrefix, "rp1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"request3"}, requestIDList)
+}
@@ -0,0 +1,251 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+type HeartbeatParam struct{}
+
+func (app *ABCIApplication) validateHeartbeat(funcParam HeartbeatParam, callerNodeID string, committedState bool, checktx bool) error {
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	_, err := app.getNodeDetail(callerNodeID, committedState)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) heartbeatCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam HeartbeatParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateHeartbeat(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) heartbeat(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("Heartbeat, Parameter: %s", param)
+	var funcParam HeartbeatParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateHeartbeat(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	nodeHeartbeat := data.NodeHeartbeat{
+		LastSeenBlockHeight: app.state.CurrentBlockHeight,
+		LastSeenBlockTime:   app.state.GetBlockTime(false),
+	}
+	value, err := utils.ProtoDeterministicMarshal(&nodeHeartbeat)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	key := nodeHeartbeatKeyPrefix + keySeparator + callerNodeID
+	app.state.Set([]byte(key), value)
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+func (app *ABCIApplication) getNodeHeartbeat(nodeID string, committedState bool) (*data.NodeHeartbeat, error) {
+	key := nodeHeartbeatKeyPrefix + keySeparator + nodeID
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, err
+	}
+	var nodeHeartbeat data.NodeHeartbeat
+	if value != nil {
+		err = proto.Unmarshal(value, &nodeHeartbeat)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &nodeHeartbeat, nil
+}
+
+// getLastSeenNodeHeartbeat returns latest heartbeat of node.
+// Heartbeat of proxy node also counts for node behind it since they share MQ.
+// Node which has never sent heartbeat has zero last seen block height and time.
+func (app *ABCIApplication) getLastSeenNodeHeartbeat(nodeDetail *data.NodeDetail, nodeID string) (*data.NodeHeartbeat, error) {
+	nodeHeartbeat, err := app.getNodeHeartbeat(nodeID, true)
+	if err != nil {
+		return nil, err
+	}
+	if nodeDetail.ProxyNodeId != "" {
+		proxyNodeHeartbeat, err := app.getNodeHeartbeat(nodeDetail.ProxyNodeId, true)
+		if err != nil {
+			return nil, err
+		}
+		if proxyNodeHeartbeat.LastSeenBlockTime > nodeHeartbeat.LastSeenBlockTime {
+			nodeHeartbeat = proxyNodeHeartbeat
+		}
+	}
+	return nodeHeartbeat, nil
+}
+
+// isNodeHeartbeatStale returns true if last heartbeat is older than maxStaleness (in seconds)
+// before currentTime (unix time in milliseconds)
+func isNodeHeartbeatStale(nodeHeartbeat *data.NodeHeartbeat, maxStaleness int64, currentTime int64) bool {
+	return nodeHeartbeat.LastSeenBlockTime < currentTime-maxStaleness*1000
+}
+
+// validateMaxHeartbeatStaleness checks max heartbeat staleness filter of node queries
+func validateMaxHeartbeatStaleness(maxStaleness *int64) error {
+	if maxStaleness != nil && *maxStaleness < 0 {
+		return errors.New("max heartbeat staleness cannot be negative")
+	}
+	return nil
+}
+
+// isNodeStale is used by discovery queries to filter out nodes
+// which have not sent heartbeat within maxStaleness (in seconds)
+// before time of last committed block. Nil maxStaleness means no filter.
+func (app *ABCIApplication) isNodeStale(nodeDetail *data.NodeDetail, nodeID string, maxStaleness *int64) bool {
+	if maxStaleness == nil {
+		return false
+	}
+	nodeHeartbeat, err := app.getLastSeenNodeHeartbeat(nodeDetail, nodeID)
+	if err != nil {
+		return true
+	}
+	return isNodeHeartbeatStale(nodeHeartbeat, *maxStaleness, app.state.GetBlockTime(true))
+}
+
+type GetStaleNodeListParam struct {
+	MaxStaleness int64  `json:"max_staleness"` // seconds
+	Role         string `json:"role"`
+}
+
+type StaleNode struct {
+	NodeID              string `json:"node_id"`
+	NodeName            string `json:"node_name"`
+	Role                string `json:"role"`
+	LastSeenBlockHeight int64  `json:"last_seen_block_height"`
+	LastSeenBlockTime   int64  `json:"last_seen_block_time"`
+}
+
+type GetStaleNodeListResult struct {
+	NodeList []StaleNode `json:"node_list"`
+}
+
+func (app *ABCIApplication) getStaleNodeList(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetStaleNodeList, Parameter: %s", param)
+	var funcParam GetStaleNodeListParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if funcParam.MaxStaleness <= 0 {
+		return app.NewResponseQuery(nil, "max staleness must be greater than 0", app.state.Height)
+	}
+	var roleFilter []appTypes.NodeRole
+	if funcParam.Role != "" {
+		roleFilter, err = parseNodeRoleList(funcParam.Role, nil)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+	}
+
+	var allList data.AllList
+	allValue, err := app.state.Get([]byte("allList"), true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if allValue != nil {
+		err = proto.Unmarshal(allValue, &allList)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+	}
+
+	currentTime := app.state.GetBlockTime(true)
+	result := GetStaleNodeListResult{
+		NodeList: make([]StaleNode, 0),
+	}
+	for _, nodeID := range allList.NodeId {
+		nodeDetail, err := app.getNodeDetail(nodeID, true)
+		if err != nil {
+			continue
+		}
+		// disabled node is not expected to send heartbeat
+		if !nodeDetail.Active {
+			continue
+		}
+		if len(roleFilter) > 0 && !hasNodeRole(nodeDetail, roleFilter[0]) {
+			continue
+		}
+		nodeHeartbeat, err := app.getLastSeenNodeHeartbeat(nodeDetail, nodeID)
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+		if !isNodeHeartbeatStale(nodeHeartbeat, funcParam.MaxStaleness, currentTime) {
+			continue
+		}
+		result.NodeList = append(result.NodeList, StaleNode{
+			NodeID:              nodeID,
+			NodeName:            nodeDetail.NodeName,
+			Role:                nodeDetail.Role,
+			LastSeenBlockHeight: nodeHeartbeat.LastSeenBlockHeight,
+			LastSeenBlockTime:   nodeHeartbeat.LastSeenBlockTime,
+		})
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
@@ -0,0 +1,155 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/assert"
+
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestIsNodeHeartbeatStale(t *testing.T) {
+	currentTime := int64(1700000000000)
+	testCases := []struct {
+		name              string
+		lastSeenBlockTime int64
+		expectedStale     bool
+	}{
+		{"seen within max staleness", currentTime - 30000, false},
+		{"seen at max staleness", currentTime - 60000, false},
+		{"seen before max staleness", currentTime - 60001, true},
+		{"never sent heartbeat", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nodeHeartbeat := &data.NodeHeartbeat{LastSeenBlockTime: tc.lastSeenBlockTime}
+			assert.Equal(t, tc.expectedStale, isNodeHeartbeatStale(nodeHeartbeat, 60, currentTime))
+		})
+	}
+}
+
+func TestIsNodeStaleWithoutFilter(t *testing.T) {
+	var app *ABCIApplication
+	assert.False(t, app.isNodeStale(&data.NodeDetail{}, "idp1", nil))
+}
+
+func TestValidateMaxHeartbeatStaleness(t *testing.T) {
+	int64Ptr := func(value int64) *int64 { return &value }
+	testCases := []struct {
+		name          string
+		maxStaleness  *int64
+		expectedError bool
+	}{
+		{"not set", nil, false},
+		{"zero", int64Ptr(0), false},
+		{"positive", int64Ptr(60), false},
+		{"negative", int64Ptr(-1), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateMaxHeartbeatStaleness(tc.maxStaleness)
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNodeQueryRejectNegativeMaxHeartbeatStaleness(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	param := []byte(`{"service_id":"service1","max_heartbeat_staleness":-1}`)
+	for _, res := range []*abcitypes.ResponseQuery{
+		app.getIdpNodes(param),
+		app.getIdpNodesInfo(param),
+		app.getAsNodesByServiceId(param),
+		app.getAsNodesInfoByServiceId(param),
+	} {
+		assert.Nil(t, res.Value)
+		assert.Equal(t, "max heartbeat staleness cannot be negative", res.Log)
+	}
+}
+
+func TestGetStaleNodeListUsesCommittedBlockTime(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1700000000000
+	registerTestNode(t, app, "idp1", "IdP")
+	deliverTestTx(t, app, "Heartbeat", HeartbeatParam{}, "idp1")
+	commitTestBlock(app)
+	heartbeatHeight := app.state.Height
+
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1700000120000
+	commitTestBlock(app)
+
+	// block time is loaded from state after restart
+	appState, err := NewAppState(app.state.db)
+	assert.NoError(t, err)
+	app = &ABCIApplication{
+		logger: app.logger,
+		state:  *appState,
+	}
+	param := []byte(`{"max_staleness":60,"role":"IdP"}`)
+	var result GetStaleNodeListResult
+	assert.NoError(t, json.Unmarshal(app.getStaleNodeList(param).Value, &result))
+	assert.Len(t, result.NodeList, 1)
+	assert.Equal(t, "idp1", result.NodeList[0].NodeID)
+
+	// historical query uses block time at queried height
+	result = GetStaleNodeListResult{}
+	assert.NoError(t, json.Unmarshal(app.historicalView(heartbeatHeight).getStaleNodeList(param).Value, &result))
+	assert.Empty(t, result.NodeList)
+}
+
+func TestGetStaleNodeListAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	getStaleNodeIDList := func(role string) []string {
+		param, err := json.Marshal(GetStaleNodeListParam{MaxStaleness: 60, Role: role})
+		assert.NoError(t, err)
+		var result GetStaleNodeListResult
+		assert.NoError(t, json.Unmarshal(app.getStaleNodeList(param).Value, &result))
+		nodeIDList := make([]string, 0)
+		for _, node := range result.NodeList {
+			nodeIDList = append(nodeIDList, node.NodeID)
+		}
+		return nodeIDList
+	}
+
+	// baseline nodes have never sent heartbeat
+	assert.Equal(t, []string{"idp1"}, getStaleNodeIDList("IdP"))
+	assert.Equal(t, []string{"rp1"}, getStaleNodeIDList("RP"))
+
+	beginTestBlock(app)
+	app.state.CurrentBlockTime = 1030000
+	deliverTestTx(t, app, "Heartbeat", HeartbeatParam{}, "idp1")
+	commitTestBlock(app)
+
+	assert.Empty(t, getStaleNodeIDList("IdP"))
+	assert.Equal(t, []string{"rp1"}, getStaleNodeIDList("RP"))
+}
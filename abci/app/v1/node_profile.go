@@ -0,0 +1,156 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+const maxNodeProfileFieldLength = 512
+
+// NodeProfileParam is partial update of node profile. Only non-nil fields are updated.
+// Legal entity name and registration number can only be set by NDID.
+type NodeProfileParam struct {
+	LegalEntityName    *string `json:"legal_entity_name"`
+	RegistrationNumber *string `json:"registration_number"`
+	ContactEmail       *string `json:"contact_email"`
+	SupportURL         *string `json:"support_url"`
+	LogoHash           *string `json:"logo_hash"`
+	OperatingHours     *string `json:"operating_hours"`
+}
+
+type NodeProfile struct {
+	Version            int64  `json:"version"`
+	LegalEntityName    string `json:"legal_entity_name"`
+	RegistrationNumber string `json:"registration_number"`
+	ContactEmail       string `json:"contact_email"`
+	SupportURL         string `json:"support_url"`
+	LogoHash           string `json:"logo_hash"`
+	OperatingHours     string `json:"operating_hours"`
+	UpdateBlockHeight  int64  `json:"update_block_height"`
+}
+
+func validateNodeProfileParam(profile *NodeProfileParam, byNDID bool) error {
+	if profile == nil {
+		return nil
+	}
+
+	if !byNDID && (profile.LegalEntityName != nil || profile.RegistrationNumber != nil) {
+		return &ApplicationError{
+			Code:    code.NodeProfileFieldCanOnlyBeSetByNDID,
+			Message: "Legal entity name and registration number can only be set by NDID",
+		}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"legal_entity_name", profile.LegalEntityName},
+		{"registration_number", profile.RegistrationNumber},
+		{"contact_email", profile.ContactEmail},
+		{"support_url", profile.SupportURL},
+		{"logo_hash", profile.LogoHash},
+		{"operating_hours", profile.OperatingHours},
+	} {
+		if field.value != nil && len(*field.value) > maxNodeProfileFieldLength {
+			return &ApplicationError{
+				Code:    code.InvalidNodeProfile,
+				Message: fmt.Sprintf("Node profile %s cannot be longer than %d characters", field.name, maxNodeProfileFieldLength),
+			}
+		}
+	}
+
+	if profile.ContactEmail != nil && *profile.ContactEmail != "" {
+		_, err := mail.ParseAddress(*profile.ContactEmail)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.InvalidNodeProfile,
+				Message: "Invalid node profile contact email",
+			}
+		}
+	}
+
+	if profile.SupportURL != nil && *profile.SupportURL != "" {
+		supportURL, err := url.ParseRequestURI(*profile.SupportURL)
+		if err != nil || (supportURL.Scheme != "http" && supportURL.Scheme != "https") {
+			return &ApplicationError{
+				Code:    code.InvalidNodeProfile,
+				Message: "Invalid node profile support URL",
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyNodeProfileParam updates node profile with non-nil fields and increases profile version
+func applyNodeProfileParam(node *data.NodeDetail, profile *NodeProfileParam, blockHeight int64) {
+	if profile == nil {
+		return
+	}
+	if node.Profile == nil {
+		node.Profile = &data.NodeProfile{}
+	}
+	if profile.LegalEntityName != nil {
+		node.Profile.LegalEntityName = *profile.LegalEntityName
+	}
+	if profile.RegistrationNumber != nil {
+		node.Profile.RegistrationNumber = *profile.RegistrationNumber
+	}
+	if profile.ContactEmail != nil {
+		node.Profile.ContactEmail = *profile.ContactEmail
+	}
+	if profile.SupportURL != nil {
+		node.Profile.SupportUrl = *profile.SupportURL
+	}
+	if profile.LogoHash != nil {
+		node.Profile.LogoHash = *profile.LogoHash
+	}
+	if profile.OperatingHours != nil {
+		node.Profile.OperatingHours = *profile.OperatingHours
+	}
+	node.Profile.Version++
+	node.Profile.UpdateBlockHeight = blockHeight
+}
+
+func getNodeProfile(node *data.NodeDetail) *NodeProfile {
+	if node.Profile == nil {
+		return nil
+	}
+	return &NodeProfile{
+		Version:            node.Profile.Version,
+		LegalEntityName:    node.Profile.LegalEntityName,
+		RegistrationNumber: node.Profile.RegistrationNumber,
+		ContactEmail:       node.Profile.ContactEmail,
+		SupportURL:         node.Profile.SupportUrl,
+		LogoHash:           node.Profile.LogoHash,
+		OperatingHours:     node.Profile.OperatingHours,
+		UpdateBlockHeight:  node.Profile.UpdateBlockHeight,
+	}
+}
@@ -0,0 +1,70 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestValidateNodeProfileParam(t *testing.T) {
+	legalEntityName := "Test Company Limited"
+	email := "support@example.com"
+	invalidEmail := "not an email"
+	supportURL := "https://example.com/support"
+	invalidSupportURL := "ftp://example.com"
+
+	assert.NoError(t, validateNodeProfileParam(nil, false))
+	assert.NoError(t, validateNodeProfileParam(&NodeProfileParam{LegalEntityName: &legalEntityName}, true))
+	assert.NoError(t, validateNodeProfileParam(&NodeProfileParam{ContactEmail: &email, SupportURL: &supportURL}, false))
+
+	err := validateNodeProfileParam(&NodeProfileParam{LegalEntityName: &legalEntityName}, false)
+	assertApplicationErrorCode(t, code.NodeProfileFieldCanOnlyBeSetByNDID, err)
+
+	err = validateNodeProfileParam(&NodeProfileParam{ContactEmail: &invalidEmail}, false)
+	assertApplicationErrorCode(t, code.InvalidNodeProfile, err)
+
+	err = validateNodeProfileParam(&NodeProfileParam{SupportURL: &invalidSupportURL}, false)
+	assertApplicationErrorCode(t, code.InvalidNodeProfile, err)
+}
+
+func TestApplyNodeProfileParam(t *testing.T) {
+	legalEntityName := "Test Company Limited"
+	email := "support@example.com"
+	operatingHours := "Mon-Fri 09:00-17:00"
+
+	var node data.NodeDetail
+	applyNodeProfileParam(&node, &NodeProfileParam{LegalEntityName: &legalEntityName, ContactEmail: &email}, 10)
+	applyNodeProfileParam(&node, &NodeProfileParam{OperatingHours: &operatingHours}, 20)
+
+	profile := getNodeProfile(&node)
+	assert.Equal(t, int64(2), profile.Version)
+	assert.Equal(t, legalEntityName, profile.LegalEntityName)
+	assert.Equal(t, email, profile.ContactEmail)
+	assert.Equal(t, operatingHours, profile.OperatingHours)
+	assert.Equal(t, int64(20), profile.UpdateBlockHeight)
+}
@@ -0,0 +1,191 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// getNodeRoles returns role set of node.
+// Node registered before multi-role support only has single role in `role` field.
+func getNodeRoles(node *data.NodeDetail) []appTypes.NodeRole {
+	if len(node.Roles) == 0 {
+		if node.Role == "" {
+			return nil
+		}
+		return []appTypes.NodeRole{appTypes.NodeRole(node.Role)}
+	}
+	roles := make([]appTypes.NodeRole, 0, len(node.Roles))
+	for _, role := range node.Roles {
+		roles = append(roles, appTypes.NodeRole(role))
+	}
+	return roles
+}
+
+func containsNodeRole(roles []appTypes.NodeRole, role appTypes.NodeRole) bool {
+	for _, nodeRole := range roles {
+		if nodeRole == role {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNodeRole(node *data.NodeDetail, role appTypes.NodeRole) bool {
+	return containsNodeRole(getNodeRoles(node), role)
+}
+
+func hasAnyNodeRole(node *data.NodeDetail, roles ...appTypes.NodeRole) bool {
+	for _, role := range roles {
+		if hasNodeRole(node, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// setNodeRoles sets role set of node. First role is kept in `role` field as primary role.
+func setNodeRoles(node *data.NodeDetail, roles []appTypes.NodeRole) {
+	node.Roles = make([]string, 0, len(roles))
+	for _, role := range roles {
+		node.Roles = append(node.Roles, string(role))
+	}
+	if len(roles) > 0 {
+		node.Role = string(roles[0])
+	}
+}
+
+func getNodeRoleStringList(node *data.NodeDetail) []string {
+	roleList := make([]string, 0)
+	for _, role := range getNodeRoles(node) {
+		roleList = append(roleList, string(role))
+	}
+	return roleList
+}
+
+// parseNodeRoleList combines single role and role list parameters into role set.
+// Role names are case insensitive. Proxy role cannot be combined with other roles.
+func parseNodeRoleList(role string, roleList []string) ([]appTypes.NodeRole, error) {
+	inputRoleList := make([]string, 0, len(roleList)+1)
+	if role != "" {
+		inputRoleList = append(inputRoleList, role)
+	}
+	inputRoleList = append(inputRoleList, roleList...)
+
+	roles := make([]appTypes.NodeRole, 0, len(inputRoleList))
+	for _, inputRole := range inputRoleList {
+		var nodeRole appTypes.NodeRole
+		switch strings.ToLower(inputRole) {
+		case "rp":
+			nodeRole = appTypes.NodeRoleRp
+		case "idp":
+			nodeRole = appTypes.NodeRoleIdp
+		case "as":
+			nodeRole = appTypes.NodeRoleAs
+		case "proxy":
+			nodeRole = appTypes.NodeRoleProxy
+		default:
+			return nil, &ApplicationError{
+				Code:    code.InvalidNodeRole,
+				Message: "Invalid node role",
+			}
+		}
+		if !containsNodeRole(roles, nodeRole) {
+			roles = append(roles, nodeRole)
+		}
+	}
+
+	if len(roles) == 0 {
+		return nil, &ApplicationError{
+			Code:    code.InvalidNodeRole,
+			Message: "Invalid node role",
+		}
+	}
+	if len(roles) > 1 {
+		for _, nodeRole := range roles {
+			if nodeRole == appTypes.NodeRoleProxy {
+				return nil, &ApplicationError{
+					Code:    code.InvalidNodeRole,
+					Message: "Proxy role cannot be combined with other roles",
+				}
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+// addNodeToRoleLists adds node ID to node ID list of each given role (IdP, RP and AS)
+func (app *ABCIApplication) addNodeToRoleLists(nodeID string, roles []appTypes.NodeRole) error {
+	for _, role := range roles {
+		var key []byte
+		var list proto.Message
+		var nodeIDs *[]string
+		switch role {
+		case appTypes.NodeRoleIdp:
+			var idpList data.IdPList
+			key, list, nodeIDs = idpListKeyBytes, &idpList, &idpList.NodeId
+		case appTypes.NodeRoleRp:
+			var rpList data.RPList
+			key, list, nodeIDs = []byte("rpList"), &rpList, &rpList.NodeId
+		case appTypes.NodeRoleAs:
+			var asList data.ASList
+			key, list, nodeIDs = []byte("asList"), &asList, &asList.NodeId
+		default:
+			continue
+		}
+		value, err := app.state.Get(key, false)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.AppStateError,
+				Message: err.Error(),
+			}
+		}
+		if value != nil {
+			err = proto.Unmarshal(value, list)
+			if err != nil {
+				return &ApplicationError{
+					Code:    code.UnmarshalError,
+					Message: err.Error(),
+				}
+			}
+		}
+		*nodeIDs = append(*nodeIDs, nodeID)
+		value, err = utils.ProtoDeterministicMarshal(list)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.MarshalError,
+				Message: err.Error(),
+			}
+		}
+		app.state.Set(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestParseNodeRoleList(t *testing.T) {
+	roles, err := parseNodeRoleList("rp", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []appTypes.NodeRole{appTypes.NodeRoleRp}, roles)
+
+	roles, err = parseNodeRoleList("RP", []string{"as", "rp", "IdP"})
+	assert.NoError(t, err)
+	assert.Equal(t, []appTypes.NodeRole{appTypes.NodeRoleRp, appTypes.NodeRoleAs, appTypes.NodeRoleIdp}, roles)
+
+	_, err = parseNodeRoleList("", nil)
+	assertApplicationErrorCode(t, code.InvalidNodeRole, err)
+
+	_, err = parseNodeRoleList("ndid", nil)
+	assertApplicationErrorCode(t, code.InvalidNodeRole, err)
+
+	_, err = parseNodeRoleList("proxy", []string{"rp"})
+	assertApplicationErrorCode(t, code.InvalidNodeRole, err)
+}
+
+func TestGetNodeRoles(t *testing.T) {
+	// node registered before multi-role support
+	node := &data.NodeDetail{Role: string(appTypes.NodeRoleIdp)}
+	assert.Equal(t, []appTypes.NodeRole{appTypes.NodeRoleIdp}, getNodeRoles(node))
+	assert.True(t, hasNodeRole(node, appTypes.NodeRoleIdp))
+	assert.False(t, hasNodeRole(node, appTypes.NodeRoleAs))
+
+	setNodeRoles(node, []appTypes.NodeRole{appTypes.NodeRoleIdp, appTypes.NodeRoleAs})
+	assert.Equal(t, string(appTypes.NodeRoleIdp), node.Role)
+	assert.Equal(t, []string{"IdP", "AS"}, getNodeRoleStringList(node))
+	assert.True(t, hasNodeRole(node, appTypes.NodeRoleAs))
+	assert.True(t, hasAnyNodeRole(node, appTypes.NodeRoleRp, appTypes.NodeRoleAs))
+	assert.False(t, hasAnyNodeRole(node, appTypes.NodeRoleRp, appTypes.NodeRoleProxy))
+}
+
+func TestMultiRoleNodeHelpers(t *testing.T) {
+	var app *ABCIApplication
+	node := &data.NodeDetail{}
+	setNodeRoles(node, []appTypes.NodeRole{appTypes.NodeRoleRp, appTypes.NodeRoleAs})
+
+	assert.True(t, app.isRPNode(node))
+	assert.True(t, app.isASNode(node))
+	assert.False(t, app.isIDPNode(node))
+	assert.True(t, app.isIDPorRPNode(node))
+	assert.False(t, app.isNDIDNode(node))
+}
@@ -26,8 +26,8 @@ import (
 	"encoding/json"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
@@ -39,16 +39,10 @@ type AddAllowedNodeSupportedFeatureParam struct {
 
 func (app *ABCIApplication) validateAddAllowedNodeSupportedFeature(funcParam AddAllowedNodeSupportedFeatureParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -128,16 +122,10 @@ type RemoveAllowedNodeSupportedFeatureParam struct {
 
 func (app *ABCIApplication) validateRemoveAllowedNodeSupportedFeature(funcParam RemoveAllowedNodeSupportedFeatureParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -221,20 +209,16 @@ func (app *ABCIApplication) getAllowedNodeSupportedFeatureList(param []byte, hei
 
 	nodeSupportedFeatureKeyIteratorBasePrefix := nodeSupportedFeatureKeyPrefix + keySeparator
 	nodeSupportedFeatureKeyIteratorPrefix := nodeSupportedFeatureKeyIteratorBasePrefix + funcParam.Prefix
-	r := goleveldbutil.BytesPrefix([]byte(nodeSupportedFeatureKeyIteratorPrefix))
-	iter, err := app.state.db.Iterator(r.Start, r.Limit)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-
+	err = app.state.IterateKeyPrefix([]byte(nodeSupportedFeatureKeyIteratorPrefix), nil, true, func(key []byte, value []byte) (bool, error) {
 		runes := []rune(string(key))
 		nodeSupportedFeature := string(runes[len(nodeSupportedFeatureKeyIteratorBasePrefix):])
 
 		result = append(result, nodeSupportedFeature)
+		return true, nil
+	})
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	iter.Close()
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
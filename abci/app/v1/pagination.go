@@ -0,0 +1,122 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+// maxQueryPageLimit caps number of items in one page of list query
+const maxQueryPageLimit = 1000
+
+// PaginationParam is optional part of list query parameters.
+// Cursor is `next_cursor` of previous page. Limit 0 returns all remaining items.
+type PaginationParam struct {
+	Limit  int    `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// PaginatedListResult wraps result of list query which returns an array
+// when pagination is requested
+type PaginatedListResult struct {
+	Items      interface{} `json:"items"`
+	NextCursor string      `json:"next_cursor"`
+}
+
+func (pagination PaginationParam) isPaginated() bool {
+	return pagination.Limit > 0 || pagination.Cursor != ""
+}
+
+func (pagination PaginationParam) pageLimit() int {
+	if pagination.Limit <= 0 {
+		return 0
+	}
+	if pagination.Limit > maxQueryPageLimit {
+		return maxQueryPageLimit
+	}
+	return pagination.Limit
+}
+
+// iterateCommittedKeyPrefix iterates committed keys with given prefix in key order
+// starting after cursor. ID is the key without prefix. include returns function
+// adding the item to result or nil when the item is not included.
+// Iteration stops at the first included item after page limit is reached.
+// Returns ID of last added item and whether there are more included items after it.
+func (app *ABCIApplication) iterateCommittedKeyPrefix(
+	prefix string,
+	pagination PaginationParam,
+	include func(id string, value []byte) (func(), error),
+) (lastID string, hasMore bool, err error) {
+	var start []byte
+	if pagination.Cursor != "" {
+		// smallest key after cursor
+		start = []byte(prefix + pagination.Cursor + "\x00")
+	}
+
+	limit := pagination.pageLimit()
+	count := 0
+	err = app.state.IterateKeyPrefix(
+		[]byte(prefix),
+		start,
+		true,
+		func(key []byte, value []byte) (bool, error) {
+			id := string(key[len(prefix):])
+			add, err := include(id, value)
+			if err != nil {
+				return false, err
+			}
+			if add == nil {
+				return true, nil
+			}
+			if limit > 0 && count == limit {
+				hasMore = true
+				return false, nil
+			}
+			add()
+			lastID = id
+			count++
+			return true, nil
+		},
+	)
+	if err != nil {
+		return "", false, err
+	}
+	return lastID, hasMore, nil
+}
+
+// paginateIDList returns index range of page in list of item IDs
+// and next cursor. Page is empty when cursor is not in the list.
+func paginateIDList(ids []string, pagination PaginationParam) (start int, end int, nextCursor string) {
+	if pagination.Cursor != "" {
+		start = len(ids)
+		for i, id := range ids {
+			if id == pagination.Cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end = len(ids)
+	limit := pagination.pageLimit()
+	if limit > 0 && start+limit < end {
+		end = start + limit
+		nextCursor = ids[end-1]
+	}
+	return start, end, nextCursor
+}
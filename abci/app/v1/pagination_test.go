@@ -0,0 +1,265 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestPaginateIDList(t *testing.T) {
+	ids := []string{"a", "b", "c", "d", "e"}
+	testCases := []struct {
+		name               string
+		param              PaginationParam
+		expectedIDs        []string
+		expectedNextCursor string
+	}{
+		{"no limit", PaginationParam{}, []string{"a", "b", "c", "d", "e"}, ""},
+		{"first page", PaginationParam{Limit: 2}, []string{"a", "b"}, "b"},
+		{"last page", PaginationParam{Limit: 2, Cursor: "d"}, []string{"e"}, ""},
+		{"unknown cursor", PaginationParam{Limit: 2, Cursor: "x"}, []string{}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, nextCursor := paginateIDList(ids, tc.param)
+			assert.Equal(t, tc.expectedIDs, ids[start:end])
+			assert.Equal(t, tc.expectedNextCursor, nextCursor)
+		})
+	}
+}
+
+func TestGetNodeIDListPagination(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+
+	// registration order differs from key order
+	nodes := []*data.NodeDetail{
+		{NodeName: "idp2", Role: "IdP", Active: true, ProxyNodeId: "proxy1"},
+		{NodeName: "idp1", Role: "IdP", Active: true},
+		{NodeName: "idp3", Role: "IdP", Active: false},
+		{NodeName: "idp4", Roles: []string{"RP", "IdP"}, Active: true},
+		{NodeName: "proxy1", Role: "Proxy", Active: true},
+		{NodeName: "rp1", Role: "RP", Active: true},
+	}
+	var allList data.AllList
+	var idpList data.IdPList
+	var rpList data.RPList
+	for _, node := range nodes {
+		value, err := utils.ProtoDeterministicMarshal(node)
+		assert.NoError(t, err)
+		app.state.Set([]byte(nodeIDKeyPrefix+keySeparator+node.NodeName), value)
+		allList.NodeId = append(allList.NodeId, node.NodeName)
+		if hasNodeRole(node, appTypes.NodeRoleIdp) {
+			idpList.NodeId = append(idpList.NodeId, node.NodeName)
+		}
+		if hasNodeRole(node, appTypes.NodeRoleRp) {
+			rpList.NodeId = append(rpList.NodeId, node.NodeName)
+		}
+	}
+	// NDID node is not in node ID lists
+	ndidNodeValue, err := utils.ProtoDeterministicMarshal(&data.NodeDetail{NodeName: "ndid1", Role: "NDID", Active: true})
+	assert.NoError(t, err)
+	app.state.Set([]byte(nodeIDKeyPrefix+keySeparator+"ndid1"), ndidNodeValue)
+	for key, list := range map[string]proto.Message{
+		"allList":               &allList,
+		string(idpListKeyBytes): &idpList,
+		"rpList":                &rpList,
+	} {
+		value, err := utils.ProtoDeterministicMarshal(list)
+		assert.NoError(t, err)
+		app.state.Set([]byte(key), value)
+	}
+	app.state.Save()
+
+	getNodeIDList := func(param GetNodeIDListParam) GetNodeIDListResult {
+		paramJSON, err := json.Marshal(param)
+		assert.NoError(t, err)
+		res := app.getNodeIDList(paramJSON)
+		var result GetNodeIDListResult
+		assert.NoError(t, json.Unmarshal(res.Value, &result))
+		return result
+	}
+
+	inactive := false
+	behindProxy := true
+	testCases := []struct {
+		name               string
+		param              GetNodeIDListParam
+		expectedNodeIDs    []string
+		expectedNextCursor string
+	}{
+		{"role", GetNodeIDListParam{Role: "idp"}, []string{"idp2", "idp1", "idp4"}, ""},
+		{"first page", GetNodeIDListParam{PaginationParam: PaginationParam{Limit: 2}, Role: "idp"}, []string{"idp2", "idp1"}, "idp1"},
+		{"last page", GetNodeIDListParam{PaginationParam: PaginationParam{Limit: 2, Cursor: "idp1"}, Role: "idp"}, []string{"idp4"}, ""},
+		// no next page when limit equals number of nodes
+		{"limit equals number of nodes", GetNodeIDListParam{PaginationParam: PaginationParam{Limit: 3}, Role: "idp"}, []string{"idp2", "idp1", "idp4"}, ""},
+		{"node with multiple roles", GetNodeIDListParam{Role: "rp"}, []string{"idp4", "rp1"}, ""},
+		{"inactive", GetNodeIDListParam{Active: &inactive}, []string{"idp3"}, ""},
+		{"behind proxy", GetNodeIDListParam{BehindProxy: &behindProxy}, []string{"idp2"}, ""},
+		{"proxy node ID", GetNodeIDListParam{ProxyNodeID: "proxy1"}, []string{"idp2"}, ""},
+		// role other than RP, IdP and AS lists all nodes except NDID
+		{"no role", GetNodeIDListParam{}, []string{"idp2", "idp1", "idp4", "proxy1", "rp1"}, ""},
+		{"proxy role", GetNodeIDListParam{Role: "proxy"}, []string{"idp2", "idp1", "idp4", "proxy1", "rp1"}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := getNodeIDList(tc.param)
+			assert.Equal(t, tc.expectedNodeIDs, result.NodeIDList)
+			assert.Equal(t, tc.expectedNextCursor, result.NextCursor)
+		})
+	}
+}
+
+func TestGetNodeIDListPaginationAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "idp2", "IdP")
+	commitTestBlock(app)
+
+	// baseline and newly registered nodes are paged in registration order
+	var nodeIDList []string
+	cursor := ""
+	for page := 0; page < 3; page++ {
+		paramJSON, err := json.Marshal(GetNodeIDListParam{PaginationParam: PaginationParam{Limit: 1, Cursor: cursor}, Role: "idp"})
+		assert.NoError(t, err)
+		var result GetNodeIDListResult
+		assert.NoError(t, json.Unmarshal(app.getNodeIDList(paramJSON).Value, &result))
+		nodeIDList = append(nodeIDList, result.NodeIDList...)
+		cursor = result.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"idp1", "idp2"}, nodeIDList)
+	assert.Equal(t, "", cursor)
+}
+
+func TestGetErrorCodeListPagination(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+	for _, errorCode := range []*data.ErrorCode{
+		{ErrorCode: 10101, Description: "a"},
+		{ErrorCode: 10102, Description: "b"},
+		{ErrorCode: 10103, Description: "c"},
+	} {
+		value, err := utils.ProtoDeterministicMarshal(errorCode)
+		assert.NoError(t, err)
+		app.state.Set([]byte(errorCodeKeyPrefix+keySeparator+"idp"+keySeparator+fmt.Sprintf("%d", errorCode.ErrorCode)), value)
+	}
+	app.state.Save()
+
+	res := app.getErrorCodeList([]byte(`{"type":"idp"}`))
+	var list []GetErrorCodeListResult
+	assert.NoError(t, json.Unmarshal(res.Value, &list))
+	assert.Len(t, list, 3)
+
+	res = app.getErrorCodeList([]byte(`{"type":"idp","limit":2}`))
+	var page struct {
+		Items      []GetErrorCodeListResult `json:"items"`
+		NextCursor string                   `json:"next_cursor"`
+	}
+	assert.NoError(t, json.Unmarshal(res.Value, &page))
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, "10102", page.NextCursor)
+
+	res = app.getErrorCodeList([]byte(`{"type":"idp","limit":2,"cursor":"10102"}`))
+	assert.NoError(t, json.Unmarshal(res.Value, &page))
+	assert.Equal(t, []GetErrorCodeListResult{{ErrorCode: 10103, Description: "c"}}, page.Items)
+	assert.Equal(t, "", page.NextCursor)
+
+	// no next page when limit equals number of remaining items
+	page.NextCursor = "x"
+	res = app.getErrorCodeList([]byte(`{"type":"idp","limit":3}`))
+	assert.NoError(t, json.Unmarshal(res.Value, &page))
+	assert.Len(t, page.Items, 3)
+	assert.Equal(t, "", page.NextCursor)
+}
+
+func TestGetNodePublicKeyListPagination(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+
+	nodeDetailValue, err := utils.ProtoDeterministicMarshal(&data.NodeDetail{NodeName: "rp1", Role: "RP", Active: true})
+	assert.NoError(t, err)
+	app.state.Set([]byte(nodeIDKeyPrefix+keySeparator+"rp1"), nodeDetailValue)
+	setNodeKey := func(keyType string, version int64, active bool) {
+		value, err := utils.ProtoDeterministicMarshal(&data.NodeKey{
+			PublicKey: fmt.Sprintf("%s%d", keyType, version),
+			Version:   version,
+			Active:    active,
+		})
+		assert.NoError(t, err)
+		app.state.Set([]byte(nodeKeyKeyPrefix+keySeparator+keyType+keySeparator+"rp1"+keySeparator+fmt.Sprintf("%d", version)), value)
+	}
+	setNodeKey("signing", 1, false)
+	setNodeKey("signing", 2, true)
+	setNodeKey("encryption", 1, false)
+	app.state.Save()
+
+	getNodePublicKeyList := func(param string) getNodePublicKeyListResult {
+		res := app.getNodePublicKeyList([]byte(param))
+		var result getNodePublicKeyListResult
+		assert.NoError(t, json.Unmarshal(res.Value, &result))
+		return result
+	}
+
+	result := getNodePublicKeyList(`{"node_id":"rp1","limit":2}`)
+	assert.Len(t, result.SigningPublicKeyList, 2)
+	assert.Equal(t, "signing|2", result.NextCursor)
+
+	result = getNodePublicKeyList(`{"node_id":"rp1","limit":2,"cursor":"signing|2"}`)
+	assert.Empty(t, result.SigningPublicKeyList)
+	assert.Equal(t, []NodeKey{{PublicKey: "encryption1", Version: 1}}, result.EncryptionPublicKeyList)
+	assert.Equal(t, "", result.NextCursor)
+
+	// no next page when later key types have no matching key
+	result = getNodePublicKeyList(`{"node_id":"rp1","limit":1,"active":true}`)
+	assert.Equal(t, []NodeKey{{PublicKey: "signing2", Version: 2, Active: true}}, result.SigningPublicKeyList)
+	assert.Equal(t, "", result.NextCursor)
+
+	result = getNodePublicKeyList(`{"node_id":"rp1","limit":2,"key_type":"signing"}`)
+	assert.Len(t, result.SigningPublicKeyList, 2)
+	assert.Equal(t, "", result.NextCursor)
+}
@@ -0,0 +1,440 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// parameterDefinition describes a global setting in parameter store.
+// Parameter value is stored at key of its name (same key and encoding
+// used by dedicated set method of the setting).
+// Integer values are int64, number values are float64 and
+// number list values are []float64.
+type parameterDefinition struct {
+	name      string
+	valueType appTypes.ParameterType
+	min       *float64 // bound of value or each item of list
+	max       *float64
+	// adminScope allows sub-administrators with the scope to set the parameter,
+	// NDID only when empty
+	adminScope   appTypes.AdminPermissionScope
+	defaultValue interface{}
+	description  string
+	marshal      func(value interface{}) ([]byte, error)
+	unmarshal    func(value []byte) (interface{}, error)
+}
+
+func parameterBound(value float64) *float64 {
+	return &value
+}
+
+var parameterDefinitions = []parameterDefinition{
+	{
+		name:         "AllowedMinIalForRegisterIdentityAtFirstIdp",
+		valueType:    appTypes.ParameterTypeNumber,
+		min:          parameterBound(0),
+		defaultValue: float64(0),
+		description:  "Minimum IAL of identity registered at first IdP",
+		marshal: func(value interface{}) ([]byte, error) {
+			return utils.ProtoDeterministicMarshal(&data.AllowedMinIalForRegisterIdentityAtFirstIdp{
+				MinIal: value.(float64),
+			})
+		},
+		unmarshal: func(value []byte) (interface{}, error) {
+			var allowedMinIal data.AllowedMinIalForRegisterIdentityAtFirstIdp
+			err := proto.Unmarshal(value, &allowedMinIal)
+			return allowedMinIal.MinIal, err
+		},
+	},
+	{
+		name:         "ServicePriceMinEffectiveDatetimeDelay",
+		valueType:    appTypes.ParameterTypeInteger,
+		min:          parameterBound(0),
+		max:          parameterBound(math.MaxUint32),
+		adminScope:   appTypes.AdminPermissionScopeServices,
+		defaultValue: int64(12 * time.Hour / time.Second),
+		description:  "Minimum delay (in seconds) of service price effective datetime for services without specific setting",
+		marshal: func(value interface{}) ([]byte, error) {
+			return utils.ProtoDeterministicMarshal(&data.ServicePriceMinEffectiveDatetimeDelay{
+				DurationSecond: uint32(value.(int64)),
+			})
+		},
+		unmarshal: func(value []byte) (interface{}, error) {
+			var servicePriceMinEffectiveDatetimeDelay data.ServicePriceMinEffectiveDatetimeDelay
+			err := proto.Unmarshal(value, &servicePriceMinEffectiveDatetimeDelay)
+			return int64(servicePriceMinEffectiveDatetimeDelay.DurationSecond), err
+		},
+	},
+	{
+		name:         "SupportedAALList",
+		valueType:    appTypes.ParameterTypeNumberList,
+		min:          parameterBound(0),
+		defaultValue: []float64{},
+		description:  "List of supported AAL",
+		marshal: func(value interface{}) ([]byte, error) {
+			return utils.ProtoDeterministicMarshal(&data.SupportedAALList{
+				AalList: value.([]float64),
+			})
+		},
+		unmarshal: func(value []byte) (interface{}, error) {
+			var supportedAALList data.SupportedAALList
+			err := proto.Unmarshal(value, &supportedAALList)
+			return supportedAALList.AalList, err
+		},
+	},
+	{
+		name:         "SupportedIALList",
+		valueType:    appTypes.ParameterTypeNumberList,
+		min:          parameterBound(0),
+		defaultValue: []float64{},
+		description:  "List of supported IAL",
+		marshal: func(value interface{}) ([]byte, error) {
+			return utils.ProtoDeterministicMarshal(&data.SupportedIALList{
+				IalList: value.([]float64),
+			})
+		},
+		unmarshal: func(value []byte) (interface{}, error) {
+			var supportedIALList data.SupportedIALList
+			err := proto.Unmarshal(value, &supportedIALList)
+			return supportedIALList.IalList, err
+		},
+	},
+	{
+		name:         "TimeOutBlockRegisterIdentity",
+		valueType:    appTypes.ParameterTypeInteger,
+		min:          parameterBound(1),
+		defaultValue: int64(0),
+		description:  "Number of blocks before identity registration times out (0 when not set)",
+		marshal: func(value interface{}) ([]byte, error) {
+			return utils.ProtoDeterministicMarshal(&data.TimeOutBlockRegisterIdentity{
+				TimeOutBlock: value.(int64),
+			})
+		},
+		unmarshal: func(value []byte) (interface{}, error) {
+			var timeOut data.TimeOutBlockRegisterIdentity
+			err := proto.Unmarshal(value, &timeOut)
+			return timeOut.TimeOutBlock, err
+		},
+	},
+}
+
+func getParameterDefinition(name string) (*parameterDefinition, error) {
+	for i := range parameterDefinitions {
+		if parameterDefinitions[i].name == name {
+			return &parameterDefinitions[i], nil
+		}
+	}
+	return nil, &ApplicationError{
+		Code:    code.ParameterNotFound,
+		Message: "Parameter not found",
+	}
+}
+
+func checkParameterBound(definition *parameterDefinition, value float64) error {
+	if (definition.min != nil && value < *definition.min) ||
+		(definition.max != nil && value > *definition.max) {
+		return &ApplicationError{
+			Code:    code.InvalidParameterValue,
+			Message: fmt.Sprintf("Value of parameter %s is out of bound", definition.name),
+		}
+	}
+	return nil
+}
+
+// parseParameterValue decodes JSON value to type of parameter and checks bounds
+func parseParameterValue(definition *parameterDefinition, valueJSON json.RawMessage) (interface{}, error) {
+	var value interface{}
+	var err error
+	switch definition.valueType {
+	case appTypes.ParameterTypeInteger:
+		var integerValue int64
+		err = json.Unmarshal(valueJSON, &integerValue)
+		value = integerValue
+	case appTypes.ParameterTypeNumber:
+		var numberValue float64
+		err = json.Unmarshal(valueJSON, &numberValue)
+		value = numberValue
+	case appTypes.ParameterTypeNumberList:
+		numberListValue := make([]float64, 0)
+		err = json.Unmarshal(valueJSON, &numberListValue)
+		value = numberListValue
+	}
+	if err != nil || value == nil {
+		return nil, &ApplicationError{
+			Code:    code.InvalidParameterValue,
+			Message: fmt.Sprintf("Value of parameter %s must be %s", definition.name, definition.valueType),
+		}
+	}
+
+	err = validateParameterValue(definition, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func validateParameterValue(definition *parameterDefinition, value interface{}) error {
+	switch v := value.(type) {
+	case int64:
+		return checkParameterBound(definition, float64(v))
+	case float64:
+		return checkParameterBound(definition, v)
+	case []float64:
+		for _, item := range v {
+			err := checkParameterBound(definition, item)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// storeParameter validates typed value and writes it to app state
+func (app *ABCIApplication) storeParameter(name string, value interface{}) error {
+	definition, err := getParameterDefinition(name)
+	if err != nil {
+		return err
+	}
+	err = validateParameterValue(definition, value)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := definition.marshal(value)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
+	}
+	app.state.Set([]byte(definition.name), valueBytes)
+	return nil
+}
+
+// getParameterValue returns typed value of parameter and whether it is set.
+// Default value is returned when parameter has never been set.
+func (app *ABCIApplication) getParameterValue(name string, committedState bool) (value interface{}, isSet bool, err error) {
+	definition, err := getParameterDefinition(name)
+	if err != nil {
+		return nil, false, err
+	}
+	valueBytes, err := app.state.Get([]byte(definition.name), committedState)
+	if err != nil {
+		return nil, false, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if valueBytes == nil {
+		return definition.defaultValue, false, nil
+	}
+	value, err = definition.unmarshal(valueBytes)
+	if err != nil {
+		return nil, false, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	if list, ok := value.([]float64); ok && list == nil {
+		value = []float64{}
+	}
+	return value, true, nil
+}
+
+type SetParameterParam struct {
+	Name  string          `json:"name"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (app *ABCIApplication) validateSetParameter(funcParam SetParameterParam, callerNodeID string, committedState bool, checktx bool) error {
+	definition, err := getParameterDefinition(funcParam.Name)
+	if err != nil {
+		return err
+	}
+
+	// permission
+	if definition.adminScope != "" {
+		err = app.checkAdminPermission(callerNodeID, definition.adminScope, committedState)
+		if err != nil {
+			return err
+		}
+	} else {
+		ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &ApplicationError{
+				Code:    code.NoPermissionForCallNDIDMethod,
+				Message: "This node does not have permission to call NDID method",
+			}
+		}
+	}
+
+	// stateless
+
+	_, err = parseParameterValue(definition, funcParam.Value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) setParameterCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam SetParameterParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateSetParameter(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+func (app *ABCIApplication) setParameter(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("SetParameter, Parameter: %s", param)
+	var funcParam SetParameterParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateSetParameter(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	definition, _ := getParameterDefinition(funcParam.Name)
+	value, _ := parseParameterValue(definition, funcParam.Value)
+	err = app.storeParameter(funcParam.Name, value)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type GetParameterParam struct {
+	Name string `json:"name"`
+}
+
+type ParameterInfo struct {
+	Name         string      `json:"name"`
+	Type         string      `json:"type"`
+	Value        interface{} `json:"value"`
+	IsDefault    bool        `json:"is_default"`
+	DefaultValue interface{} `json:"default_value"`
+	Min          *float64    `json:"min,omitempty"`
+	Max          *float64    `json:"max,omitempty"`
+	Description  string      `json:"description"`
+}
+
+func (app *ABCIApplication) getParameterInfo(definition *parameterDefinition) (*ParameterInfo, error) {
+	value, isSet, err := app.getParameterValue(definition.name, true)
+	if err != nil {
+		return nil, err
+	}
+	return &ParameterInfo{
+		Name:         definition.name,
+		Type:         string(definition.valueType),
+		Value:        value,
+		IsDefault:    !isSet,
+		DefaultValue: definition.defaultValue,
+		Min:          definition.min,
+		Max:          definition.max,
+		Description:  definition.description,
+	}, nil
+}
+
+func (app *ABCIApplication) getParameter(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetParameter, Parameter: %s", param)
+	var funcParam GetParameterParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	definition, err := getParameterDefinition(funcParam.Name)
+	if err != nil {
+		return app.NewResponseQuery(nil, "not found", app.state.Height)
+	}
+
+	result, err := app.getParameterInfo(definition)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	return app.NewResponseQuery(resultJSON, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) getParameterList(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetParameterList, Parameter: %s", param)
+
+	result := make([]*ParameterInfo, 0, len(parameterDefinitions))
+	for i := range parameterDefinitions {
+		parameterInfo, err := app.getParameterInfo(&parameterDefinitions[i])
+		if err != nil {
+			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+		}
+		result = append(result, parameterInfo)
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	return app.NewResponseQuery(resultJSON, "success", app.state.Height)
+}
@@ -0,0 +1,97 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestParseParameterValue(t *testing.T) {
+	definition, err := getParameterDefinition("ServicePriceMinEffectiveDatetimeDelay")
+	assert.NoError(t, err)
+
+	value, err := parseParameterValue(definition, json.RawMessage(`3600`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), value)
+
+	_, err = parseParameterValue(definition, json.RawMessage(`1.5`))
+	assertApplicationErrorCode(t, code.InvalidParameterValue, err)
+	_, err = parseParameterValue(definition, json.RawMessage(`-1`))
+	assertApplicationErrorCode(t, code.InvalidParameterValue, err)
+	_, err = parseParameterValue(definition, json.RawMessage(`4294967296`))
+	assertApplicationErrorCode(t, code.InvalidParameterValue, err)
+
+	definition, err = getParameterDefinition("SupportedIALList")
+	assert.NoError(t, err)
+	value, err = parseParameterValue(definition, json.RawMessage(`[1.1, 2.3]`))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.1, 2.3}, value)
+	_, err = parseParameterValue(definition, json.RawMessage(`"1.1"`))
+	assertApplicationErrorCode(t, code.InvalidParameterValue, err)
+
+	_, err = getParameterDefinition("Unknown")
+	assertApplicationErrorCode(t, code.ParameterNotFound, err)
+}
+
+func TestParameterStoreReadsLegacyKeys(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+
+	value, isSet, err := app.getParameterValue("ServicePriceMinEffectiveDatetimeDelay", false)
+	assert.NoError(t, err)
+	assert.False(t, isSet)
+	assert.Equal(t, int64(43200), value)
+
+	// value written by dedicated set method before parameter store
+	legacyValue, err := utils.ProtoDeterministicMarshal(&data.SupportedIALList{IalList: []float64{1.1, 2.3}})
+	assert.NoError(t, err)
+	app.state.Set([]byte("SupportedIALList"), legacyValue)
+
+	value, isSet, err = app.getParameterValue("SupportedIALList", false)
+	assert.NoError(t, err)
+	assert.True(t, isSet)
+	assert.Equal(t, []float64{1.1, 2.3}, value)
+
+	err = app.storeParameter("SupportedIALList", []float64{3})
+	assert.NoError(t, err)
+	storedValue, err := app.state.Get([]byte("SupportedIALList"), false)
+	assert.NoError(t, err)
+	expectedValue, err := utils.ProtoDeterministicMarshal(&data.SupportedIALList{IalList: []float64{3}})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedValue, storedValue)
+
+	err = app.storeParameter("TimeOutBlockRegisterIdentity", int64(0))
+	assertApplicationErrorCode(t, code.InvalidParameterValue, err)
+}
@@ -134,6 +134,42 @@ func (app *ABCIApplication) callQuery(name string, param []byte, height int64) *
 		return app.isSuppressedIdentityModificationNotificationNode(param, height)
 	case "GetAllowedNodeSupportedFeatureList":
 		return app.getAllowedNodeSupportedFeatureList(param, height)
+	case "GetIdPAssociationTransfer":
+		return app.getIdPAssociationTransferDetail(param)
+	case "GetNodeCertificateAuthorityList":
+		return app.getNodeCertificateAuthorityListQuery(param)
+	case "GetDecommissionedNodeInfo":
+		return app.getDecommissionedNodeInfo(param)
+	case "GetStaleNodeList":
+		return app.getStaleNodeList(param)
+	case "GetValidatorList":
+		return app.getValidatorListQuery(param)
+	case "GetValidatorHistory":
+		return app.getValidatorHistory(param)
+	case "GetValidatorPowerCap":
+		return app.getValidatorPowerCapQuery(param)
+	case "GetAdminPermission":
+		return app.getAdminPermissionQuery(param)
+	case "GetAdminPermissionList":
+		return app.getAdminPermissionList(param)
+	case "GetGovernanceConfig":
+		return app.getGovernanceConfigQuery(param)
+	case "GetProposal":
+		return app.getProposalQuery(param)
+	case "GetProposalList":
+		return app.getProposalList(param)
+	case "GetScheduledChangeList":
+		return app.getScheduledChangeListQuery(param)
+	case "GetPausedMethodList":
+		return app.getPausedMethodListQuery(param)
+	case "GetUpgradePlan":
+		return app.getUpgradePlan(param)
+	case "GetParameter":
+		return app.getParameter(param)
+	case "GetParameterList":
+		return app.getParameterList(param)
+	case "BatchQuery":
+		return app.batchQuery(param, height)
 	default:
 		return &abcitypes.ResponseQuery{Code: code.UnknownMethod, Log: "Unknown method name"}
 	}
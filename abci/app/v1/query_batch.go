@@ -0,0 +1,114 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+// maxBatchQuerySize limits number of queries in one BatchQuery
+const maxBatchQuerySize = 100
+
+type BatchQueryParam struct {
+	Queries []BatchQueryItem `json:"queries"`
+}
+
+type BatchQueryItem struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type BatchQueryResult struct {
+	Results []BatchQueryItemResult `json:"results"`
+}
+
+type BatchQueryItemResult struct {
+	Method string          `json:"method"`
+	Code   uint32          `json:"code"`
+	Log    string          `json:"log"`
+	Value  json.RawMessage `json:"value"`
+}
+
+func (app *ABCIApplication) batchQuery(param []byte, height int64) *abcitypes.ResponseQuery {
+	app.logger.Infof("BatchQuery, Parameter: %s", param)
+	var funcParam BatchQueryParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if len(funcParam.Queries) > maxBatchQuerySize {
+		return app.NewResponseQuery(nil, fmt.Sprintf("Too many queries in batch (max %d)", maxBatchQuerySize), app.state.Height)
+	}
+
+	// all queries are executed on the same state (ABCI calls are serialized)
+	result := BatchQueryResult{
+		Results: make([]BatchQueryItemResult, 0, len(funcParam.Queries)),
+	}
+	for _, query := range funcParam.Queries {
+		result.Results = append(result.Results, app.batchQueryItem(query, height))
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(resultJSON, "success", app.state.Height)
+}
+
+func (app *ABCIApplication) batchQueryItem(query BatchQueryItem, height int64) (result BatchQueryItemResult) {
+	result.Method = query.Method
+
+	// Recover when panic
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Errorf("Recovered in %s, %s", r, identifyPanic())
+			result.Code = code.UnknownError
+			result.Log = "Unknown error"
+			result.Value = nil
+		}
+	}()
+
+	if query.Method == "" || query.Method == "BatchQuery" {
+		result.Code = code.UnknownMethod
+		result.Log = "Unknown method name"
+		return result
+	}
+
+	res := app.callQuery(query.Method, query.Params, height)
+	result.Code = res.Code
+	result.Log = res.Log
+	if len(res.Value) > 0 {
+		if json.Valid(res.Value) {
+			result.Value = res.Value
+		} else {
+			// non-JSON value is returned as base64 string
+			result.Value, _ = json.Marshal(res.Value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func TestBatchQuery(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+
+	res := app.callQuery("BatchQuery", []byte(`{"queries":[
+		{"method":"GetParameter","params":{"name":"SupportedIALList"}},
+		{"method":"GetParameter","params":{"name":"Unknown"}},
+		{"method":"UnknownQuery","params":{}},
+		{"method":"BatchQuery","params":{"queries":[]}}
+	]}`), 0)
+	assert.Equal(t, "success", res.Log)
+
+	var result BatchQueryResult
+	assert.NoError(t, json.Unmarshal(res.Value, &result))
+	assert.Len(t, result.Results, 4)
+
+	assert.Equal(t, "GetParameter", result.Results[0].Method)
+	assert.Equal(t, "success", result.Results[0].Log)
+	var parameterInfo ParameterInfo
+	assert.NoError(t, json.Unmarshal(result.Results[0].Value, &parameterInfo))
+	assert.Equal(t, "SupportedIALList", parameterInfo.Name)
+
+	assert.Equal(t, "not found", result.Results[1].Log)
+	assert.Equal(t, json.RawMessage("null"), result.Results[1].Value)
+
+	assert.Equal(t, code.UnknownMethod, result.Results[2].Code)
+	assert.Equal(t, code.UnknownMethod, result.Results[3].Code)
+}
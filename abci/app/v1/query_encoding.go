@@ -0,0 +1,150 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	protoQuery "github.com/ndidplatform/smart-contract/v9/protos/query"
+)
+
+// protobufQueryResponses maps query method to its protobuf response message
+// defined in protos/query. Every query method must be in the map; response
+// messages are checked against JSON result structs in query_encoding_test.go
+// so JSON field added to result struct without message field fails the test.
+var protobufQueryResponses = map[string]func() proto.Message{
+	"GetNodeSigningPublicKey":                       func() proto.Message { return &protoQuery.NodeKey{} },
+	"GetNodeEncryptionPublicKey":                    func() proto.Message { return &protoQuery.NodeKey{} },
+	"GetIdpNodes":                                   func() proto.Message { return &protoQuery.GetIdpNodesResult{} },
+	"GetRequest":                                    func() proto.Message { return &protoQuery.GetRequestResult{} },
+	"GetRequestDetail":                              func() proto.Message { return &protoQuery.GetRequestDetailResult{} },
+	"GetAsNodesByServiceId":                         func() proto.Message { return &protoQuery.GetAsNodesByServiceIdResult{} },
+	"GetMqAddresses":                                func() proto.Message { return &protoQuery.GetMqAddressesResult{} },
+	"GetNodeToken":                                  func() proto.Message { return &protoQuery.GetNodeTokenResult{} },
+	"GetPriceFunc":                                  func() proto.Message { return &protoQuery.GetPriceFuncResult{} },
+	"GetServiceDetail":                              func() proto.Message { return &protoQuery.ServiceDetail{} },
+	"GetNamespaceList":                              func() proto.Message { return &protoQuery.GetNamespaceListResult{} },
+	"CheckExistingIdentity":                         func() proto.Message { return &protoQuery.CheckExistingIdentityResult{} },
+	"GetAccessorKey":                                func() proto.Message { return &protoQuery.GetAccessorKeyResult{} },
+	"GetServiceList":                                func() proto.Message { return &protoQuery.GetServiceListResult{} },
+	"GetNodeSigningMasterPublicKey":                 func() proto.Message { return &protoQuery.NodeKey{} },
+	"GetNodeInfo":                                   func() proto.Message { return &protoQuery.GetNodeInfoResult{} },
+	"GetNodePublicKeyList":                          func() proto.Message { return &protoQuery.GetNodePublicKeyListResult{} },
+	"CheckExistingAccessorID":                       func() proto.Message { return &protoQuery.CheckExistingResult{} },
+	"GetIdentityInfo":                               func() proto.Message { return &protoQuery.GetIdentityInfoResult{} },
+	"GetDataSignature":                              func() proto.Message { return &protoQuery.GetDataSignatureResult{} },
+	"GetServicesByAsID":                             func() proto.Message { return &protoQuery.GetServicesByAsIDResult{} },
+	"GetIdpNodesInfo":                               func() proto.Message { return &protoQuery.GetIdpNodesInfoResult{} },
+	"GetAsNodesInfoByServiceId":                     func() proto.Message { return &protoQuery.GetAsNodesInfoByServiceIdResult{} },
+	"GetNodesBehindProxyNode":                       func() proto.Message { return &protoQuery.GetNodesBehindProxyNodeResult{} },
+	"GetNodeIDList":                                 func() proto.Message { return &protoQuery.GetNodeIDListResult{} },
+	"GetAccessorOwner":                              func() proto.Message { return &protoQuery.GetAccessorOwnerResult{} },
+	"GetErrorCodeList":                              func() proto.Message { return &protoQuery.GetErrorCodeListResult{} },
+	"IsInitEnded":                                   func() proto.Message { return &protoQuery.IsInitEndedResult{} },
+	"GetChainHistory":                               func() proto.Message { return &protoQuery.GetChainHistoryResult{} },
+	"GetReferenceGroupCode":                         func() proto.Message { return &protoQuery.GetReferenceGroupCodeResult{} },
+	"GetReferenceGroupCodeByAccessorID":             func() proto.Message { return &protoQuery.GetReferenceGroupCodeResult{} },
+	"GetSupportedIALList":                           func() proto.Message { return &protoQuery.GetSupportedIALListResult{} },
+	"GetSupportedAALList":                           func() proto.Message { return &protoQuery.GetSupportedAALListResult{} },
+	"GetAllowedModeList":                            func() proto.Message { return &protoQuery.GetAllowedModeListResult{} },
+	"GetAllowedMinIalForRegisterIdentityAtFirstIdp": func() proto.Message { return &protoQuery.GetAllowedMinIalForRegisterIdentityAtFirstIdpResult{} },
+	"GetServicePriceList":                           func() proto.Message { return &protoQuery.GetServicePriceListResult{} },
+	"GetServicePriceCeiling":                        func() proto.Message { return &protoQuery.GetServicePriceCeilingResult{} },
+	"GetServicePriceMinEffectiveDatetimeDelay":      func() proto.Message { return &protoQuery.GetServicePriceMinEffectiveDatetimeDelayResult{} },
+	"GetMessage":                                    func() proto.Message { return &protoQuery.GetMessageResult{} },
+	"GetMessageDetail":                              func() proto.Message { return &protoQuery.GetMessageDetailResult{} },
+	"GetRequestTypeList":                            func() proto.Message { return &protoQuery.GetRequestTypeListResult{} },
+	"GetSuppressedIdentityModificationNotificationNodeList": func() proto.Message { return &protoQuery.GetSuppressedIdentityModificationNotificationNodeListResult{} },
+	"IsSuppressedIdentityModificationNotificationNode":      func() proto.Message { return &protoQuery.IsSuppressedIdentityModificationNotificationNodeResult{} },
+	"GetAllowedNodeSupportedFeatureList":                    func() proto.Message { return &protoQuery.GetAllowedNodeSupportedFeatureListResult{} },
+	"GetIdPAssociationTransfer":                             func() proto.Message { return &protoQuery.GetIdPAssociationTransferResult{} },
+	"GetNodeCertificateAuthorityList":                       func() proto.Message { return &protoQuery.GetNodeCertificateAuthorityListResult{} },
+	"GetDecommissionedNodeInfo":                             func() proto.Message { return &protoQuery.GetDecommissionedNodeInfoResult{} },
+	"GetStaleNodeList":                                      func() proto.Message { return &protoQuery.GetStaleNodeListResult{} },
+	"GetValidatorList":                                      func() proto.Message { return &protoQuery.GetValidatorListResult{} },
+	"GetValidatorHistory":                                   func() proto.Message { return &protoQuery.GetValidatorHistoryResult{} },
+	"GetValidatorPowerCap":                                  func() proto.Message { return &protoQuery.GetValidatorPowerCapResult{} },
+	"GetAdminPermission":                                    func() proto.Message { return &protoQuery.AdminPermission{} },
+	"GetAdminPermissionList":                                func() proto.Message { return &protoQuery.GetAdminPermissionListResult{} },
+	"GetGovernanceConfig":                                   func() proto.Message { return &protoQuery.GetGovernanceConfigResult{} },
+	"GetProposal":                                           func() proto.Message { return &protoQuery.ProposalInfo{} },
+	"GetProposalList":                                       func() proto.Message { return &protoQuery.GetProposalListResult{} },
+	"GetScheduledChangeList":                                func() proto.Message { return &protoQuery.GetScheduledChangeListResult{} },
+	"GetPausedMethodList":                                   func() proto.Message { return &protoQuery.GetPausedMethodListResult{} },
+	"GetUpgradePlan":                                        func() proto.Message { return &protoQuery.GetUpgradePlanResult{} },
+	"GetParameter":                                          func() proto.Message { return &protoQuery.ParameterInfo{} },
+	"GetParameterList":                                      func() proto.Message { return &protoQuery.GetParameterListResult{} },
+	"BatchQuery":                                            func() proto.Message { return &protoQuery.BatchQueryResult{} },
+}
+
+// protobufQuery runs query and returns its response value as protobuf encoded
+// response message instead of JSON
+func (app *ABCIApplication) protobufQuery(method string, param []byte, height int64) *abcitypes.ResponseQuery {
+	if _, ok := protobufQueryResponses[method]; !ok {
+		return app.NewResponseQuery(nil, fmt.Sprintf("protobuf response encoding is not supported for method: %s", method), app.state.Height)
+	}
+
+	res := app.QueryRouter(method, param, height)
+	if len(res.Value) == 0 {
+		return res
+	}
+
+	value, err := encodeProtobufQueryResponse(method, res.Value)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	res.Value = value
+	return res
+}
+
+// encodeProtobufQueryResponse converts JSON response value of query to its
+// protobuf response message. JSON array is converted as items field.
+func encodeProtobufQueryResponse(method string, valueJSON []byte) ([]byte, error) {
+	newResponse, ok := protobufQueryResponses[method]
+	if !ok {
+		return nil, fmt.Errorf("protobuf response encoding is not supported for method: %s", method)
+	}
+
+	valueJSON = bytes.TrimSpace(valueJSON)
+	if len(valueJSON) > 0 && valueJSON[0] == '[' {
+		wrapped := make([]byte, 0, len(valueJSON)+len(`{"items":}`))
+		wrapped = append(wrapped, `{"items":`...)
+		wrapped = append(wrapped, valueJSON...)
+		wrapped = append(wrapped, '}')
+		valueJSON = wrapped
+	}
+
+	// JSON key without response message field is an error, not silently dropped
+	response := newResponse()
+	err := protojson.Unmarshal(valueJSON, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.MarshalOptions{Deterministic: true}.Marshal(response)
+}
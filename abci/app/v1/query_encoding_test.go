@@ -0,0 +1,348 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+	protoQuery "github.com/ndidplatform/smart-contract/v9/protos/query"
+	protoTm "github.com/ndidplatform/smart-contract/v9/protos/tendermint"
+)
+
+// fillTestValue sets every field, slice and map of v to non-zero value so
+// that JSON result includes all keys (including omitempty ones). Interface
+// values are filled according to their concrete value.
+func fillTestValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fillTestValue(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		concrete := reflect.New(v.Elem().Type()).Elem()
+		concrete.Set(v.Elem())
+		fillTestValue(concrete)
+		v.Set(concrete)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Unix(1, 0).UTC()))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillTestValue(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if v.Type() == reflect.TypeOf(json.RawMessage{}) {
+			v.Set(reflect.ValueOf(json.RawMessage(`{"key":"value"}`)))
+			return
+		}
+		if v.Len() == 0 {
+			v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		}
+		for i := 0; i < v.Len(); i++ {
+			fillTestValue(v.Index(i))
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		fillTestValue(key)
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillTestValue(value)
+		v.SetMapIndex(key, value)
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	}
+}
+
+// getQueryMethodList returns methods handled by callQuery
+func getQueryMethodList(t *testing.T) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "query.go", nil, 0)
+	assert.NoError(t, err)
+	methods := make([]string, 0)
+	ast.Inspect(file, func(node ast.Node) bool {
+		caseClause, ok := node.(*ast.CaseClause)
+		if !ok {
+			return true
+		}
+		for _, expr := range caseClause.List {
+			if lit, ok := expr.(*ast.BasicLit); ok {
+				method, err := strconv.Unquote(lit.Value)
+				assert.NoError(t, err)
+				methods = append(methods, method)
+			}
+		}
+		return true
+	})
+	return methods
+}
+
+func TestProtobufQueryResponseSchema(t *testing.T) {
+	// JSON results of each method, paginated and non-paginated list results
+	// and every concrete type of interface values are listed
+	testCases := map[string][]interface{}{
+		"GetNodeSigningPublicKey":       {NodeKey{}},
+		"GetNodeSigningMasterPublicKey": {NodeKey{}},
+		"GetNodeEncryptionPublicKey":    {NodeKey{}},
+		"GetIdpNodes":                   {GetIdpNodesResult{}},
+		"GetRequest":                    {GetRequestResult{}},
+		"GetRequestDetail":              {GetRequestDetailResult{}},
+		"GetAsNodesByServiceId":         {GetAsNodesByServiceIdResult{}},
+		"GetMqAddresses":                {GetMqAddressesResult{}},
+		"GetNodeToken":                  {GetNodeTokenResult{}},
+		"GetPriceFunc":                  {GetPriceFuncResult{}},
+		"GetServiceDetail":              {data.ServiceDetail{}},
+		"GetNamespaceList": {
+			[]*data.Namespace{},
+			PaginatedListResult{Items: []*data.Namespace{}},
+		},
+		"CheckExistingIdentity": {CheckExistingIdentityResult{}},
+		"GetAccessorKey":        {GetAccessorKeyResult{}},
+		"GetServiceList": {
+			[]*data.ServiceDetail{},
+			PaginatedListResult{Items: []*data.ServiceDetail{}},
+		},
+		"GetNodeInfo":             {GetNodeInfoResult{}},
+		"GetNodePublicKeyList":    {getNodePublicKeyListResult{}},
+		"CheckExistingAccessorID": {CheckExistingResult{}},
+		"GetIdentityInfo":         {GetIdentityInfoResult{}},
+		"GetDataSignature":        {GetDataSignatureResult{}},
+		"GetServicesByAsID":       {GetServicesByAsIDResult{}},
+		"GetIdpNodesInfo":         {GetIdpNodesInfoResult{}},
+		"GetAsNodesInfoByServiceId": {
+			GetAsNodesInfoByServiceIdResult{Node: []interface{}{ASWithMqNode{}, ASWithMqNodeBehindProxy{}}},
+		},
+		"GetNodesBehindProxyNode": {
+			GetNodesBehindProxyNodeResult{Nodes: []interface{}{IdPBehindProxy{}, ASorRPBehindProxy{}}},
+		},
+		"GetNodeIDList":    {GetNodeIDListResult{}},
+		"GetAccessorOwner": {GetAccessorOwnerResult{}},
+		"GetErrorCodeList": {
+			[]*GetErrorCodeListResult{},
+			PaginatedListResult{Items: []*GetErrorCodeListResult{}},
+		},
+		"IsInitEnded":                                           {IsInitEndedResult{}},
+		"GetChainHistory":                                       {ChainHistory{}},
+		"GetReferenceGroupCode":                                 {GetReferenceGroupCodeResult{}},
+		"GetReferenceGroupCodeByAccessorID":                     {GetReferenceGroupCodeResult{}},
+		"GetSupportedIALList":                                   {GetSupportedIALListResult{}},
+		"GetSupportedAALList":                                   {GetSupportedAALListResult{}},
+		"GetAllowedModeList":                                    {GetAllowedModeListResult{}},
+		"GetAllowedMinIalForRegisterIdentityAtFirstIdp":         {GetAllowedMinIalForRegisterIdentityAtFirstIdpResult{}},
+		"GetServicePriceList":                                   {GetServicePriceListResult{}},
+		"GetServicePriceCeiling":                                {GetServicePriceCeilingResult{}},
+		"GetServicePriceMinEffectiveDatetimeDelay":              {GetServicePriceMinEffectiveDatetimeDelayResult{}},
+		"GetMessage":                                            {GetMessageResult{}},
+		"GetMessageDetail":                                      {GetMessageDetailResult{}},
+		"GetRequestTypeList":                                    {[]string{}},
+		"GetSuppressedIdentityModificationNotificationNodeList": {[]string{}},
+		"IsSuppressedIdentityModificationNotificationNode":      {IsSuppressedIdentityModificationNotificationNodeResult{}},
+		"GetAllowedNodeSupportedFeatureList":                    {[]string{}},
+		"GetIdPAssociationTransfer":                             {GetIdPAssociationTransferResult{}},
+		"GetNodeCertificateAuthorityList":                       {[]NodeCertificateAuthorityResult{}},
+		"GetDecommissionedNodeInfo":                             {GetDecommissionedNodeInfoResult{}},
+		"GetStaleNodeList":                                      {GetStaleNodeListResult{}},
+		"GetValidatorList":                                      {GetValidatorListResult{}},
+		"GetValidatorHistory":                                   {GetValidatorHistoryResult{}},
+		"GetValidatorPowerCap":                                  {GetValidatorPowerCapResult{}},
+		"GetAdminPermission":                                    {AdminPermission{}},
+		"GetAdminPermissionList":                                {GetAdminPermissionListResult{}},
+		"GetGovernanceConfig":                                   {GetGovernanceConfigResult{}},
+		"GetProposal":                                           {ProposalInfo{}},
+		"GetProposalList":                                       {GetProposalListResult{}},
+		"GetScheduledChangeList":                                {GetScheduledChangeListResult{}},
+		"GetPausedMethodList":                                   {GetPausedMethodListResult{}},
+		"GetUpgradePlan":                                        {GetUpgradePlanResult{}},
+		"GetParameter":                                          {ParameterInfo{}},
+		"GetParameterList":                                      {[]ParameterInfo{}},
+		"BatchQuery":                                            {BatchQueryResult{}},
+	}
+	for _, method := range getQueryMethodList(t) {
+		assert.Contains(t, protobufQueryResponses, method)
+		assert.Contains(t, testCases, method)
+	}
+	for method, results := range testCases {
+		for _, result := range results {
+			value := reflect.New(reflect.TypeOf(result)).Elem()
+			value.Set(reflect.ValueOf(result))
+			fillTestValue(value)
+			resultJSON, err := json.Marshal(value.Interface())
+			assert.NoError(t, err)
+			_, err = encodeProtobufQueryResponse(method, resultJSON)
+			assert.NoError(t, err, "%s: %s", method, string(resultJSON))
+		}
+	}
+
+	// result with field which is not in response message is rejected
+	_, err := encodeProtobufQueryResponse("GetNodeToken", []byte(`{"amount":1,"unknown":1}`))
+	assert.Error(t, err)
+}
+
+func newTestQuery(t *testing.T, app *ABCIApplication, method string, params string, encoding protoTm.Encoding) *abcitypes.ResponseQuery {
+	queryBytes, err := proto.Marshal(&protoTm.Query{
+		Method:           method,
+		Params:           []byte(params),
+		ResponseEncoding: encoding,
+	})
+	assert.NoError(t, err)
+	res, err := app.Query(&abcitypes.RequestQuery{Data: queryBytes})
+	assert.NoError(t, err)
+	return res
+}
+
+func TestProtobufQueryResponse(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+
+	testCases := []struct {
+		name        string
+		method      string
+		params      string
+		encoding    protoTm.Encoding
+		expectedLog string
+		checkValue  func(t *testing.T, value []byte)
+	}{
+		{
+			name:        "protobuf",
+			method:      "GetParameter",
+			params:      `{"name":"ServicePriceMinEffectiveDatetimeDelay"}`,
+			encoding:    protoTm.Encoding_PROTOBUF,
+			expectedLog: "success",
+			checkValue: func(t *testing.T, value []byte) {
+				var parameterInfo protoQuery.ParameterInfo
+				assert.NoError(t, proto.Unmarshal(value, &parameterInfo))
+				assert.Equal(t, "ServicePriceMinEffectiveDatetimeDelay", parameterInfo.Name)
+				assert.Equal(t, float64(43200), parameterInfo.Value.GetNumberValue())
+				assert.True(t, parameterInfo.IsDefault)
+			},
+		},
+		{
+			// JSON array response is wrapped in items
+			name:        "protobuf list",
+			method:      "GetParameterList",
+			encoding:    protoTm.Encoding_PROTOBUF,
+			expectedLog: "success",
+			checkValue: func(t *testing.T, value []byte) {
+				var parameterList protoQuery.GetParameterListResult
+				assert.NoError(t, proto.Unmarshal(value, &parameterList))
+				assert.Len(t, parameterList.Items, len(parameterDefinitions))
+			},
+		},
+		{
+			// default encoding is JSON
+			name:        "JSON",
+			method:      "GetParameter",
+			params:      `{"name":"ServicePriceMinEffectiveDatetimeDelay"}`,
+			encoding:    protoTm.Encoding_JSON,
+			expectedLog: "success",
+			checkValue: func(t *testing.T, value []byte) {
+				var parameterInfo ParameterInfo
+				assert.NoError(t, json.Unmarshal(value, &parameterInfo))
+				assert.Equal(t, "ServicePriceMinEffectiveDatetimeDelay", parameterInfo.Name)
+			},
+		},
+		{
+			name:        "not found",
+			method:      "GetNodeInfo",
+			params:      `{"node_id":"rp1"}`,
+			encoding:    protoTm.Encoding_PROTOBUF,
+			expectedLog: "not found",
+		},
+		{
+			name:        "unsupported method",
+			method:      "UnknownMethod",
+			encoding:    protoTm.Encoding_PROTOBUF,
+			expectedLog: "protobuf response encoding is not supported for method: UnknownMethod",
+			checkValue: func(t *testing.T, value []byte) {
+				assert.Nil(t, value)
+			},
+		},
+		{
+			name:        "unknown encoding",
+			method:      "GetParameter",
+			params:      `{"name":"ServicePriceMinEffectiveDatetimeDelay"}`,
+			encoding:    protoTm.Encoding(99),
+			expectedLog: "unknown response encoding",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := newTestQuery(t, app, tc.method, tc.params, tc.encoding)
+			assert.Equal(t, tc.expectedLog, res.Log)
+			if tc.checkValue != nil {
+				tc.checkValue(t, res.Value)
+			}
+		})
+	}
+}
+
+func TestProtobufQueryResponseAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+
+	// node detail stored before node roles is encoded with migrated roles
+	res := newTestQuery(t, app, "GetNodeInfo", `{"node_id":"idp1"}`, protoTm.Encoding_PROTOBUF)
+	assert.Equal(t, "success", res.Log)
+	var nodeInfo protoQuery.GetNodeInfoResult
+	assert.NoError(t, proto.Unmarshal(res.Value, &nodeInfo))
+	assert.Equal(t, "IdP 1", nodeInfo.NodeName)
+	assert.Equal(t, "IdP", nodeInfo.Role)
+	assert.Equal(t, []string{"IdP"}, nodeInfo.Roles)
+	assert.Equal(t, float64(3), nodeInfo.GetMaxIal())
+	assert.True(t, nodeInfo.Active)
+
+	res = newTestQuery(t, app, "GetNodeToken", `{"node_id":"rp1"}`, protoTm.Encoding_PROTOBUF)
+	assert.Equal(t, "success", res.Log)
+	var token protoQuery.GetNodeTokenResult
+	assert.NoError(t, proto.Unmarshal(res.Value, &token))
+	assert.Equal(t, float64(100), token.Amount)
+}
@@ -100,15 +100,17 @@ func (app *ABCIApplication) validateCreateRequest(funcParam CreateRequestParam,
 		}
 	}
 
-	if !(appTypes.NodeRole(requesterNodeDetail.Role) == appTypes.NodeRoleRp ||
-		(appTypes.NodeRole(requesterNodeDetail.Role) == appTypes.NodeRoleIdp && !requesterNodeDetail.IsIdpAgent)) {
+	if !(hasNodeRole(&requesterNodeDetail, appTypes.NodeRoleRp) ||
+		(hasNodeRole(&requesterNodeDetail, appTypes.NodeRoleIdp) && !requesterNodeDetail.IsIdpAgent)) {
 		return &ApplicationError{
 			Code:    code.NoPermissionForCallRPandIdPMethod,
 			Message: "This node does not have permission to call RP and IdP method",
 		}
 	}
 
-	if appTypes.NodeRole(requesterNodeDetail.Role) == appTypes.NodeRoleIdp {
+	// node with both RP and IdP roles creates request as RP
+	if hasNodeRole(&requesterNodeDetail, appTypes.NodeRoleIdp) &&
+		!hasNodeRole(&requesterNodeDetail, appTypes.NodeRoleRp) {
 		// IdP must not be able to create request with mode 1 or 2
 		if funcParam.Mode == 1 {
 			return &ApplicationError{
@@ -450,7 +452,7 @@ func (app *ABCIApplication) createRequest(param []byte, callerNodeID string) *ab
 	request.Owner = callerNodeID
 
 	// set purpose e.g. add accessor
-	if appTypes.NodeRole(requesterNodeDetail.Role) == appTypes.NodeRoleIdp {
+	if hasNodeRole(&requesterNodeDetail, appTypes.NodeRoleIdp) {
 		request.Purpose = funcParam.Purpose
 	}
 
@@ -469,6 +471,7 @@ func (app *ABCIApplication) createRequest(param []byte, callerNodeID string) *ab
 	if err != nil {
 		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
 	}
+	app.addOwnedOpenRequestIndex(request.Owner, request.RequestId)
 
 	return app.NewExecTxResult(code.OK, "success", request.RequestId)
 }
@@ -624,6 +627,13 @@ func (app *ABCIApplication) closeRequest(param []byte, callerNodeID string) *abc
 	if err != nil {
 		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
 	}
+	err = app.removeOwnedOpenRequestIndex(request.Owner, request.RequestId)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
 	return app.NewExecTxResult(code.OK, "success", funcParam.RequestID)
 }
 
@@ -772,6 +782,13 @@ func (app *ABCIApplication) timeOutRequest(param []byte, callerNodeID string) *a
 	if err != nil {
 		return app.NewExecTxResult(code.AppStateError, err.Error(), "")
 	}
+	err = app.removeOwnedOpenRequestIndex(request.Owner, request.RequestId)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
 	return app.NewExecTxResult(code.OK, "success", funcParam.RequestID)
 }
 
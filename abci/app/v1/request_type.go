@@ -26,7 +26,6 @@ import (
 	"encoding/json"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
@@ -221,20 +220,16 @@ func (app *ABCIApplication) getRequestTypeList(param []byte, height int64) *abci
 
 	requestTypeKeyIteratorBasePrefix := requestTypeKeyPrefix + keySeparator
 	requestTypeKeyIteratorPrefix := requestTypeKeyIteratorBasePrefix + funcParam.Prefix
-	r := goleveldbutil.BytesPrefix([]byte(requestTypeKeyIteratorPrefix))
-	iter, err := app.state.db.Iterator(r.Start, r.Limit)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-
+	err = app.state.IterateKeyPrefix([]byte(requestTypeKeyIteratorPrefix), nil, true, func(key []byte, value []byte) (bool, error) {
 		runes := []rune(string(key))
 		requestType := string(runes[len(requestTypeKeyIteratorBasePrefix):])
 
 		result = append(result, requestType)
+		return true, nil
+	})
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	iter.Close()
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -0,0 +1,584 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"strconv"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// schedulableMethod lists NDID methods which can be scheduled
+// to take effect at a later block with activation parameters
+var schedulableMethod = map[string]bool{
+	"SetSupportedIALList":                           true,
+	"SetSupportedAALList":                           true,
+	"SetAllowedModeList":                            true,
+	"SetAllowedMinIalForRegisterIdentityAtFirstIdp": true,
+	"SetTimeOutBlockRegisterIdentity":               true,
+	"SetPriceFunc":                                  true,
+	"SetServicePriceCeiling":                        true,
+	"SetServicePriceMinEffectiveDatetimeDelay":      true,
+	"AddNamespace":                                  true,
+	"UpdateNamespace":                               true,
+	"EnableNamespace":                               true,
+	"DisableNamespace":                              true,
+	"AddService":                                    true,
+	"UpdateService":                                 true,
+	"EnableService":                                 true,
+	"DisableService":                                true,
+	"AddErrorCode":                                  true,
+	"RemoveErrorCode":                               true,
+	"AddRequestType":                                true,
+	"RemoveRequestType":                             true,
+	"AddAllowedNodeSupportedFeature":                true,
+	"RemoveAllowedNodeSupportedFeature":             true,
+	"SetValidatorPowerCap":                          true,
+	"SetParameter":                                  true,
+}
+
+// ChangeActivation is optional part of NDID method parameters.
+// When set, the change is queued and applied at the start of the target block.
+type ChangeActivation struct {
+	ActivationHeight    int64 `json:"activation_height"`
+	ActivationBlockTime int64 `json:"activation_block_time"` // unix time in milliseconds
+}
+
+// getChangeActivation returns nil when method parameters do not request scheduling
+func getChangeActivation(method string, param []byte) (*ChangeActivation, error) {
+	if !regulatorMethod[method] {
+		return nil, nil
+	}
+	var activation ChangeActivation
+	err := json.Unmarshal(param, &activation)
+	if err != nil {
+		// let method handle invalid parameters
+		return nil, nil
+	}
+	if activation.ActivationHeight == 0 && activation.ActivationBlockTime == 0 {
+		return nil, nil
+	}
+
+	if !schedulableMethod[method] {
+		return nil, &ApplicationError{
+			Code:    code.MethodCannotBeScheduled,
+			Message: "Method cannot be scheduled: " + method,
+		}
+	}
+	if activation.ActivationHeight < 0 || activation.ActivationBlockTime < 0 ||
+		(activation.ActivationHeight > 0 && activation.ActivationBlockTime > 0) {
+		return nil, &ApplicationError{
+			Code:    code.InvalidChangeActivation,
+			Message: "Either activation height or activation block time must be set",
+		}
+	}
+	return &activation, nil
+}
+
+func (app *ABCIApplication) getScheduledChangeList(committedState bool) (*data.ScheduledChangeList, error) {
+	value, err := app.state.Get(scheduledChangeListKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var changeList data.ScheduledChangeList
+	if value == nil {
+		return &changeList, nil
+	}
+	err = proto.Unmarshal(value, &changeList)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &changeList, nil
+}
+
+func (app *ABCIApplication) setScheduledChangeList(changeList *data.ScheduledChangeList) error {
+	value, err := utils.ProtoDeterministicMarshal(changeList)
+	if err != nil {
+		return &ApplicationError{
+			Code:    code.MarshalError,
+			Message: err.Error(),
+		}
+	}
+	app.state.Set(scheduledChangeListKeyBytes, value)
+	return nil
+}
+
+func isScheduledChangeActivated(change *data.ScheduledChange, height int64, blockTime int64) bool {
+	if change.ActivationHeight > 0 {
+		return height >= change.ActivationHeight
+	}
+	return blockTime >= change.ActivationBlockTime
+}
+
+// validateScheduledChange validates schedulable method call in the same way as DeliverTx
+// (against current state including earlier transactions in the block) when it is scheduled.
+// The call is validated again when the change is applied at activation.
+func (app *ABCIApplication) validateScheduledChange(method string, param []byte, callerNodeID string) error {
+	unmarshalParam := func(funcParam interface{}) error {
+		err := json.Unmarshal(param, funcParam)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.UnmarshalError,
+				Message: err.Error(),
+			}
+		}
+		return nil
+	}
+
+	switch method {
+	case "SetSupportedIALList":
+		var funcParam SetSupportedIALListParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetSupportedIALList(funcParam, callerNodeID, false, false)
+	case "SetSupportedAALList":
+		var funcParam SetSupportedAALListParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetSupportedAALList(funcParam, callerNodeID, false, false)
+	case "SetAllowedModeList":
+		var funcParam SetAllowedModeListParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetAllowedModeList(funcParam, callerNodeID, false, false)
+	case "SetAllowedMinIalForRegisterIdentityAtFirstIdp":
+		var funcParam SetAllowedMinIalForRegisterIdentityAtFirstIdpParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetAllowedMinIalForRegisterIdentityAtFirstIdp(funcParam, callerNodeID, false, false)
+	case "SetTimeOutBlockRegisterIdentity":
+		var funcParam TimeOutBlockRegisterIdentity
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetTimeOutBlockRegisterIdentity(funcParam, callerNodeID, false, false)
+	case "SetPriceFunc":
+		var funcParam SetPriceFuncParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetPriceFunc(funcParam, callerNodeID, false, false)
+	case "SetServicePriceCeiling":
+		var funcParam SetServicePriceCeilingParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetServicePriceCeiling(funcParam, callerNodeID, false, false)
+	case "SetServicePriceMinEffectiveDatetimeDelay":
+		var funcParam SetServicePriceMinEffectiveDatetimeDelayParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetServicePriceMinEffectiveDatetimeDelay(funcParam, callerNodeID, false, false)
+	case "AddNamespace":
+		var funcParam AddNamespaceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateAddNamespace(funcParam, callerNodeID, false, false)
+	case "UpdateNamespace":
+		var funcParam UpdateNamespaceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateUpdateNamespace(funcParam, callerNodeID, false, false)
+	case "EnableNamespace":
+		var funcParam EnableNamespaceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateEnableNamespace(funcParam, callerNodeID, false, false)
+	case "DisableNamespace":
+		var funcParam DisableNamespaceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateDisableNamespace(funcParam, callerNodeID, false, false)
+	case "AddService":
+		var funcParam AddServiceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateAddService(funcParam, callerNodeID, false, false)
+	case "UpdateService":
+		var funcParam UpdateServiceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateUpdateService(funcParam, callerNodeID, false, false)
+	case "EnableService":
+		var funcParam EnableServiceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateEnableService(funcParam, callerNodeID, false, false)
+	case "DisableService":
+		var funcParam DisableServiceParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateDisableService(funcParam, callerNodeID, false, false)
+	case "AddErrorCode":
+		var funcParam AddErrorCodeParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateAddErrorCode(funcParam, callerNodeID, false, false)
+	case "RemoveErrorCode":
+		var funcParam RemoveErrorCodeParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateRemoveErrorCode(funcParam, callerNodeID, false, false)
+	case "AddRequestType":
+		var funcParam AddRequestTypeParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateAddRequestType(funcParam, callerNodeID, false, false)
+	case "RemoveRequestType":
+		var funcParam RemoveRequestTypeParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateRemoveRequestType(funcParam, callerNodeID, false, false)
+	case "AddAllowedNodeSupportedFeature":
+		var funcParam AddAllowedNodeSupportedFeatureParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateAddAllowedNodeSupportedFeature(funcParam, callerNodeID, false, false)
+	case "RemoveAllowedNodeSupportedFeature":
+		var funcParam RemoveAllowedNodeSupportedFeatureParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateRemoveAllowedNodeSupportedFeature(funcParam, callerNodeID, false, false)
+	case "SetValidatorPowerCap":
+		var funcParam SetValidatorPowerCapParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetValidatorPowerCap(funcParam, callerNodeID, false, false)
+	case "SetParameter":
+		var funcParam SetParameterParam
+		if err := unmarshalParam(&funcParam); err != nil {
+			return err
+		}
+		return app.validateSetParameter(funcParam, callerNodeID, false, false)
+	}
+	return &ApplicationError{
+		Code:    code.MethodCannotBeScheduled,
+		Message: "Method cannot be scheduled: " + method,
+	}
+}
+
+// scheduleChange queues NDID method call to be applied at activation height or block time.
+// Permission and parameters are checked when the change is scheduled.
+func (app *ABCIApplication) scheduleChange(method string, param []byte, callerNodeID string, activation *ChangeActivation) *abcitypes.ExecTxResult {
+	app.logger.Infof("Schedule %s, Parameter: %s", method, param)
+
+	if activation.ActivationHeight > 0 && activation.ActivationHeight <= app.state.CurrentBlockHeight {
+		return app.NewExecTxResult(code.InvalidChangeActivation, "Activation height must be greater than current block height", "")
+	}
+	if activation.ActivationBlockTime > 0 && activation.ActivationBlockTime <= app.state.GetBlockTime(false) {
+		return app.NewExecTxResult(code.InvalidChangeActivation, "Activation block time must be later than current block time", "")
+	}
+
+	err := app.validateScheduledChange(method, param, callerNodeID)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	changeList, err := app.getScheduledChangeList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	changeList.LastChangeId++
+	change := data.ScheduledChange{
+		ChangeId:             changeList.LastChangeId,
+		Method:               method,
+		Params:               param,
+		NodeId:               callerNodeID,
+		ActivationHeight:     activation.ActivationHeight,
+		ActivationBlockTime:  activation.ActivationBlockTime,
+		ScheduledBlockHeight: app.state.CurrentBlockHeight,
+	}
+	changeList.Changes = append(changeList.Changes, &change)
+	err = app.setScheduledChangeList(changeList)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	var attributes []abcitypes.EventAttribute
+	var attribute abcitypes.EventAttribute
+	attribute.Key = "change_id"
+	attribute.Value = strconv.FormatInt(change.ChangeId, 10)
+	attributes = append(attributes, attribute)
+	attribute.Key = "method"
+	attribute.Value = method
+	attributes = append(attributes, attribute)
+	return app.NewExecTxResultWithAttributes(code.OK, "success", attributes)
+}
+
+// applyScheduledChanges applies queued changes activated at current block in queue order.
+// Called at the start of FinalizeBlock before transactions are executed.
+func (app *ABCIApplication) applyScheduledChanges() []abcitypes.Event {
+	events := make([]abcitypes.Event, 0)
+
+	changeList, err := app.getScheduledChangeList(false)
+	if err != nil {
+		app.logger.Errorf("applyScheduledChanges: %+v", err)
+		return events
+	}
+
+	blockTime := app.state.GetBlockTime(false)
+	remainingChanges := make([]*data.ScheduledChange, 0, len(changeList.Changes))
+	for _, change := range changeList.Changes {
+		if !isScheduledChangeActivated(change, app.state.CurrentBlockHeight, blockTime) {
+			remainingChanges = append(remainingChanges, change)
+			continue
+		}
+
+		result := app.applyScheduledChange(change)
+
+		attributes := []abcitypes.EventAttribute{
+			{Key: "change_id", Value: strconv.FormatInt(change.ChangeId, 10)},
+			{Key: "method", Value: change.Method},
+			{Key: "result_code", Value: strconv.FormatUint(uint64(result.Code), 10)},
+		}
+		for _, event := range result.Events {
+			attributes = append(attributes, event.Attributes...)
+		}
+		events = append(events, abcitypes.Event{
+			Type:       "did.scheduled_change",
+			Attributes: attributes,
+		})
+	}
+	if len(remainingChanges) == len(changeList.Changes) {
+		return events
+	}
+
+	changeList.Changes = remainingChanges
+	err = app.setScheduledChangeList(changeList)
+	if err != nil {
+		app.logger.Errorf("applyScheduledChanges: %+v", err)
+	}
+
+	return events
+}
+
+func (app *ABCIApplication) applyScheduledChange(change *data.ScheduledChange) (res *abcitypes.ExecTxResult) {
+	// Recover when panic
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Errorf("Recovered in %s, %s", r, identifyPanic())
+			res = app.NewExecTxResult(code.UnknownError, "Unknown error", "")
+		}
+	}()
+
+	app.logger.Infof("Apply scheduled change: %d, Method: %s", change.ChangeId, change.Method)
+	return app.callDeliverTx(change.Method, change.Params, change.NodeId)
+}
+
+type CancelScheduledChangeParam struct {
+	ChangeID int64 `json:"change_id"`
+}
+
+func (app *ABCIApplication) validateCancelScheduledChange(funcParam CancelScheduledChangeParam, callerNodeID string, committedState bool, checktx bool) error {
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	changeList, err := app.getScheduledChangeList(committedState)
+	if err != nil {
+		return err
+	}
+	for _, change := range changeList.Changes {
+		if change.ChangeId != funcParam.ChangeID {
+			continue
+		}
+		// permission
+		if change.NodeId == callerNodeID {
+			return nil
+		}
+		ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return &ApplicationError{
+				Code:    code.NoPermissionForCallNDIDMethod,
+				Message: "This node does not have permission to call NDID method",
+			}
+		}
+		return nil
+	}
+
+	return &ApplicationError{
+		Code:    code.ScheduledChangeNotFound,
+		Message: "Scheduled change not found",
+	}
+}
+
+func (app *ABCIApplication) cancelScheduledChangeCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam CancelScheduledChangeParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateCancelScheduledChange(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// cancelScheduledChange removes change from pending queue.
+// Allowed for master NDID and the node which scheduled the change.
+func (app *ABCIApplication) cancelScheduledChange(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("CancelScheduledChange, Parameter: %s", param)
+	var funcParam CancelScheduledChangeParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateCancelScheduledChange(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	changeList, err := app.getScheduledChangeList(false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+	for i, change := range changeList.Changes {
+		if change.ChangeId == funcParam.ChangeID {
+			changeList.Changes = append(changeList.Changes[:i], changeList.Changes[i+1:]...)
+			break
+		}
+	}
+	err = app.setScheduledChangeList(changeList)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type GetScheduledChangeListParam struct {
+	Method string `json:"method"`
+}
+
+type ScheduledChange struct {
+	ChangeID             int64           `json:"change_id"`
+	Method               string          `json:"method"`
+	Params               json.RawMessage `json:"params"`
+	NodeID               string          `json:"node_id"`
+	ActivationHeight     int64           `json:"activation_height"`
+	ActivationBlockTime  int64           `json:"activation_block_time"`
+	ScheduledBlockHeight int64           `json:"scheduled_block_height"`
+}
+
+type GetScheduledChangeListResult struct {
+	ScheduledChangeList []ScheduledChange `json:"scheduled_change_list"`
+}
+
+func (app *ABCIApplication) getScheduledChangeListQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetScheduledChangeList, Parameter: %s", param)
+	var funcParam GetScheduledChangeListParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	changeList, err := app.getScheduledChangeList(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	result := GetScheduledChangeListResult{
+		ScheduledChangeList: make([]ScheduledChange, 0, len(changeList.Changes)),
+	}
+	for _, change := range changeList.Changes {
+		if funcParam.Method != "" && change.Method != funcParam.Method {
+			continue
+		}
+		result.ScheduledChangeList = append(result.ScheduledChangeList, ScheduledChange{
+			ChangeID:             change.ChangeId,
+			Method:               change.Method,
+			Params:               json.RawMessage(change.Params),
+			NodeID:               change.NodeId,
+			ActivationHeight:     change.ActivationHeight,
+			ActivationBlockTime:  change.ActivationBlockTime,
+			ScheduledBlockHeight: change.ScheduledBlockHeight,
+		})
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
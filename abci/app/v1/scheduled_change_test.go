@@ -0,0 +1,102 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+	protoParam "github.com/ndidplatform/smart-contract/v9/protos/param"
+)
+
+func TestGetChangeActivation(t *testing.T) {
+	activation, err := getChangeActivation("SetSupportedIALList", []byte(`{"supported_ial_list":[1.1,2.3]}`))
+	assert.NoError(t, err)
+	assert.Nil(t, activation)
+
+	activation, err = getChangeActivation("SetSupportedIALList", []byte(`{"supported_ial_list":[1.1,2.3],"activation_height":100}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &ChangeActivation{ActivationHeight: 100}, activation)
+
+	// not an NDID method
+	activation, err = getChangeActivation("CreateRequest", []byte(`{"activation_height":100}`))
+	assert.NoError(t, err)
+	assert.Nil(t, activation)
+
+	_, err = getChangeActivation("SetNodeToken", []byte(`{"activation_height":100}`))
+	assertApplicationErrorCode(t, code.MethodCannotBeScheduled, err)
+
+	_, err = getChangeActivation("SetAllowedModeList", []byte(`{"activation_height":100,"activation_block_time":1700000000000}`))
+	assertApplicationErrorCode(t, code.InvalidChangeActivation, err)
+
+	_, err = getChangeActivation("SetAllowedModeList", []byte(`{"activation_height":-1}`))
+	assertApplicationErrorCode(t, code.InvalidChangeActivation, err)
+}
+
+func TestIsScheduledChangeActivated(t *testing.T) {
+	change := &data.ScheduledChange{ActivationHeight: 100}
+	assert.False(t, isScheduledChangeActivated(change, 99, 0))
+	assert.True(t, isScheduledChangeActivated(change, 100, 0))
+
+	change = &data.ScheduledChange{ActivationBlockTime: 1700000000000}
+	assert.False(t, isScheduledChangeActivated(change, 100, 1699999999999))
+	assert.True(t, isScheduledChangeActivated(change, 100, 1700000000000))
+}
+
+func TestScheduleChange(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	registerTestNode(t, app, "rp1", "RP")
+	addTestNamespace(t, app, "citizen_id")
+	activationHeight := app.state.CurrentBlockHeight + 2
+
+	// namespace added earlier in the same block is visible to validation when scheduling
+	param, err := proto.Marshal(&protoParam.UpdateNamespaceParam{
+		Namespace:        "citizen_id",
+		Description:      "updated",
+		ActivationHeight: activationHeight,
+	})
+	assert.NoError(t, err)
+	method, paramJSON, err := decodeTxParams("UpdateNamespace_pb", param)
+	assert.NoError(t, err)
+	activation, err := getChangeActivation(method, paramJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, &ChangeActivation{ActivationHeight: activationHeight}, activation)
+	res := app.scheduleChange(method, paramJSON, testNDIDNodeID, activation)
+	assert.Equal(t, code.OK, res.Code, res.Log)
+
+	res = app.scheduleChange("AddNamespace", []byte(`{"namespace":"citizen_id","active":true}`), testNDIDNodeID, activation)
+	assert.Equal(t, code.DuplicateNamespace, res.Code)
+
+	res = app.scheduleChange(method, paramJSON, "rp1", activation)
+	assert.Equal(t, code.NoPermissionForCallNDIDMethod, res.Code)
+	commitTestBlock(app)
+
+	changeList, err := app.getScheduledChangeList(true)
+	assert.NoError(t, err)
+	assert.Len(t, changeList.Changes, 1)
+	assert.Equal(t, "UpdateNamespace", changeList.Changes[0].Method)
+}
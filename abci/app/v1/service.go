@@ -71,37 +71,71 @@ func (app *ABCIApplication) getServiceDetail(param []byte) *abcitypes.ResponseQu
 	return app.NewResponseQuery(returnValue, "success", app.state.Height)
 }
 
+type GetServiceListParam struct {
+	PaginationParam
+	// Active filters by service active flag, active services only when not set
+	Active *bool `json:"active"`
+}
+
 func (app *ABCIApplication) getServiceList(param []byte) *abcitypes.ResponseQuery {
 	app.logger.Infof("GetServiceList, Parameter: %s", param)
-	key := "AllService"
-	value, err := app.state.Get([]byte(key), true)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	if value == nil {
-		result := make([]ServiceDetail, 0)
-		value, err := json.Marshal(result)
+	var funcParam GetServiceListParam
+	if len(param) > 0 {
+		err := json.Unmarshal(param, &funcParam)
 		if err != nil {
 			return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 		}
-		return app.NewResponseQuery(value, "not found", app.state.Height)
 	}
+	active := true
+	if funcParam.Active != nil {
+		active = *funcParam.Active
+	}
+
 	result := make([]*data.ServiceDetail, 0)
-	// filter flag==true
-	var services data.ServiceDetailList
-	err = proto.Unmarshal([]byte(value), &services)
+	lastID, hasMore, err := app.iterateCommittedKeyPrefix(
+		serviceKeyPrefix+keySeparator,
+		funcParam.PaginationParam,
+		func(serviceID string, value []byte) (func(), error) {
+			var service data.ServiceDetail
+			err := proto.Unmarshal(value, &service)
+			if err != nil {
+				return nil, err
+			}
+			if service.Active != active {
+				return nil, nil
+			}
+			return func() {
+				result = append(result, &data.ServiceDetail{
+					ServiceId:   service.ServiceId,
+					ServiceName: service.ServiceName,
+					Active:      service.Active,
+				})
+			}, nil
+		},
+	)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	for _, service := range services.Services {
-		if service.Active {
-			result = append(result, service)
+
+	var returnValue []byte
+	if funcParam.isPaginated() {
+		var nextCursor string
+		if hasMore {
+			nextCursor = lastID
 		}
+		returnValue, err = json.Marshal(PaginatedListResult{
+			Items:      result,
+			NextCursor: nextCursor,
+		})
+	} else {
+		returnValue, err = json.Marshal(result)
 	}
-	returnValue, err := json.Marshal(result)
 	if err != nil {
 		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
+	if len(result) == 0 {
+		return app.NewResponseQuery(returnValue, "not found", app.state.Height)
+	}
 	return app.NewResponseQuery(returnValue, "success", app.state.Height)
 }
 
@@ -23,13 +23,17 @@
 package app
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/json"
 	"hash"
+	"sort"
 	"strconv"
+	"strings"
 
 	dbm "github.com/cometbft/cometbft-db"
+	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
@@ -50,16 +54,28 @@ type AppStateMetadata struct {
 	ChainID                string `json:"chain_id"`
 	Height                 int64  `json:"height"`
 	AppHash                []byte `json:"app_hash"`
+	// HistoryStartHeight is height of first block with journaled history
+	HistoryStartHeight int64 `json:"history_start_height"`
+	// HistoryPrunedHeight is lowest height which history is kept for
+	// after history below retain height is pruned
+	HistoryPrunedHeight int64 `json:"history_pruned_height"`
+	// BlockTime is time of block at Height (unix time in milliseconds)
+	BlockTime int64 `json:"block_time"`
 }
 
 type AppState struct {
 	AppStateMetadata
 	db                       dbm.DB
 	CurrentBlockHeight       int64
+	CurrentBlockTime         int64
 	HasHashData              bool
 	HashDigest               hash.Hash
 	uncommittedState         map[string][]byte
 	uncommittedVersionsState map[string][]int64
+	uncommittedHistoryState  map[string][]byte
+	// queryHeight is set on state of historical query view,
+	// committed reads return value at the height
+	queryHeight int64
 }
 
 func NewAppState(db dbm.DB) (appState *AppState, err error) {
@@ -71,10 +87,12 @@ func NewAppState(db dbm.DB) (appState *AppState, err error) {
 		AppStateMetadata:         *appStateMetadata,
 		db:                       db,
 		CurrentBlockHeight:       appStateMetadata.Height,
+		CurrentBlockTime:         appStateMetadata.BlockTime,
 		HasHashData:              false,
 		HashDigest:               sha256.New(),
 		uncommittedState:         make(map[string][]byte),
 		uncommittedVersionsState: make(map[string][]int64),
+		uncommittedHistoryState:  make(map[string][]byte),
 	}
 	return appState, nil
 }
@@ -106,6 +124,8 @@ func loadAppStateMetadata(db dbm.DB) (*AppStateMetadata, error) {
 
 // Set value `nil` equals Delete
 func (appState *AppState) Set(key, value []byte) {
+	appState.recordHistory(key)
+
 	appState.HasHashData = true
 	appState.HashDigest.Write(key)
 	appState.HashDigest.Write(actionSet)
@@ -171,6 +191,9 @@ func (appState *AppState) Get(key []byte, committed bool) (value []byte, err err
 }
 
 func (appState *AppState) get(key []byte) (value []byte, err error) {
+	if appState.queryHeight > 0 {
+		return appState.getHistorical(key, appState.queryHeight)
+	}
 	var existInUncommittedState bool
 	value, existInUncommittedState = appState.uncommittedState[string(key)]
 	if !existInUncommittedState {
@@ -184,6 +207,9 @@ func (appState *AppState) get(key []byte) (value []byte, err error) {
 }
 
 func (appState *AppState) getCommitted(key []byte) (value []byte, err error) {
+	if appState.queryHeight > 0 {
+		return appState.getHistorical(key, appState.queryHeight)
+	}
 	value, err = appState.db.Get(key)
 	if err != nil {
 		return nil, err
@@ -312,6 +338,10 @@ func (appState *AppState) Has(key []byte, committed bool) (bool, error) {
 }
 
 func (appState *AppState) has(key []byte) (bool, error) {
+	if appState.queryHeight > 0 {
+		value, err := appState.getHistorical(key, appState.queryHeight)
+		return value != nil, err
+	}
 	value, existInUncommittedState := appState.uncommittedState[string(key)]
 	if existInUncommittedState {
 		if value != nil {
@@ -324,6 +354,10 @@ func (appState *AppState) has(key []byte) (bool, error) {
 }
 
 func (appState *AppState) hasCommitted(key []byte) (bool, error) {
+	if appState.queryHeight > 0 {
+		value, err := appState.getHistorical(key, appState.queryHeight)
+		return value != nil, err
+	}
 	return appState.db.Has(key)
 }
 
@@ -357,6 +391,73 @@ func (appState *AppState) hasCommittedVersioned(key []byte) (bool, error) {
 	return appState.db.Has(versionsKey)
 }
 
+// IterateKeyPrefix calls fn with keys having prefix and their values in key order
+// starting from start key (from first key with prefix when start is nil).
+// Uncommitted changes are included unless committed is true.
+// Committed keys and values at query height are iterated on historical query view.
+// Iteration stops when fn returns false.
+func (appState *AppState) IterateKeyPrefix(
+	prefix []byte,
+	start []byte,
+	committed bool,
+	fn func(key []byte, value []byte) (bool, error),
+) error {
+	r := goleveldbutil.BytesPrefix(prefix)
+	if start == nil || bytes.Compare(start, r.Start) < 0 {
+		start = r.Start
+	}
+	if committed && appState.queryHeight > 0 {
+		return appState.iterateHistoricalKeyPrefix(prefix, start, fn)
+	}
+	iter, err := appState.db.Iterator(start, r.Limit)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var uncommittedKeys []string
+	if !committed {
+		for key := range appState.uncommittedState {
+			if strings.HasPrefix(key, string(prefix)) && key >= string(start) {
+				uncommittedKeys = append(uncommittedKeys, key)
+			}
+		}
+		sort.Strings(uncommittedKeys)
+	}
+
+	i := 0
+	for {
+		var key, value []byte
+		if i < len(uncommittedKeys) && (!iter.Valid() || uncommittedKeys[i] <= string(iter.Key())) {
+			key = []byte(uncommittedKeys[i])
+			value = appState.uncommittedState[uncommittedKeys[i]]
+			if iter.Valid() && uncommittedKeys[i] == string(iter.Key()) {
+				iter.Next()
+			}
+			i++
+			if value == nil {
+				// deleted in uncommitted state
+				continue
+			}
+		} else if iter.Valid() {
+			key = append([]byte(nil), iter.Key()...)
+			value = append([]byte(nil), iter.Value()...)
+			iter.Next()
+		} else {
+			break
+		}
+
+		next, err := fn(key, value)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+	return iter.Error()
+}
+
 func (appState *AppState) Delete(key []byte) error {
 	hasKey, err := appState.has(key)
 	if err != nil {
@@ -366,6 +467,8 @@ func (appState *AppState) Delete(key []byte) error {
 		return nil
 	}
 
+	appState.recordHistory(key)
+
 	appState.HasHashData = true
 	appState.HashDigest.Write(key)
 	appState.HashDigest.Write(actionDelete)
@@ -388,6 +491,15 @@ func (appState *AppState) DeleteVersioned(key []byte) error {
 	return nil
 }
 
+// GetBlockTime returns block time (unix time in milliseconds) of current block
+// or of last committed block (block at query height for historical queries)
+func (appState *AppState) GetBlockTime(committed bool) int64 {
+	if committed {
+		return appState.BlockTime
+	}
+	return appState.CurrentBlockTime
+}
+
 func (appState *AppState) Save() error {
 	batch := appState.db.NewBatch()
 	defer batch.Close()
@@ -412,6 +524,20 @@ func (appState *AppState) Save() error {
 		batch.Set([]byte(key), value)
 	}
 
+	// history is not included in app hash
+	for key, value := range appState.uncommittedHistoryState {
+		batch.Set([]byte(key), value)
+	}
+	if appState.HistoryStartHeight == 0 && appState.Height > 0 {
+		appState.HistoryStartHeight = appState.Height
+	}
+	// block time is not included in app hash,
+	// it is kept for each height for historical queries
+	if appState.CurrentBlockTime > 0 {
+		appState.BlockTime = appState.CurrentBlockTime
+		batch.Set(blockTimeKey(appState.Height), []byte(strconv.FormatInt(appState.BlockTime, 10)))
+	}
+
 	// save metadata
 	appStateMetadataBytes, err := json.Marshal(appState.AppStateMetadata)
 	if err != nil {
@@ -423,6 +549,7 @@ func (appState *AppState) Save() error {
 
 	appState.uncommittedState = make(map[string][]byte)
 	appState.uncommittedVersionsState = make(map[string][]int64)
+	appState.uncommittedHistoryState = make(map[string][]byte)
 
 	return nil
 }
@@ -0,0 +1,145 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportState writes all committed keys in key order using the same format
+// as initial state data so that the output can be loaded on a new chain.
+// Metadata is written last so that an incomplete export cannot be loaded.
+func (appState *AppState) ExportState(exportDir string, previousChain *ChainHistoryDetail) (keyCount int64, err error) {
+	err = os.MkdirAll(exportDir, 0700)
+	if err != nil {
+		return 0, err
+	}
+
+	dataFile, err := os.Create(filepath.Join(exportDir, initialStateDataFilename))
+	if err != nil {
+		return 0, err
+	}
+	defer dataFile.Close()
+
+	iterator, err := appState.db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iterator.Close()
+
+	writer := bufio.NewWriter(dataFile)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		// app state metadata and last block belong to the chain being exported
+		// and history and block times are local to the node
+		if string(key) == string(appStateMetadataKey) ||
+			string(key) == string(lastBlockKeyBytes) ||
+			strings.HasPrefix(string(key), historyKeyPrefix+keySeparator) ||
+			strings.HasPrefix(string(key), historyIndexKeyPrefix+keySeparator) ||
+			strings.HasPrefix(string(key), blockTimeKeyPrefix+keySeparator) {
+			continue
+		}
+		line, err := json.Marshal(KeyValue{
+			Key:   key,
+			Value: iterator.Value(),
+		})
+		if err != nil {
+			return 0, err
+		}
+		_, err = writer.Write(append(line, '\n'))
+		if err != nil {
+			return 0, err
+		}
+		keyCount++
+	}
+	if err := iterator.Error(); err != nil {
+		return 0, err
+	}
+	err = writer.Flush()
+	if err != nil {
+		return 0, err
+	}
+	err = dataFile.Sync()
+	if err != nil {
+		return 0, err
+	}
+
+	metadataJSON, err := json.Marshal(InitialStateMetadata{
+		TotalKeyCount: keyCount,
+		PreviousChain: previousChain,
+	})
+	if err != nil {
+		return 0, err
+	}
+	err = os.WriteFile(filepath.Join(exportDir, initialStateMetadataFilename), metadataJSON, 0600)
+	if err != nil {
+		return 0, err
+	}
+
+	return keyCount, nil
+}
+
+// exportChainHandoff writes committed state to the configured export directory
+// once the last block set by SetLastBlock is committed. Export is a local side
+// effect of the node and does not affect consensus, so errors are only logged.
+func (app *ABCIApplication) exportChainHandoff() {
+	if app.chainHandoffExportDir == "" {
+		return
+	}
+	value, err := app.state.Get(lastBlockKeyBytes, true)
+	if err != nil {
+		app.logger.Errorf("Chain handoff: cannot read last block: %s", err.Error())
+		return
+	}
+	if len(value) == 0 || string(value) == "-1" {
+		return
+	}
+	lastBlock, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || lastBlock != app.state.Height {
+		return
+	}
+
+	startTime := time.Now()
+	app.logger.Infof("Chain handoff: exporting state at last block %d to directory: %s", lastBlock, app.chainHandoffExportDir)
+
+	previousChain := ChainHistoryDetail{
+		ChainID:           app.state.ChainID,
+		LatestBlockHash:   strings.ToUpper(hex.EncodeToString(app.lastBlockHash)),
+		LatestAppHash:     strings.ToUpper(hex.EncodeToString(app.state.AppHash)),
+		LatestBlockHeight: strconv.FormatInt(lastBlock, 10),
+	}
+	keyCount, err := app.state.ExportState(app.chainHandoffExportDir, &previousChain)
+	if err != nil {
+		app.logger.Errorf("Chain handoff: export error: %s", err.Error())
+		return
+	}
+
+	app.logger.Infof("Chain handoff: exported %d keys, time used: %s", keyCount, time.Since(startTime))
+}
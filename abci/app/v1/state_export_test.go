@@ -0,0 +1,115 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+)
+
+func TestChainHandoffExportAndInitChain(t *testing.T) {
+	exportDir := t.TempDir()
+	logger := logrus.NewEntry(logrus.New())
+
+	oldChain := NewABCIApplication(logger, dbm.NewMemDB(), "", 0, exportDir)
+	oldChain.state.ChainID = "old-chain"
+	oldChain.state.Set([]byte("key1"), []byte("value1"))
+	oldChain.state.Set(lastBlockKeyBytes, []byte("1"))
+	oldChain.state.AppHash = []byte{0xab, 0xcd}
+	oldChain.lastBlockHash = []byte{0x01, 0x02}
+	oldChain.state.CurrentBlockTime = 1700000000000
+	_, err := oldChain.Commit(&abcitypes.RequestCommit{})
+	assert.NoError(t, err)
+
+	metadata, err := ReadInitialStateMetadata(exportDir)
+	assert.NoError(t, err)
+	// last block and block time of old chain are not exported
+	assert.Equal(t, int64(1), metadata.TotalKeyCount)
+	assert.Equal(t, &ChainHistoryDetail{
+		ChainID:           "old-chain",
+		LatestBlockHash:   "0102",
+		LatestAppHash:     "ABCD",
+		LatestBlockHeight: "1",
+	}, metadata.PreviousChain)
+
+	newChain := NewABCIApplication(logger, dbm.NewMemDB(), exportDir, 0, "")
+	_, err = newChain.InitChain(&abcitypes.RequestInitChain{ChainId: "new-chain"})
+	assert.NoError(t, err)
+
+	value, err := newChain.state.Get([]byte("key1"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	// new chain accepts txs after last block of old chain
+	value, err = newChain.state.Get(lastBlockKeyBytes, true)
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+	oldChain.state.CurrentBlockHeight = 2
+	assertApplicationErrorCode(t, code.ChainIsDisabled, oldChain.checkLastBlock(true))
+	newChain.state.CurrentBlockHeight = 2
+	assert.NoError(t, newChain.checkLastBlock(true))
+
+	res := newChain.getChainHistory(nil)
+	var chainHistory ChainHistory
+	assert.NoError(t, json.Unmarshal(res.Value, &chainHistory))
+	assert.Equal(t, []ChainHistoryDetail{*metadata.PreviousChain}, chainHistory.Chains)
+}
+
+func TestChainHandoffExportOnlyAtLastBlock(t *testing.T) {
+	exportDir := t.TempDir()
+	app := NewABCIApplication(logrus.NewEntry(logrus.New()), dbm.NewMemDB(), "", 0, exportDir)
+	app.state.Set(lastBlockKeyBytes, []byte("2"))
+	_, err := app.Commit(&abcitypes.RequestCommit{})
+	assert.NoError(t, err)
+
+	_, err = ReadInitialStateMetadata(exportDir)
+	assert.Error(t, err)
+}
+
+func TestAppendChainHistory(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+	app.state.Set(chainHistoryInfoKeyBytes, []byte(`{"chains":[{"chain_id":"chain-1","latest_block_height":"10"}]}`))
+
+	err = app.appendChainHistory(ChainHistoryDetail{ChainID: "chain-2", LatestBlockHeight: "20"})
+	assert.NoError(t, err)
+
+	value, err := app.state.Get(chainHistoryInfoKeyBytes, false)
+	assert.NoError(t, err)
+	var chainHistory ChainHistory
+	assert.NoError(t, json.Unmarshal(value, &chainHistory))
+	assert.Equal(t, []ChainHistoryDetail{
+		{ChainID: "chain-1", LatestBlockHeight: "10"},
+		{ChainID: "chain-2", LatestBlockHeight: "20"},
+	}, chainHistory.Chains)
+}
@@ -0,0 +1,310 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// History of plain (non-versioned) keys is a journal of value before
+// the first change of the key in each block:
+//
+//	History|<key>|<block height (zero-padded)> = value before changes in the block
+//
+// Value of key at height H is the journaled value of the first change after H,
+// or the current value when the key has not been changed since H.
+// History is local to the node (not included in app hash or state export)
+// and starts from the block the node runs this version (HistoryStartHeight).
+// Keys deleted since height H are found by their history entries.
+//
+// Time of each committed block is kept in the same way for queries
+// depending on block time:
+//
+//	BlockTime|<block height (zero-padded)> = unix time in milliseconds
+//
+// Journal entries are indexed by height so that history below retain height
+// (TENDERMINT_RETAIN_BLOCK_COUNT) is pruned on commit together with blocks:
+//
+//	HistoryIndex|<block height (zero-padded)>|<key> = empty
+
+const historyHeightLength = 20
+
+// historyPruneMaxHeights limits number of heights pruned in one commit so
+// that enabling block retention on a node with long history does not stall
+// a commit. Remaining heights are pruned on following commits.
+const historyPruneMaxHeights = 1000
+
+var (
+	historyValueNotExist = []byte{0}
+	historyValueExist    = []byte{1}
+)
+
+func historyKeyPrefixOf(key []byte) string {
+	return historyKeyPrefix + keySeparator + string(key) + keySeparator
+}
+
+func historyIndexKeyPrefixOf(height int64) string {
+	return historyIndexKeyPrefix + keySeparator + formatHistoryHeight(height) + keySeparator
+}
+
+func formatHistoryHeight(height int64) string {
+	return fmt.Sprintf("%0*d", historyHeightLength, height)
+}
+
+func isHistoryHeight(value []byte) bool {
+	if len(value) != historyHeightLength {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// recordHistory journals committed value of key on first change in current block.
+//
+// Reading the committed value costs one db.Get per key changed in a block
+// (later changes of the key in the block are skipped). This is a deliberate
+// trade-off for historical queries: handlers read a key before changing it,
+// so the value is usually in the database cache, and keeping the journal
+// in the write path avoids a separate scan of changed keys on commit.
+func (appState *AppState) recordHistory(key []byte) {
+	// nonces are never changed after set
+	if bytes.HasPrefix(key, []byte(nonceKeyPrefix+keySeparator)) {
+		return
+	}
+	if appState.uncommittedHistoryState == nil {
+		appState.uncommittedHistoryState = make(map[string][]byte)
+	}
+	historyKey := historyKeyPrefixOf(key) + formatHistoryHeight(appState.CurrentBlockHeight)
+	if _, exist := appState.uncommittedHistoryState[historyKey]; exist {
+		return
+	}
+	value, err := appState.db.Get(key)
+	if err != nil {
+		// history is best-effort, state change must not fail because of it
+		return
+	}
+	if value == nil {
+		appState.uncommittedHistoryState[historyKey] = historyValueNotExist
+	} else {
+		appState.uncommittedHistoryState[historyKey] = append(append([]byte{}, historyValueExist...), value...)
+	}
+	appState.uncommittedHistoryState[historyIndexKeyPrefixOf(appState.CurrentBlockHeight)+string(key)] = []byte{}
+}
+
+// getHistorical returns committed value of key at given height
+func (appState *AppState) getHistorical(key []byte, height int64) (value []byte, err error) {
+	prefix := historyKeyPrefixOf(key)
+	r := goleveldbutil.BytesPrefix([]byte(prefix))
+	iter, err := appState.db.Iterator([]byte(prefix+formatHistoryHeight(height+1)), r.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	// keys of other history entries (key with longer suffix) never have height format
+	if iter.Valid() && isHistoryHeight(iter.Key()[len(prefix):]) {
+		historyValue := iter.Value()
+		if len(historyValue) == 0 || historyValue[0] != historyValueExist[0] {
+			return nil, nil
+		}
+		return historyValue[1:], nil
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return appState.db.Get(key)
+}
+
+// earliestHistoricalHeight returns lowest committed height which values can be read at.
+// Journal of the first block with history has values at the height before it.
+// History below retain height is pruned.
+func (appState *AppState) earliestHistoricalHeight() int64 {
+	if appState.HistoryStartHeight == 0 {
+		// no block with history has been committed
+		return appState.Height
+	}
+	if appState.HistoryPrunedHeight > appState.HistoryStartHeight-1 {
+		return appState.HistoryPrunedHeight
+	}
+	return appState.HistoryStartHeight - 1
+}
+
+// PruneHistory deletes history which is only needed for states below retain
+// height: journal entries of blocks up to retain height and block times below it.
+func (appState *AppState) PruneHistory(retainHeight int64) error {
+	if appState.HistoryStartHeight == 0 || retainHeight <= appState.HistoryPrunedHeight {
+		return nil
+	}
+	// there is no history before the first block with history
+	fromHeight := appState.HistoryPrunedHeight + 1
+	if fromHeight < appState.HistoryStartHeight {
+		fromHeight = appState.HistoryStartHeight
+	}
+	toHeight := retainHeight
+	if toHeight-fromHeight+1 > historyPruneMaxHeights {
+		toHeight = fromHeight + historyPruneMaxHeights - 1
+	}
+
+	batch := appState.db.NewBatch()
+	defer batch.Close()
+	for height := fromHeight; height <= toHeight; height++ {
+		indexPrefix := historyIndexKeyPrefixOf(height)
+		r := goleveldbutil.BytesPrefix([]byte(indexPrefix))
+		iter, err := appState.db.Iterator(r.Start, r.Limit)
+		if err != nil {
+			return err
+		}
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()[len(indexPrefix):]
+			batch.Delete([]byte(historyKeyPrefixOf(key) + formatHistoryHeight(height)))
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Close()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+		batch.Delete(blockTimeKey(height - 1))
+	}
+	appState.HistoryPrunedHeight = toHeight
+
+	appStateMetadataBytes, err := json.Marshal(appState.AppStateMetadata)
+	if err != nil {
+		return err
+	}
+	batch.Set(appStateMetadataKey, appStateMetadataBytes)
+	return batch.WriteSync()
+}
+
+// iterateHistoricalKeyPrefix calls fn with keys having prefix and their values
+// at query height in key order starting from start key.
+// Iteration stops when fn returns false.
+func (appState *AppState) iterateHistoricalKeyPrefix(
+	prefix []byte,
+	start []byte,
+	fn func(key []byte, value []byte) (bool, error),
+) error {
+	// current keys and keys which are deleted but have history
+	keySet := make(map[string]struct{})
+	r := goleveldbutil.BytesPrefix(prefix)
+	iter, err := appState.db.Iterator(start, r.Limit)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		keySet[string(iter.Key())] = struct{}{}
+	}
+	iter.Close()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	historyPrefix := historyKeyPrefix + keySeparator
+	r = goleveldbutil.BytesPrefix([]byte(historyPrefix + string(prefix)))
+	iter, err = appState.db.Iterator(r.Start, r.Limit)
+	if err != nil {
+		return err
+	}
+	for ; iter.Valid(); iter.Next() {
+		historyKey := iter.Key()[len(historyPrefix):]
+		// <key>|<block height>
+		keyLength := len(historyKey) - len(keySeparator) - historyHeightLength
+		if keyLength < len(prefix) || !isHistoryHeight(historyKey[keyLength+len(keySeparator):]) {
+			continue
+		}
+		key := historyKey[:keyLength]
+		if bytes.Compare(key, start) < 0 {
+			continue
+		}
+		keySet[string(key)] = struct{}{}
+	}
+	iter.Close()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, err := appState.getHistorical([]byte(key), appState.queryHeight)
+		if err != nil {
+			return err
+		}
+		// key has no value at the height
+		if value == nil {
+			continue
+		}
+		next, err := fn([]byte(key), value)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+	return nil
+}
+
+func blockTimeKey(height int64) []byte {
+	return []byte(blockTimeKeyPrefix + keySeparator + formatHistoryHeight(height))
+}
+
+// getHistoricalBlockTime returns time of block at given committed height
+// (unix time in milliseconds) or 0 when it is not recorded
+func (appState *AppState) getHistoricalBlockTime(height int64) (int64, error) {
+	value, err := appState.db.Get(blockTimeKey(height))
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+// historicalView returns read-only view of application for queries at given committed height
+func (app *ABCIApplication) historicalView(height int64) *ABCIApplication {
+	view := *app
+	view.state.queryHeight = height
+	view.state.Height = height
+	view.state.CurrentBlockHeight = height
+	blockTime, err := app.state.getHistoricalBlockTime(height)
+	if err != nil {
+		app.logger.Errorf("historicalView: %+v", err)
+	}
+	view.state.BlockTime = blockTime
+	view.state.CurrentBlockTime = blockTime
+	return &view
+}
@@ -0,0 +1,272 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+	protoTm "github.com/ndidplatform/smart-contract/v9/protos/tendermint"
+)
+
+func TestHistoricalView(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+	commitBlock := func(height int64, changes func()) {
+		app.state.CurrentBlockHeight = height
+		changes()
+		app.state.Height = height
+		assert.NoError(t, app.state.Save())
+	}
+	setNode := func(nodeID string, active bool) {
+		value, err := utils.ProtoDeterministicMarshal(&data.NodeDetail{NodeName: nodeID, Role: "IdP", Active: active})
+		assert.NoError(t, err)
+		app.state.Set([]byte(nodeIDKeyPrefix+keySeparator+nodeID), value)
+	}
+	setIdpList := func(nodeIDs ...string) {
+		value, err := utils.ProtoDeterministicMarshal(&data.IdPList{NodeId: nodeIDs})
+		assert.NoError(t, err)
+		app.state.Set(idpListKeyBytes, value)
+	}
+	keyA := []byte("KeyA")
+
+	commitBlock(1, func() {
+		app.state.Set(keyA, []byte("v1"))
+		setNode("idp1", true)
+		setIdpList("idp1")
+	})
+	commitBlock(2, func() {
+		app.state.Set(keyA, []byte("v2"))
+		app.state.Set(keyA, []byte("v2-final"))
+		setNode("idp2", true)
+		setIdpList("idp1", "idp2")
+	})
+	commitBlock(3, func() {
+		assert.NoError(t, app.state.Delete(keyA))
+		setNode("idp1", false)
+	})
+
+	expected := map[int64][]byte{1: []byte("v1"), 2: []byte("v2-final"), 3: nil}
+	for height, expectedValue := range expected {
+		view := app.historicalView(height)
+		value, err := view.state.Get(keyA, true)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedValue, value, "height %d", height)
+	}
+	value, err := app.state.Get(keyA, true)
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	getNodeIDList := func(app *ABCIApplication) []string {
+		res := app.getNodeIDList([]byte(`{"role":"idp"}`))
+		var result GetNodeIDListResult
+		assert.NoError(t, json.Unmarshal(res.Value, &result))
+		return result.NodeIDList
+	}
+	assert.Equal(t, []string{"idp1"}, getNodeIDList(app.historicalView(1)))
+	assert.Equal(t, []string{"idp1", "idp2"}, getNodeIDList(app.historicalView(2)))
+	assert.Equal(t, []string{"idp2"}, getNodeIDList(app))
+}
+
+func TestHistoricalListQueryWithDeletedKeys(t *testing.T) {
+	appState, err := NewAppState(dbm.NewMemDB())
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+	commitBlock := func(height int64, changes func()) {
+		app.state.CurrentBlockHeight = height
+		changes()
+		app.state.Height = height
+		assert.NoError(t, app.state.Save())
+	}
+	errorCodeKey := func(errorCode int32) []byte {
+		return []byte(errorCodeKeyPrefix + keySeparator + "idp" + keySeparator + fmt.Sprintf("%d", errorCode))
+	}
+	setErrorCode := func(errorCode int32) {
+		value, err := utils.ProtoDeterministicMarshal(&data.ErrorCode{ErrorCode: errorCode, Description: "d"})
+		assert.NoError(t, err)
+		app.state.Set(errorCodeKey(errorCode), value)
+	}
+
+	commitBlock(1, func() {
+		setErrorCode(10101)
+		setErrorCode(10102)
+		setErrorCode(10103)
+	})
+	commitBlock(2, func() {
+		assert.NoError(t, app.state.Delete(errorCodeKey(10102)))
+	})
+	commitBlock(3, func() {
+		assert.NoError(t, app.state.Delete(errorCodeKey(10101)))
+		setErrorCode(10104)
+	})
+
+	getErrorCodeList := func(app *ABCIApplication, param string) []int32 {
+		res := app.getErrorCodeList([]byte(param))
+		var page struct {
+			Items      []GetErrorCodeListResult `json:"items"`
+			NextCursor string                   `json:"next_cursor"`
+		}
+		assert.NoError(t, json.Unmarshal(res.Value, &page))
+		errorCodes := make([]int32, 0)
+		for _, item := range page.Items {
+			errorCodes = append(errorCodes, item.ErrorCode)
+		}
+		return errorCodes
+	}
+	param := `{"type":"idp","limit":10}`
+	assert.Equal(t, []int32{10101, 10102, 10103}, getErrorCodeList(app.historicalView(1), param))
+	assert.Equal(t, []int32{10101, 10103}, getErrorCodeList(app.historicalView(2), param))
+	assert.Equal(t, []int32{10103, 10104}, getErrorCodeList(app, param))
+
+	// cursor and limit apply to keys at the height
+	assert.Equal(t, []int32{10102}, getErrorCodeList(app.historicalView(1), `{"type":"idp","limit":1,"cursor":"10101"}`))
+
+	var keys []string
+	err = app.historicalView(1).state.IterateKeyPrefix([]byte(errorCodeKeyPrefix+keySeparator), errorCodeKey(10102), true, func(key []byte, value []byte) (bool, error) {
+		keys = append(keys, string(key))
+		return true, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{string(errorCodeKey(10102)), string(errorCodeKey(10103))}, keys)
+}
+
+func TestQueryHeightBeforeHistoryStart(t *testing.T) {
+	// state committed by version without history
+	db := dbm.NewMemDB()
+	assert.NoError(t, db.Set([]byte("KeyA"), []byte("v5")))
+	assert.NoError(t, db.Set(appStateMetadataKey, []byte(`{"height":5}`)))
+
+	appState, err := NewAppState(db)
+	assert.NoError(t, err)
+	app := &ABCIApplication{
+		logger: logrus.NewEntry(logrus.New()),
+		state:  *appState,
+	}
+	commitBlock := func(height int64, changes func()) {
+		app.state.CurrentBlockHeight = height
+		changes()
+		app.state.Height = height
+		assert.NoError(t, app.state.Save())
+	}
+	commitBlock(6, func() {
+		app.state.Set([]byte("KeyA"), []byte("v6"))
+	})
+	commitBlock(7, func() {
+		app.state.Set([]byte("KeyA"), []byte("v7"))
+	})
+
+	// history start height is persisted
+	appState, err = NewAppState(db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), appState.HistoryStartHeight)
+	assert.Equal(t, int64(5), appState.earliestHistoricalHeight())
+
+	query := func(height int64) *abcitypes.ResponseQuery {
+		queryBytes, err := proto.Marshal(&protoTm.Query{
+			Method: "GetChainHistory",
+			Params: []byte(`{}`),
+		})
+		assert.NoError(t, err)
+		res, err := app.Query(&abcitypes.RequestQuery{Data: queryBytes, Height: height})
+		assert.NoError(t, err)
+		return res
+	}
+	res := query(4)
+	assert.Nil(t, res.Value)
+	assert.Equal(t, "state at height 4 is not available, earliest height is 5", res.Log)
+	assert.Equal(t, int64(7), res.Height)
+
+	for _, height := range []int64{5, 6} {
+		res = query(height)
+		assert.Equal(t, height, res.Height)
+	}
+
+	for height, expectedValue := range map[int64]string{5: "v5", 6: "v6"} {
+		value, err := app.historicalView(height).state.Get([]byte("KeyA"), true)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedValue, string(value))
+	}
+}
+
+func TestPruneHistory(t *testing.T) {
+	db := dbm.NewMemDB()
+	appState, err := NewAppState(db)
+	assert.NoError(t, err)
+	for height := int64(1); height <= 1010; height++ {
+		appState.CurrentBlockHeight = height
+		appState.CurrentBlockTime = 1700000000000 + height
+		appState.Set([]byte("KeyA"), []byte(fmt.Sprintf("v%d", height)))
+		appState.Height = height
+		assert.NoError(t, appState.Save())
+	}
+	countKeys := func(prefix string) int {
+		count := 0
+		iter, err := db.Iterator([]byte(prefix), nil)
+		assert.NoError(t, err)
+		defer iter.Close()
+		for ; iter.Valid() && len(iter.Key()) >= len(prefix) && string(iter.Key()[:len(prefix)]) == prefix; iter.Next() {
+			count++
+		}
+		return count
+	}
+
+	// number of heights pruned in one commit is limited
+	assert.NoError(t, appState.PruneHistory(1005))
+	assert.Equal(t, int64(1000), appState.HistoryPrunedHeight)
+	assert.NoError(t, appState.PruneHistory(1005))
+	assert.Equal(t, int64(1005), appState.HistoryPrunedHeight)
+	assert.Equal(t, int64(1005), appState.earliestHistoricalHeight())
+
+	// entries needed for states from retain height are kept
+	assert.Equal(t, 5, countKeys(historyKeyPrefix+keySeparator))
+	assert.Equal(t, 5, countKeys(historyIndexKeyPrefix+keySeparator))
+	assert.Equal(t, 6, countKeys(blockTimeKeyPrefix+keySeparator))
+	value, err := appState.getHistorical([]byte("KeyA"), 1005)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1005", string(value))
+	blockTime, err := appState.getHistoricalBlockTime(1005)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1700000001005), blockTime)
+
+	// pruned height is persisted
+	appState, err = NewAppState(db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1005), appState.HistoryPrunedHeight)
+	assert.NoError(t, appState.PruneHistory(1000))
+	assert.Equal(t, int64(1005), appState.HistoryPrunedHeight)
+}
@@ -50,21 +50,32 @@ const (
 
 type InitialStateMetadata struct {
 	TotalKeyCount int64 `json:"total_key_count"`
+	// PreviousChain is set when the data is exported by chain handoff
+	PreviousChain *ChainHistoryDetail `json:"previous_chain,omitempty"`
 }
 
-func (appState *AppState) LoadInitialState(logger *logrus.Entry, initialStateDir string) (hash []byte, err error) {
-	startTime := time.Now()
-
+func ReadInitialStateMetadata(initialStateDir string) (*InitialStateMetadata, error) {
 	metadataJSON, err := ioutil.ReadFile(filepath.Join(initialStateDir, initialStateMetadataFilename))
 	if err != nil {
 		return nil, err
 	}
 
-	// read metadata
 	var initialStateMetadata InitialStateMetadata
 	err = json.Unmarshal(metadataJSON, &initialStateMetadata)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	return &initialStateMetadata, nil
+}
+
+func (appState *AppState) LoadInitialState(logger *logrus.Entry, initialStateDir string) (hash []byte, err error) {
+	startTime := time.Now()
+
+	// read metadata
+	initialStateMetadata, err := ReadInitialStateMetadata(initialStateDir)
+	if err != nil {
+		return nil, err
 	}
 
 	logger.Infof(
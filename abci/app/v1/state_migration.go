@@ -0,0 +1,253 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+// migrationProgressLogInterval is number of records between progress logs
+const migrationProgressLogInterval = 1000
+
+// stateMigration rewrites stored records to the shape of schema version.
+// Migration must be deterministic and write through app.state so changes are included in app hash.
+type stateMigration struct {
+	schemaVersion int64
+	description   string
+	migrate       func(app *ABCIApplication) error
+}
+
+// stateMigrations must be ordered by schema version.
+// Append new migration with the next schema version, never modify registered migration.
+var stateMigrations = []stateMigration{
+	{
+		schemaVersion: 1,
+		description:   "Rewrite node details with role list",
+		migrate:       (*ABCIApplication).migrateNodeDetailRoles,
+	},
+	{
+		schemaVersion: 2,
+		description:   "Build per-node reference group and open request indexes",
+		migrate:       (*ABCIApplication).migrateNodeIndexes,
+	},
+	{
+		schemaVersion: 3,
+		description:   "Rewrite legacy validators as validator details",
+		migrate:       (*ABCIApplication).migrateLegacyValidators,
+	},
+}
+
+// latestSchemaVersion is schema version of records written by this app version
+func latestSchemaVersion() int64 {
+	if len(stateMigrations) == 0 {
+		return 0
+	}
+	return stateMigrations[len(stateMigrations)-1].schemaVersion
+}
+
+// getSchemaVersion returns schema version of stored records.
+// State without schema version (created before state migrations) is version 0.
+func (app *ABCIApplication) getSchemaVersion(committedState bool) (int64, error) {
+	value, err := app.state.Get(stateSchemaVersionKeyBytes, committedState)
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return 0, nil
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+func (app *ABCIApplication) setSchemaVersion(schemaVersion int64) {
+	app.state.Set(stateSchemaVersionKeyBytes, []byte(strconv.FormatInt(schemaVersion, 10)))
+}
+
+// runStateMigrations executes pending migrations when schema version of state
+// is below the latest one. Called at the start of FinalizeBlock so migrations run
+// in the first block processed by this app version. Every node must switch to this
+// app version at the same block height (chain handoff or coordinated halt and upgrade)
+// so that the migrated state is the same on every node.
+func (app *ABCIApplication) runStateMigrations() error {
+	schemaVersion, err := app.getSchemaVersion(false)
+	if err != nil {
+		return err
+	}
+	if schemaVersion >= latestSchemaVersion() {
+		return nil
+	}
+
+	for _, migration := range stateMigrations {
+		if migration.schemaVersion <= schemaVersion {
+			continue
+		}
+		app.logger.Infof("State migration to schema version %d started: %s", migration.schemaVersion, migration.description)
+		err := migration.migrate(app)
+		if err != nil {
+			return fmt.Errorf("state migration to schema version %d: %w", migration.schemaVersion, err)
+		}
+		app.setSchemaVersion(migration.schemaVersion)
+		app.logger.Infof("State migration to schema version %d completed", migration.schemaVersion)
+	}
+
+	return nil
+}
+
+// migrateNodeDetailRoles sets role list of nodes registered before multi-role support
+func (app *ABCIApplication) migrateNodeDetailRoles() error {
+	nodeIDList, err := app.getCommittedKeyList(nodeIDKeyPrefix + keySeparator)
+	if err != nil {
+		return err
+	}
+	migratedCount := 0
+	for i, nodeID := range nodeIDList {
+		if i > 0 && i%migrationProgressLogInterval == 0 {
+			app.logger.Infof("Node detail migration progress: %d/%d", i, len(nodeIDList))
+		}
+		nodeDetailKey := nodeIDKeyPrefix + keySeparator + nodeID
+		value, err := app.state.Get([]byte(nodeDetailKey), false)
+		if err != nil {
+			return err
+		}
+		var nodeDetail data.NodeDetail
+		err = proto.Unmarshal(value, &nodeDetail)
+		if err != nil {
+			return err
+		}
+		if len(nodeDetail.Roles) > 0 || nodeDetail.Role == "" {
+			continue
+		}
+		setNodeRoles(&nodeDetail, getNodeRoles(&nodeDetail))
+		value, err = utils.ProtoDeterministicMarshal(&nodeDetail)
+		if err != nil {
+			return err
+		}
+		app.state.Set([]byte(nodeDetailKey), value)
+		migratedCount++
+	}
+	app.logger.Infof("Node detail migration: %d of %d nodes rewritten", migratedCount, len(nodeIDList))
+	return nil
+}
+
+// migrateNodeIndexes builds IdP reference group index and owned open request index
+// from reference groups and requests created before the indexes were introduced
+func (app *ABCIApplication) migrateNodeIndexes() error {
+	refGroupKeyIteratorBasePrefix := refGroupCodeKeyPrefix + keySeparator
+	refGroupCodeList, err := app.getCommittedKeyList(refGroupKeyIteratorBasePrefix)
+	if err != nil {
+		return err
+	}
+	for i, refGroupCode := range refGroupCodeList {
+		if i > 0 && i%migrationProgressLogInterval == 0 {
+			app.logger.Infof("Reference group index migration progress: %d/%d", i, len(refGroupCodeList))
+		}
+		value, err := app.state.Get([]byte(refGroupKeyIteratorBasePrefix+refGroupCode), false)
+		if err != nil {
+			return err
+		}
+		var refGroup data.ReferenceGroup
+		err = proto.Unmarshal(value, &refGroup)
+		if err != nil {
+			return err
+		}
+		for _, idp := range refGroup.Idps {
+			err = app.addIdPRefGroupCodeIndex(idp.NodeId, refGroupCode)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	requestKeyIteratorBasePrefix := requestKeyPrefix + keySeparator
+	requestKeyList, err := app.getCommittedKeyList(requestKeyIteratorBasePrefix)
+	if err != nil {
+		return err
+	}
+	openRequestCount := 0
+	for _, requestKeySuffix := range requestKeyList {
+		// only look at versions key of each request
+		requestID, found := strings.CutSuffix(requestKeySuffix, keySeparator+"versions")
+		if !found {
+			continue
+		}
+		value, err := app.state.GetVersioned([]byte(requestKeyIteratorBasePrefix+requestID), 0, false)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+		var request data.Request
+		err = proto.Unmarshal(value, &request)
+		if err != nil {
+			return err
+		}
+		if request.Closed || request.TimedOut {
+			continue
+		}
+		app.addOwnedOpenRequestIndex(request.Owner, request.RequestId)
+		openRequestCount++
+	}
+	app.logger.Infof("Node index migration: %d reference groups and %d open requests indexed", len(refGroupCodeList), openRequestCount)
+	return nil
+}
+
+// migrateLegacyValidators rewrites validators stored before metadata support
+// (length-delimited ValidatorUpdate) as validator details
+func (app *ABCIApplication) migrateLegacyValidators() error {
+	validatorKeyIteratorBasePrefix := validatorKeyPrefix + keySeparator
+	pubKeyList, err := app.getCommittedKeyList(validatorKeyIteratorBasePrefix)
+	if err != nil {
+		return err
+	}
+	migratedCount := 0
+	for _, pubKeyBase64 := range pubKeyList {
+		key := []byte(validatorKeyIteratorBasePrefix + pubKeyBase64)
+		value, err := app.state.Get(key, false)
+		if err != nil {
+			return err
+		}
+		var validator data.ValidatorDetail
+		err = proto.Unmarshal(value, &validator)
+		if err == nil && validator.PublicKey == pubKeyBase64 {
+			continue
+		}
+		legacyValidator, err := decodeLegacyValidatorDetail(pubKeyBase64, value)
+		if err != nil {
+			return err
+		}
+		value, err = utils.ProtoDeterministicMarshal(legacyValidator)
+		if err != nil {
+			return err
+		}
+		app.state.Set(key, value)
+		migratedCount++
+	}
+	app.logger.Infof("Validator migration: %d of %d validators rewritten", migratedCount, len(pubKeyList))
+	return nil
+}
@@ -0,0 +1,204 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func TestStateMigrationsOrdered(t *testing.T) {
+	var schemaVersion int64
+	for _, migration := range stateMigrations {
+		assert.Equal(t, schemaVersion+1, migration.schemaVersion)
+		schemaVersion = migration.schemaVersion
+	}
+	assert.Equal(t, schemaVersion, latestSchemaVersion())
+}
+
+// newTestBaselineStateDB returns DB with records in the format written by
+// app version before state migrations (schema version 0) committed at height 5
+func newTestBaselineStateDB(t *testing.T, legacyValidatorPubKeyBase64 string) dbm.DB {
+	db := dbm.NewMemDB()
+	appState, err := NewAppState(db)
+	assert.NoError(t, err)
+	set := func(key string, message proto.Message) {
+		value, err := utils.ProtoDeterministicMarshal(message)
+		assert.NoError(t, err)
+		appState.Set([]byte(key), value)
+	}
+
+	// single role node details
+	withNodeKeys := func(nodeID string, node *data.NodeDetail) *data.NodeDetail {
+		newNodeKey := func(publicKey string) *data.NodeKey {
+			return &data.NodeKey{PublicKey: publicKey, Algorithm: "RSASSA_PKCS1_V1_5_SHA_256", Version: 1, Active: true}
+		}
+		node.SigningPublicKey = newNodeKey(nodeID + "_signing")
+		node.SigningMasterPublicKey = newNodeKey(nodeID + "_signing_master")
+		node.EncryptionPublicKey = newNodeKey(nodeID + "_encryption")
+		return node
+	}
+	set(nodeIDKeyPrefix+keySeparator+"idp1", withNodeKeys("idp1", &data.NodeDetail{NodeName: "IdP 1", Role: "IdP", MaxIal: 3, MaxAal: 3, Active: true}))
+	set(nodeIDKeyPrefix+keySeparator+"rp1", withNodeKeys("rp1", &data.NodeDetail{NodeName: "RP 1", Role: "RP", Active: true}))
+	set(string(idpListKeyBytes), &data.IdPList{NodeId: []string{"idp1"}})
+	set("rpList", &data.RPList{NodeId: []string{"rp1"}})
+	set("allList", &data.AllList{NodeId: []string{"idp1", "rp1"}})
+	set(tokenKeyPrefix+keySeparator+"idp1", &data.Token{Amount: 100})
+	set(tokenKeyPrefix+keySeparator+"rp1", &data.Token{Amount: 100})
+
+	// reference group and requests without per-node indexes
+	set(refGroupCodeKeyPrefix+keySeparator+"ref1", &data.ReferenceGroup{
+		Idps: []*data.IdPInRefGroup{{NodeId: "idp1", Active: true}},
+	})
+	for _, request := range []*data.Request{
+		{RequestId: "request1", Owner: "rp1"},
+		{RequestId: "request2", Owner: "rp1", Closed: true},
+		{RequestId: "request3", Owner: "rp1", TimedOut: true},
+	} {
+		value, err := utils.ProtoDeterministicMarshal(request)
+		assert.NoError(t, err)
+		assert.NoError(t, appState.SetVersioned([]byte(requestKeyPrefix+keySeparator+request.RequestId), value))
+	}
+
+	// validator stored as ABCI validator update
+	legacyPubKey, err := base64.StdEncoding.DecodeString(legacyValidatorPubKeyBase64)
+	assert.NoError(t, err)
+	legacyValue := bytes.NewBuffer(make([]byte, 0))
+	validatorUpdate := abcitypes.UpdateValidator(legacyPubKey, 10, ed25519.KeyType)
+	assert.NoError(t, abcitypes.WriteMessage(&validatorUpdate, legacyValue))
+	appState.Set([]byte(validatorKeyPrefix+keySeparator+legacyValidatorPubKeyBase64), legacyValue.Bytes())
+
+	appState.Height = 5
+	assert.NoError(t, appState.Save())
+	return db
+}
+
+// newTestAppFromBaselineState returns app restarted with this app version on
+// baseline state with state migrations and NDID initialization committed
+func newTestAppFromBaselineState(t *testing.T) *ABCIApplication {
+	db := newTestBaselineStateDB(t, newTestValidatorPublicKey(t))
+	app := NewABCIApplication(logrus.NewEntry(logrus.New()), db, "", 0, "")
+	_, err := app.FinalizeBlock(&abcitypes.RequestFinalizeBlock{Height: 6, Time: time.Unix(1000, 0)})
+	assert.NoError(t, err)
+	initTestNDID(t, app)
+	_, err = app.Commit(&abcitypes.RequestCommit{})
+	assert.NoError(t, err)
+	return app
+}
+
+func TestStateMigrationsFromBaselineState(t *testing.T) {
+	legacyPubKeyBase64 := newTestValidatorPublicKey(t)
+	db := newTestBaselineStateDB(t, legacyPubKeyBase64)
+
+	// restart with this app version
+	app := NewABCIApplication(logrus.NewEntry(logrus.New()), db, "", 0, "")
+	schemaVersion, err := app.getSchemaVersion(true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), schemaVersion)
+
+	// pending migrations run in the first block, not only at app version activation height
+	_, err = app.FinalizeBlock(&abcitypes.RequestFinalizeBlock{Height: 6, Time: time.Unix(1000, 0)})
+	assert.NoError(t, err)
+	_, err = app.Commit(&abcitypes.RequestCommit{})
+	assert.NoError(t, err)
+
+	schemaVersion, err = app.getSchemaVersion(true)
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), schemaVersion)
+	info, err := app.Info(&abcitypes.RequestInfo{})
+	assert.NoError(t, err)
+	var infoData InfoData
+	assert.NoError(t, json.Unmarshal([]byte(info.Data), &infoData))
+	assert.Equal(t, latestSchemaVersion(), infoData.SchemaVersion)
+
+	// schema version 1: node roles
+	nodeDetail, err := app.getNodeDetail("idp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"IdP"}, nodeDetail.Roles)
+	assert.Equal(t, "IdP 1", nodeDetail.NodeName)
+
+	// schema version 2: per-node indexes
+	refGroupCodeList, err := app.getNodeIndexValueList(idpRefGroupCodeKeyPrefix, "idp1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ref1"}, refGroupCodeList)
+	requestIDList, err := app.getNodeIndexValueList(ownedOpenRequestKeyPrefix, "rp1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"request1"}, requestIDList)
+
+	// schema version 3: validator details
+	validator, err := app.getValidatorDetail(legacyPubKeyBase64, true)
+	assert.NoError(t, err)
+	assert.Equal(t, legacyPubKeyBase64, validator.PublicKey)
+	assert.Equal(t, int64(10), validator.Power)
+	assert.Len(t, validator.PowerHistory, 1)
+
+	// migrated state does not change in later blocks
+	appHash := app.state.AppHash
+	res, err := app.FinalizeBlock(&abcitypes.RequestFinalizeBlock{Height: 7, Time: time.Unix(1001, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, appHash, res.AppHash)
+}
+
+func TestDecommissionNodeAfterStateMigrations(t *testing.T) {
+	db := newTestBaselineStateDB(t, newTestValidatorPublicKey(t))
+	app := NewABCIApplication(logrus.NewEntry(logrus.New()), db, "", 0, "")
+
+	// decommission in the same block as migrations uses indexes built from baseline records
+	_, err := app.FinalizeBlock(&abcitypes.RequestFinalizeBlock{Height: 6, Time: time.Unix(1000, 0)})
+	assert.NoError(t, err)
+	initTestNDID(t, app)
+	deliverTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: "idp1"}, testNDIDNodeID)
+	deliverTestTx(t, app, "DecommissionNode", DecommissionNodeParam{NodeID: "rp1"}, testNDIDNodeID)
+	_, err = app.Commit(&abcitypes.RequestCommit{})
+	assert.NoError(t, err)
+
+	assert.False(t, getTestReferenceGroup(t, app, "ref1").Idps[0].Active)
+	assert.True(t, getTestRequest(t, app, "request1").Closed)
+	decommissionedNode, err := app.getDecommissionedNode("idp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), decommissionedNode.DisabledIdpAssociationCount)
+	decommissionedNode, err = app.getDecommissionedNode("rp1", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), decommissionedNode.ClosedRequestCount)
+}
+
+func TestStateMigrationsSkippedOnNewChain(t *testing.T) {
+	app := NewABCIApplication(logrus.NewEntry(logrus.New()), dbm.NewMemDB(), "", 0, "")
+	_, err := app.InitChain(&abcitypes.RequestInitChain{ChainId: "test-chain"})
+	assert.NoError(t, err)
+	schemaVersion, err := app.getSchemaVersion(true)
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), schemaVersion)
+}
@@ -783,3 +783,46 @@ func TestDeleteVersionedAndSave1(t *testing.T) {
 	}
 	assert.Nil(t, value)
 }
+
+func TestIterateKeyPrefix1(t *testing.T) {
+	var err error
+
+	appState, err := NewAppState(testDb)
+	if err != nil {
+		t.Fatalf("error new app state: %+v", err)
+	}
+
+	appState.Set([]byte("testiterate|a"), []byte("a1"))
+	appState.Set([]byte("testiterate|b"), []byte("b1"))
+	appState.Set([]byte("testiterate|c"), []byte("c1"))
+	appState.Set([]byte("testiteratex"), []byte("x1"))
+	err = appState.Save()
+	if err != nil {
+		t.Fatalf("error save: %+v", err)
+	}
+
+	appState.Set([]byte("testiterate|b"), []byte("b2"))
+	appState.Set([]byte("testiterate|bb"), []byte("bb2"))
+	err = appState.Delete([]byte("testiterate|c"))
+	if err != nil {
+		t.Fatalf("error delete: %+v", err)
+	}
+
+	iterate := func(start []byte, committed bool, max int) []string {
+		var result []string
+		err := appState.IterateKeyPrefix([]byte("testiterate|"), start, committed, func(key []byte, value []byte) (bool, error) {
+			result = append(result, string(key)+"="+string(value))
+			return max == 0 || len(result) < max, nil
+		})
+		if err != nil {
+			t.Fatalf("error iterate: %+v", err)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"testiterate|a=a1", "testiterate|b=b2", "testiterate|bb=bb2"}, iterate(nil, false, 0))
+	assert.Equal(t, []string{"testiterate|a=a1", "testiterate|b=b1", "testiterate|c=c1"}, iterate(nil, true, 0))
+	assert.Equal(t, []string{"testiterate|b=b2", "testiterate|bb=bb2"}, iterate([]byte("testiterate|b"), false, 0))
+	assert.Equal(t, []string{"testiterate|bb=bb2"}, iterate([]byte("testiterate|b\x00"), false, 0))
+	assert.Equal(t, []string{"testiterate|a=a1", "testiterate|b=b2"}, iterate(nil, false, 2))
+}
@@ -26,7 +26,6 @@ import (
 	"encoding/json"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	goleveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 	"google.golang.org/protobuf/proto"
 
 	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
@@ -41,16 +40,10 @@ type AddSuppressedIdentityModificationNotificationNodeParam struct {
 
 func (app *ABCIApplication) validateAddSuppressedIdentityModificationNotificationNode(funcParam AddSuppressedIdentityModificationNotificationNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -75,7 +68,7 @@ func (app *ABCIApplication) validateAddSuppressedIdentityModificationNotificatio
 			Message: err.Error(),
 		}
 	}
-	if appTypes.NodeRole(node.Role) != appTypes.NodeRoleIdp {
+	if !hasNodeRole(&node, appTypes.NodeRoleIdp) {
 		return &ApplicationError{
 			Code:    code.NotIdPNode,
 			Message: "not an IdP node",
@@ -154,16 +147,10 @@ type RemoveSuppressedIdentityModificationNotificationNodeParam struct {
 
 func (app *ABCIApplication) validateRemoveSuppressedIdentityModificationNotificationNode(funcParam RemoveSuppressedIdentityModificationNotificationNodeParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeNodes, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	if checktx {
 		return nil
@@ -247,20 +234,16 @@ func (app *ABCIApplication) getSuppressedIdentityModificationNotificationNodeLis
 
 	keyIteratorBasePrefix := suppressedIdentityModificationNotificationNodePrefix + keySeparator
 	keyIteratorPrefix := keyIteratorBasePrefix + funcParam.Prefix
-	r := goleveldbutil.BytesPrefix([]byte(keyIteratorPrefix))
-	iter, err := app.state.db.Iterator(r.Start, r.Limit)
-	if err != nil {
-		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
-	}
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-
+	err = app.state.IterateKeyPrefix([]byte(keyIteratorPrefix), nil, true, func(key []byte, value []byte) (bool, error) {
 		runes := []rune(string(key))
 		requestType := string(runes[len(keyIteratorBasePrefix):])
 
 		result = append(result, requestType)
+		return true, nil
+	})
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
 	}
-	iter.Close()
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
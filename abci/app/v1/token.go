@@ -29,11 +29,18 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"google.golang.org/protobuf/proto"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
 	"github.com/ndidplatform/smart-contract/v9/abci/utils"
 	data "github.com/ndidplatform/smart-contract/v9/protos/data"
 )
 
+// defaultTokenPriceByFunc is price of functions which are priced differently
+// from other functions when NDID has not set the price
+var defaultTokenPriceByFunc = map[string]float64{
+	"Heartbeat": 0.01,
+}
+
 func (app *ABCIApplication) getTokenPriceByFunc(fnName string, committedState bool) float64 {
 	key := tokenPriceFuncKeyPrefix + keySeparator + fnName
 	value, err := app.state.Get([]byte(key), committedState)
@@ -41,6 +48,9 @@ func (app *ABCIApplication) getTokenPriceByFunc(fnName string, committedState bo
 		panic(err)
 	}
 	if value == nil {
+		if price, ok := defaultTokenPriceByFunc[fnName]; ok {
+			return price
+		}
 		// if not set price of Function --> return price=1
 		return 1.0
 	}
@@ -124,16 +134,10 @@ type SetPriceFuncParam struct {
 
 func (app *ABCIApplication) validateSetPriceFunc(funcParam SetPriceFuncParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeTokens, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	return nil
 }
@@ -335,16 +339,10 @@ type SetNodeTokenParam struct {
 
 func (app *ABCIApplication) validateSetNodeToken(funcParam SetNodeTokenParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeTokens, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	// stateless
 
@@ -433,16 +431,10 @@ type AddNodeTokenParam struct {
 
 func (app *ABCIApplication) validateAddNodeToken(funcParam AddNodeTokenParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeTokens, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	// stateless
 
@@ -534,16 +526,10 @@ type ReduceNodeTokenParam struct {
 
 func (app *ABCIApplication) validateReduceNodeToken(funcParam ReduceNodeTokenParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeTokens, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	// stateless
 
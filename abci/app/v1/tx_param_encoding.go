@@ -0,0 +1,232 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	protoParam "github.com/ndidplatform/smart-contract/v9/protos/param"
+)
+
+// protobufTxMethodSuffix is suffix of method name for sending params encoded
+// with protobuf param message instead of JSON (e.g. RegisterNode_pb)
+const protobufTxMethodSuffix = "_pb"
+
+// protobufTxParams maps transaction method to its protobuf param message
+// defined in protos/param
+var protobufTxParams = map[string]func() proto.Message{
+	"InitNDID":                          func() proto.Message { return &protoParam.InitNDIDParam{} },
+	"EndInit":                           func() proto.Message { return &protoParam.EndInitParam{} },
+	"SetLastBlock":                      func() proto.Message { return &protoParam.SetLastBlockParam{} },
+	"SetValidator":                      func() proto.Message { return &protoParam.SetValidatorParam{} },
+	"SetPriceFunc":                      func() proto.Message { return &protoParam.SetPriceFuncParam{} },
+	"RegisterNode":                      func() proto.Message { return &protoParam.RegisterNodeParam{} },
+	"UpdateNodeByNDID":                  func() proto.Message { return &protoParam.UpdateNodeByNDIDParam{} },
+	"DisableNode":                       func() proto.Message { return &protoParam.DisableNodeParam{} },
+	"EnableNode":                        func() proto.Message { return &protoParam.EnableNodeParam{} },
+	"AddNodeToProxyNode":                func() proto.Message { return &protoParam.AddNodeToProxyNodeParam{} },
+	"UpdateNodeProxyNode":               func() proto.Message { return &protoParam.UpdateNodeProxyNodeParam{} },
+	"RemoveNodeFromProxyNode":           func() proto.Message { return &protoParam.RemoveNodeFromProxyNodeParam{} },
+	"AddNodeToken":                      func() proto.Message { return &protoParam.AddNodeTokenParam{} },
+	"ReduceNodeToken":                   func() proto.Message { return &protoParam.ReduceNodeTokenParam{} },
+	"SetNodeToken":                      func() proto.Message { return &protoParam.SetNodeTokenParam{} },
+	"AddAllowedNodeSupportedFeature":    func() proto.Message { return &protoParam.AddAllowedNodeSupportedFeatureParam{} },
+	"RemoveAllowedNodeSupportedFeature": func() proto.Message { return &protoParam.RemoveAllowedNodeSupportedFeatureParam{} },
+	"AddNamespace":                      func() proto.Message { return &protoParam.AddNamespaceParam{} },
+	"DisableNamespace":                  func() proto.Message { return &protoParam.DisableNamespaceParam{} },
+	"EnableNamespace":                   func() proto.Message { return &protoParam.EnableNamespaceParam{} },
+	"UpdateNamespace":                   func() proto.Message { return &protoParam.UpdateNamespaceParam{} },
+	"SetAllowedMinIalForRegisterIdentityAtFirstIdp":        func() proto.Message { return &protoParam.SetAllowedMinIalForRegisterIdentityAtFirstIdpParam{} },
+	"SetTimeOutBlockRegisterIdentity":                      func() proto.Message { return &protoParam.SetTimeOutBlockRegisterIdentityParam{} },
+	"AddSuppressedIdentityModificationNotificationNode":    func() proto.Message { return &protoParam.AddSuppressedIdentityModificationNotificationNodeParam{} },
+	"RemoveSuppressedIdentityModificationNotificationNode": func() proto.Message { return &protoParam.RemoveSuppressedIdentityModificationNotificationNodeParam{} },
+	"AddService":                               func() proto.Message { return &protoParam.AddServiceParam{} },
+	"DisableService":                           func() proto.Message { return &protoParam.DisableServiceParam{} },
+	"EnableService":                            func() proto.Message { return &protoParam.EnableServiceParam{} },
+	"UpdateService":                            func() proto.Message { return &protoParam.UpdateServiceParam{} },
+	"RegisterServiceDestinationByNDID":         func() proto.Message { return &protoParam.RegisterServiceDestinationByNDIDParam{} },
+	"DisableServiceDestinationByNDID":          func() proto.Message { return &protoParam.DisableServiceDestinationByNDIDParam{} },
+	"EnableServiceDestinationByNDID":           func() proto.Message { return &protoParam.EnableServiceDestinationByNDIDParam{} },
+	"SetServicePriceCeiling":                   func() proto.Message { return &protoParam.SetServicePriceCeilingParam{} },
+	"SetServicePriceMinEffectiveDatetimeDelay": func() proto.Message { return &protoParam.SetServicePriceMinEffectiveDatetimeDelayParam{} },
+	"SetSupportedIALList":                      func() proto.Message { return &protoParam.SetSupportedIALListParam{} },
+	"SetSupportedAALList":                      func() proto.Message { return &protoParam.SetSupportedAALListParam{} },
+	"SetAllowedModeList":                       func() proto.Message { return &protoParam.SetAllowedModeListParam{} },
+	"AddRequestType":                           func() proto.Message { return &protoParam.AddRequestTypeParam{} },
+	"RemoveRequestType":                        func() proto.Message { return &protoParam.RemoveRequestTypeParam{} },
+	"AddErrorCode":                             func() proto.Message { return &protoParam.AddErrorCodeParam{} },
+	"RemoveErrorCode":                          func() proto.Message { return &protoParam.RemoveErrorCodeParam{} },
+	"UpdateNode":                               func() proto.Message { return &protoParam.UpdateNodeParam{} },
+	"SetMqAddresses":                           func() proto.Message { return &protoParam.SetMqAddressesParam{} },
+	"RegisterIdentity":                         func() proto.Message { return &protoParam.RegisterIdentityParam{} },
+	"UpdateIdentity":                           func() proto.Message { return &protoParam.UpdateIdentityParam{} },
+	"AddIdentity":                              func() proto.Message { return &protoParam.AddIdentityParam{} },
+	"RevokeIdentityAssociation":                func() proto.Message { return &protoParam.RevokeIdentityAssociationParam{} },
+	"AddAccessor":                              func() proto.Message { return &protoParam.AddAccessorParam{} },
+	"RevokeAccessor":                           func() proto.Message { return &protoParam.RevokeAccessorParam{} },
+	"RevokeAndAddAccessor":                     func() proto.Message { return &protoParam.RevokeAndAddAccessorParam{} },
+	"RenewAccessor":                            func() proto.Message { return &protoParam.RenewAccessorParam{} },
+	"BulkRegisterIdentityByNDID":               func() proto.Message { return &protoParam.BulkRegisterIdentityByNDIDParam{} },
+	"FreezeReferenceGroup":                     func() proto.Message { return &protoParam.FreezeReferenceGroupParam{} },
+	"UnfreezeReferenceGroup":                   func() proto.Message { return &protoParam.UnfreezeReferenceGroupParam{} },
+	"TransferIdPAssociations":                  func() proto.Message { return &protoParam.TransferIdPAssociationsParam{} },
+	"AcceptIdPAssociationTransfer":             func() proto.Message { return &protoParam.AcceptIdPAssociationTransferParam{} },
+	"ProcessIdPAssociationTransferBatch":       func() proto.Message { return &protoParam.ProcessIdPAssociationTransferBatchParam{} },
+	"CancelIdPAssociationTransfer":             func() proto.Message { return &protoParam.CancelIdPAssociationTransferParam{} },
+	"AddNodeCertificateAuthority":              func() proto.Message { return &protoParam.AddNodeCertificateAuthorityParam{} },
+	"RemoveNodeCertificateAuthority":           func() proto.Message { return &protoParam.RemoveNodeCertificateAuthorityParam{} },
+	"DecommissionNode":                         func() proto.Message { return &protoParam.DecommissionNodeParam{} },
+	"Heartbeat":                                func() proto.Message { return &protoParam.HeartbeatParam{} },
+	"SetValidatorPowerCap":                     func() proto.Message { return &protoParam.SetValidatorPowerCapParam{} },
+	"GrantAdminPermission":                     func() proto.Message { return &protoParam.GrantAdminPermissionParam{} },
+	"RevokeAdminPermission":                    func() proto.Message { return &protoParam.RevokeAdminPermissionParam{} },
+	"SetGovernanceConfig":                      func() proto.Message { return &protoParam.SetGovernanceConfigParam{} },
+	"CreateProposal":                           func() proto.Message { return &protoParam.CreateProposalParam{} },
+	"ApproveProposal":                          func() proto.Message { return &protoParam.ApproveProposalParam{} },
+	"CancelScheduledChange":                    func() proto.Message { return &protoParam.CancelScheduledChangeParam{} },
+	"PauseMethod":                              func() proto.Message { return &protoParam.PauseMethodParam{} },
+	"ResumeMethod":                             func() proto.Message { return &protoParam.ResumeMethodParam{} },
+	"SetUpgradePlan":                           func() proto.Message { return &protoParam.SetUpgradePlanParam{} },
+	"SetParameter":                             func() proto.Message { return &protoParam.SetParameterParam{} },
+	"UpdateIdentityModeList":                   func() proto.Message { return &protoParam.UpdateIdentityModeListParam{} },
+	"RegisterServiceDestination":               func() proto.Message { return &protoParam.RegisterServiceDestinationParam{} },
+	"UpdateServiceDestination":                 func() proto.Message { return &protoParam.UpdateServiceDestinationParam{} },
+	"DisableServiceDestination":                func() proto.Message { return &protoParam.DisableServiceDestinationParam{} },
+	"EnableServiceDestination":                 func() proto.Message { return &protoParam.EnableServiceDestinationParam{} },
+	"SetServicePrice":                          func() proto.Message { return &protoParam.SetServicePriceParam{} },
+	"CreateRequest":                            func() proto.Message { return &protoParam.CreateRequestParam{} },
+	"CreateIdpResponse":                        func() proto.Message { return &protoParam.CreateIdpResponseParam{} },
+	"CreateAsResponse":                         func() proto.Message { return &protoParam.CreateAsResponseParam{} },
+	"SetDataReceived":                          func() proto.Message { return &protoParam.SetDataReceivedParam{} },
+	"CloseRequest":                             func() proto.Message { return &protoParam.CloseRequestParam{} },
+	"TimeOutRequest":                           func() proto.Message { return &protoParam.TimeOutRequestParam{} },
+	"CreateMessage":                            func() proto.Message { return &protoParam.CreateMessageParam{} },
+}
+
+// decodeTxParams converts protobuf encoded params of method with "_pb" suffix
+// to JSON params of the method without suffix. Params of other methods are
+// returned as is.
+func decodeTxParams(method string, param []byte) (string, []byte, error) {
+	if IsMethod[method] || !strings.HasSuffix(method, protobufTxMethodSuffix) {
+		return method, param, nil
+	}
+	jsonMethod := strings.TrimSuffix(method, protobufTxMethodSuffix)
+	newParam, ok := protobufTxParams[jsonMethod]
+	if !ok {
+		return method, param, nil
+	}
+
+	funcParam := newParam()
+	err := proto.Unmarshal(param, funcParam)
+	if err != nil {
+		return method, param, err
+	}
+	err = checkUnknownFields(funcParam.ProtoReflect())
+	if err != nil {
+		return method, param, err
+	}
+
+	paramJSON, err := json.Marshal(protoMessageToJSONValue(funcParam.ProtoReflect()))
+	if err != nil {
+		return method, param, err
+	}
+	return jsonMethod, paramJSON, nil
+}
+
+// checkUnknownFields returns error when message or its sub-messages have
+// fields not defined in param message
+func checkUnknownFields(message protoreflect.Message) error {
+	if len(message.GetUnknown()) > 0 {
+		return fmt.Errorf("unknown field in %s", message.Descriptor().Name())
+	}
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Message() == nil || field.IsMap() {
+			return true
+		}
+		if field.IsList() {
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = checkUnknownFields(list.Get(i).Message())
+			}
+		} else {
+			err = checkUnknownFields(value.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+// protoMessageToJSONValue converts message to value to be marshaled as JSON
+// params. Unlike protojson, 64-bit integers are kept as JSON numbers.
+//
+// Repeated fields have no presence in proto3, so they are always emitted
+// (empty list when not set). Methods that replace a list when it is given
+// (e.g. supported_feature_list and node_id_whitelist of UpdateNodeByNDID)
+// therefore replace it on every protobuf encoded call; callers send the
+// current list to keep it unchanged. Other fields are emitted only when set.
+func protoMessageToJSONValue(message protoreflect.Message) interface{} {
+	if message.Descriptor().FullName() == "google.protobuf.Value" {
+		value, ok := message.Interface().(*structpb.Value)
+		if !ok {
+			return nil
+		}
+		return value.AsInterface()
+	}
+	result := make(map[string]interface{})
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.IsList() {
+			list := message.Get(field).List()
+			items := make([]interface{}, 0, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				items = append(items, protoFieldToJSONValue(field, list.Get(j)))
+			}
+			result[string(field.Name())] = items
+			continue
+		}
+		if !message.Has(field) {
+			continue
+		}
+		result[string(field.Name())] = protoFieldToJSONValue(field, message.Get(field))
+	}
+	return result
+}
+
+func protoFieldToJSONValue(field protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoMessageToJSONValue(value.Message())
+	case protoreflect.EnumKind:
+		return int32(value.Enum())
+	default:
+		return value.Interface()
+	}
+}
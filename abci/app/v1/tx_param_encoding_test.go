@@ -0,0 +1,263 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	protoParam "github.com/ndidplatform/smart-contract/v9/protos/param"
+)
+
+func TestProtobufTxParamsCoverAllMethods(t *testing.T) {
+	for method := range IsMethod {
+		if method == "SetInitData" || method == "SetInitData_pb" {
+			continue
+		}
+		_, ok := protobufTxParams[method]
+		assert.True(t, ok, method)
+	}
+}
+
+func TestProtobufTxParamsRoundTrip(t *testing.T) {
+	// JSON param of each method. Protobuf params must decode to the same
+	// param as JSON encoded params.
+	testCases := map[string]interface{}{
+		"InitNDID":                          &InitNDIDParam{},
+		"EndInit":                           &EndInitParam{},
+		"SetLastBlock":                      &SetLastBlockParam{},
+		"SetValidator":                      &SetValidatorParam{},
+		"SetPriceFunc":                      &SetPriceFuncParam{},
+		"RegisterNode":                      &RegisterNodeParam{},
+		"UpdateNodeByNDID":                  &UpdateNodeByNDIDParam{},
+		"DisableNode":                       &DisableNodeParam{},
+		"EnableNode":                        &EnableNodeParam{},
+		"AddNodeToProxyNode":                &AddNodeToProxyNodeParam{},
+		"UpdateNodeProxyNode":               &UpdateNodeProxyNodeParam{},
+		"RemoveNodeFromProxyNode":           &RemoveNodeFromProxyNode{},
+		"AddNodeToken":                      &AddNodeTokenParam{},
+		"ReduceNodeToken":                   &ReduceNodeTokenParam{},
+		"SetNodeToken":                      &SetNodeTokenParam{},
+		"AddAllowedNodeSupportedFeature":    &AddAllowedNodeSupportedFeatureParam{},
+		"RemoveAllowedNodeSupportedFeature": &RemoveAllowedNodeSupportedFeatureParam{},
+		"AddNamespace":                      &AddNamespaceParam{},
+		"DisableNamespace":                  &DisableNamespaceParam{},
+		"EnableNamespace":                   &EnableNamespaceParam{},
+		"UpdateNamespace":                   &UpdateNamespaceParam{},
+		"SetAllowedMinIalForRegisterIdentityAtFirstIdp":        &SetAllowedMinIalForRegisterIdentityAtFirstIdpParam{},
+		"SetTimeOutBlockRegisterIdentity":                      &TimeOutBlockRegisterIdentity{},
+		"AddSuppressedIdentityModificationNotificationNode":    &AddSuppressedIdentityModificationNotificationNodeParam{},
+		"RemoveSuppressedIdentityModificationNotificationNode": &RemoveSuppressedIdentityModificationNotificationNodeParam{},
+		"AddService":                               &AddServiceParam{},
+		"DisableService":                           &DisableServiceParam{},
+		"EnableService":                            &EnableServiceParam{},
+		"UpdateService":                            &UpdateServiceParam{},
+		"RegisterServiceDestinationByNDID":         &RegisterServiceDestinationByNDIDParam{},
+		"DisableServiceDestinationByNDID":          &DisableServiceDestinationByNDIDParam{},
+		"EnableServiceDestinationByNDID":           &EnableServiceDestinationByNDIDParam{},
+		"SetServicePriceCeiling":                   &SetServicePriceCeilingParam{},
+		"SetServicePriceMinEffectiveDatetimeDelay": &SetServicePriceMinEffectiveDatetimeDelayParam{},
+		"SetSupportedIALList":                      &SetSupportedIALListParam{},
+		"SetSupportedAALList":                      &SetSupportedAALListParam{},
+		"SetAllowedModeList":                       &SetAllowedModeListParam{},
+		"AddRequestType":                           &AddRequestTypeParam{},
+		"RemoveRequestType":                        &RemoveRequestTypeParam{},
+		"AddErrorCode":                             &AddErrorCodeParam{},
+		"RemoveErrorCode":                          &RemoveErrorCodeParam{},
+		"UpdateNode":                               &UpdateNodeParam{},
+		"SetMqAddresses":                           &SetMqAddressesParam{},
+		"RegisterIdentity":                         &RegisterIdentityParam{},
+		"UpdateIdentity":                           &UpdateIdentityParam{},
+		"AddIdentity":                              &AddIdentityParam{},
+		"RevokeIdentityAssociation":                &RevokeIdentityAssociationParam{},
+		"AddAccessor":                              &AddAccessorParam{},
+		"RevokeAccessor":                           &RevokeAccessorParam{},
+		"RevokeAndAddAccessor":                     &RevokeAndAddAccessorParam{},
+		"RenewAccessor":                            &RenewAccessorParam{},
+		"BulkRegisterIdentityByNDID":               &BulkRegisterIdentityByNDIDParam{},
+		"FreezeReferenceGroup":                     &FreezeReferenceGroupParam{},
+		"UnfreezeReferenceGroup":                   &FreezeReferenceGroupParam{},
+		"TransferIdPAssociations":                  &TransferIdPAssociationsParam{},
+		"AcceptIdPAssociationTransfer":             &IdPAssociationTransferIDParam{},
+		"ProcessIdPAssociationTransferBatch":       &ProcessIdPAssociationTransferBatchParam{},
+		"CancelIdPAssociationTransfer":             &IdPAssociationTransferIDParam{},
+		"AddNodeCertificateAuthority":              &AddNodeCertificateAuthorityParam{},
+		"RemoveNodeCertificateAuthority":           &RemoveNodeCertificateAuthorityParam{},
+		"DecommissionNode":                         &DecommissionNodeParam{},
+		"Heartbeat":                                &HeartbeatParam{},
+		"SetValidatorPowerCap":                     &SetValidatorPowerCapParam{},
+		"GrantAdminPermission":                     &GrantAdminPermissionParam{},
+		"RevokeAdminPermission":                    &RevokeAdminPermissionParam{},
+		"SetGovernanceConfig":                      &SetGovernanceConfigParam{},
+		"CreateProposal":                           &CreateProposalParam{},
+		"ApproveProposal":                          &ApproveProposalParam{},
+		"CancelScheduledChange":                    &CancelScheduledChangeParam{},
+		"PauseMethod":                              &PauseMethodParam{},
+		"ResumeMethod":                             &ResumeMethodParam{},
+		"SetUpgradePlan":                           &SetUpgradePlanParam{},
+		"SetParameter":                             &SetParameterParam{},
+		"UpdateIdentityModeList":                   &UpdateIdentityModeListParam{},
+		"RegisterServiceDestination":               &RegisterServiceDestinationParam{},
+		"UpdateServiceDestination":                 &UpdateServiceDestinationParam{},
+		"DisableServiceDestination":                &DisableServiceDestinationParam{},
+		"EnableServiceDestination":                 &EnableServiceDestinationParam{},
+		"SetServicePrice":                          &SetServicePriceParam{},
+		"CreateRequest":                            &CreateRequestParam{},
+		"CreateIdpResponse":                        &CreateIdpResponseParam{},
+		"CreateAsResponse":                         &CreateAsResponseParam{},
+		"SetDataReceived":                          &SetDataReceivedParam{},
+		"CloseRequest":                             &CloseRequestParam{},
+		"TimeOutRequest":                           &TimeOutRequestParam{},
+		"CreateMessage":                            &CreateMessageParam{},
+	}
+
+	for method := range protobufTxParams {
+		_, ok := testCases[method]
+		assert.True(t, ok, method)
+	}
+
+	for method, funcParam := range testCases {
+		t.Run(method, func(t *testing.T) {
+			// empty message has every repeated field as empty list
+			_, paramJSON, err := decodeTxParams(method+protobufTxMethodSuffix, nil)
+			assert.NoError(t, err)
+			var emptyParam map[string]interface{}
+			assert.NoError(t, json.Unmarshal(paramJSON, &emptyParam))
+			fields := protobufTxParams[method]().ProtoReflect().Descriptor().Fields()
+			for i := 0; i < fields.Len(); i++ {
+				if fields.Get(i).IsList() {
+					assert.Equal(t, []interface{}{}, emptyParam[string(fields.Get(i).Name())], fields.Get(i).Name())
+				}
+			}
+
+			// JSON param -> protobuf param -> JSON param
+			expected := reflect.New(reflect.TypeOf(funcParam).Elem())
+			fillTestValue(expected)
+			expectedJSON, err := json.Marshal(expected.Interface())
+			assert.NoError(t, err)
+			message := protobufTxParams[method]()
+			assert.NoError(t, protojson.Unmarshal(expectedJSON, message))
+			param, err := proto.Marshal(message)
+			assert.NoError(t, err)
+
+			jsonMethod, paramJSON, err := decodeTxParams(method+protobufTxMethodSuffix, param)
+			assert.NoError(t, err)
+			assert.Equal(t, method, jsonMethod)
+			actual := reflect.New(reflect.TypeOf(funcParam).Elem())
+			decoder := json.NewDecoder(bytes.NewReader(paramJSON))
+			decoder.DisallowUnknownFields()
+			assert.NoError(t, decoder.Decode(actual.Interface()))
+			assert.Equal(t, expected.Interface(), actual.Interface())
+		})
+	}
+}
+
+func TestDecodeTxParams(t *testing.T) {
+	agent := true
+	legalEntityName := "Company"
+	param, err := proto.Marshal(&protoParam.UpdateNodeByNDIDParam{
+		NodeId:               "idp1",
+		MaxIal:               2.3,
+		SupportedFeatureList: []string{"on_the_fly"},
+		Agent:                &agent,
+		Profile:              &protoParam.NodeProfileParam{LegalEntityName: &legalEntityName},
+	})
+	assert.NoError(t, err)
+
+	method, paramJSON, err := decodeTxParams("UpdateNodeByNDID_pb", param)
+	assert.NoError(t, err)
+	assert.Equal(t, "UpdateNodeByNDID", method)
+	var funcParam UpdateNodeByNDIDParam
+	assert.NoError(t, json.Unmarshal(paramJSON, &funcParam))
+	assert.Equal(t, "idp1", funcParam.NodeID)
+	assert.Equal(t, 2.3, funcParam.MaxIal)
+	assert.Equal(t, []string{"on_the_fly"}, funcParam.SupportedFeatureList)
+	assert.Equal(t, &agent, funcParam.IsIdPAgent)
+	assert.Nil(t, funcParam.UseWhitelist)
+	// list not given in protobuf param clears the list
+	assert.Equal(t, []string{}, funcParam.Whitelist)
+	assert.Equal(t, &legalEntityName, funcParam.Profile.LegalEntityName)
+	assert.Nil(t, funcParam.Profile.ContactEmail)
+
+	// 64-bit integer is JSON number
+	param, err = proto.Marshal(&protoParam.SetLastBlockParam{BlockHeight: 1 << 40})
+	assert.NoError(t, err)
+	_, paramJSON, err = decodeTxParams("SetLastBlock_pb", param)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"block_height":1099511627776}`, string(paramJSON))
+
+	value, err := structpb.NewValue([]interface{}{1.1, 2.3})
+	assert.NoError(t, err)
+	param, err = proto.Marshal(&protoParam.SetParameterParam{Name: "SupportedIALList", Value: value})
+	assert.NoError(t, err)
+	_, paramJSON, err = decodeTxParams("SetParameter_pb", param)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"SupportedIALList","value":[1.1,2.3]}`, string(paramJSON))
+
+	// empty message
+	method, paramJSON, err = decodeTxParams("Heartbeat_pb", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Heartbeat", method)
+	assert.Equal(t, "{}", string(paramJSON))
+
+	// JSON params and methods without protobuf params are returned as is
+	method, paramJSON, err = decodeTxParams("Heartbeat", []byte(`{}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Heartbeat", method)
+	assert.Equal(t, "{}", string(paramJSON))
+	method, _, err = decodeTxParams("SetInitData_pb", []byte{0x0a})
+	assert.NoError(t, err)
+	assert.Equal(t, "SetInitData_pb", method)
+	method, _, err = decodeTxParams("Unknown_pb", []byte{0x0a})
+	assert.NoError(t, err)
+	assert.Equal(t, "Unknown_pb", method)
+}
+
+func TestDecodeTxParamsRejectsUnknownFields(t *testing.T) {
+	param, err := proto.Marshal(&protoParam.DisableNodeParam{NodeId: "rp1"})
+	assert.NoError(t, err)
+	param = protowire.AppendTag(param, 99, protowire.VarintType)
+	param = protowire.AppendVarint(param, 1)
+	_, _, err = decodeTxParams("DisableNode_pb", param)
+	assert.EqualError(t, err, "unknown field in DisableNodeParam")
+
+	// unknown field in sub-message
+	address, err := proto.Marshal(&protoParam.MsqAddress{Ip: "127.0.0.1", Port: 5555})
+	assert.NoError(t, err)
+	address = protowire.AppendTag(address, 99, protowire.BytesType)
+	address = protowire.AppendString(address, "unknown")
+	param = protowire.AppendTag(nil, 1, protowire.BytesType)
+	param = protowire.AppendBytes(param, address)
+	_, _, err = decodeTxParams("SetMqAddresses_pb", param)
+	assert.EqualError(t, err, "unknown field in MsqAddress")
+
+	_, _, err = decodeTxParams("DisableNode_pb", []byte{0xff})
+	assert.Error(t, err)
+}
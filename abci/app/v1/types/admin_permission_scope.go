@@ -0,0 +1,21 @@
+package types
+
+type AdminPermissionScope string
+
+const (
+	AdminPermissionScopeTokens     AdminPermissionScope = "tokens"
+	AdminPermissionScopeNamespaces AdminPermissionScope = "namespaces"
+	AdminPermissionScopeServices   AdminPermissionScope = "services"
+	AdminPermissionScopeNodes      AdminPermissionScope = "nodes"
+	AdminPermissionScopeChain      AdminPermissionScope = "chain"
+	AdminPermissionScopeValidators AdminPermissionScope = "validators"
+)
+
+var AdminPermissionScopes = []AdminPermissionScope{
+	AdminPermissionScopeTokens,
+	AdminPermissionScopeNamespaces,
+	AdminPermissionScopeServices,
+	AdminPermissionScopeNodes,
+	AdminPermissionScopeChain,
+	AdminPermissionScopeValidators,
+}
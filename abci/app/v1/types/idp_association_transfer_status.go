@@ -0,0 +1,10 @@
+package types
+
+type IdPAssociationTransferStatus string
+
+const (
+	IdPAssociationTransferStatusPendingAcceptance IdPAssociationTransferStatus = "pending_acceptance"
+	IdPAssociationTransferStatusInProgress        IdPAssociationTransferStatus = "in_progress"
+	IdPAssociationTransferStatusCompleted         IdPAssociationTransferStatus = "completed"
+	IdPAssociationTransferStatusCancelled         IdPAssociationTransferStatus = "cancelled"
+)
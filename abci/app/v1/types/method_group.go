@@ -0,0 +1,11 @@
+package types
+
+type MethodGroup string
+
+const (
+	MethodGroupRequest  MethodGroup = "request"
+	MethodGroupIdentity MethodGroup = "identity"
+	MethodGroupService  MethodGroup = "service"
+	MethodGroupMessage  MethodGroup = "message"
+	MethodGroupNode     MethodGroup = "node"
+)
@@ -0,0 +1,9 @@
+package types
+
+type MQProtocol string
+
+const (
+	MQProtocolTCP   MQProtocol = "tcp"
+	MQProtocolGRPC  MQProtocol = "grpc"
+	MQProtocolHTTPS MQProtocol = "https"
+)
@@ -0,0 +1,9 @@
+package types
+
+type ParameterType string
+
+const (
+	ParameterTypeInteger    ParameterType = "integer"
+	ParameterTypeNumber     ParameterType = "number"
+	ParameterTypeNumberList ParameterType = "number_list"
+)
@@ -0,0 +1,10 @@
+package types
+
+type ProposalStatus string
+
+const (
+	ProposalStatusPending  ProposalStatus = "pending"
+	ProposalStatusExecuted ProposalStatus = "executed"
+	ProposalStatusFailed   ProposalStatus = "failed"
+	ProposalStatusExpired  ProposalStatus = "expired"
+)
@@ -30,74 +30,186 @@ import (
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"google.golang.org/protobuf/proto"
 
+	appTypes "github.com/ndidplatform/smart-contract/v9/abci/app/v1/types"
 	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
 )
 
 func (app *ABCIApplication) Validators() (validators []abcitypes.Validator) {
 	app.logger.Infof("Validators")
-	itr, err := app.state.db.Iterator(nil, nil)
+	validatorList, err := app.getValidatorList(true)
 	if err != nil {
 		panic(err)
 	}
-	defer itr.Close()
-	for ; itr.Valid(); itr.Next() {
-		key := itr.Key()
-		validator := new(abcitypes.Validator)
-		err := abcitypes.ReadMessage(bytes.NewBuffer(key), validator)
+	for _, validator := range validatorList {
+		if validator.Power == 0 {
+			continue
+		}
+		pubKey, err := base64.StdEncoding.DecodeString(validator.PublicKey)
 		if err != nil {
 			panic(err)
 		}
-		validators = append(validators, *validator)
+		validators = append(validators, abcitypes.Validator{
+			Address: ed25519.PubKey(pubKey).Address(),
+			Power:   validator.Power,
+		})
 	}
 	return
 }
 
+// decodeLegacyValidatorDetail decodes validator stored before metadata support
+// as length-delimited ValidatorUpdate. Used by state migration only.
+func decodeLegacyValidatorDetail(pubKeyBase64 string, value []byte) (*data.ValidatorDetail, error) {
+	var legacyValidator abcitypes.ValidatorUpdate
+	err := abcitypes.ReadMessage(bytes.NewReader(value), &legacyValidator)
+	if err != nil {
+		return nil, err
+	}
+	if base64.StdEncoding.EncodeToString(legacyValidator.PubKey.GetEd25519()) != pubKeyBase64 {
+		return nil, fmt.Errorf("legacy validator public key does not match key: %s", pubKeyBase64)
+	}
+	return &data.ValidatorDetail{
+		PublicKey: pubKeyBase64,
+		Power:     legacyValidator.Power,
+		PowerHistory: []*data.ValidatorPowerChange{
+			{Power: legacyValidator.Power},
+		},
+	}, nil
+}
+
+func (app *ABCIApplication) getValidatorDetail(pubKeyBase64 string, committedState bool) (*data.ValidatorDetail, error) {
+	key := validatorKeyPrefix + keySeparator + pubKeyBase64
+	value, err := app.state.Get([]byte(key), committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	if value == nil {
+		return nil, nil
+	}
+	var validator data.ValidatorDetail
+	err = proto.Unmarshal(value, &validator)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.UnmarshalError,
+			Message: err.Error(),
+		}
+	}
+	return &validator, nil
+}
+
+// getValidatorList returns all validators including removed ones (power 0).
+// Validator keys are looked up from committed state.
+func (app *ABCIApplication) getValidatorList(committedState bool) ([]*data.ValidatorDetail, error) {
+	validatorKeyIteratorBasePrefix := validatorKeyPrefix + keySeparator
+	pubKeyList := make([]string, 0)
+	err := app.state.IterateKeyPrefix([]byte(validatorKeyIteratorBasePrefix), nil, true, func(key []byte, value []byte) (bool, error) {
+		pubKeyList = append(pubKeyList, string(key[len(validatorKeyIteratorBasePrefix):]))
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorList := make([]*data.ValidatorDetail, 0, len(pubKeyList))
+	for _, pubKeyBase64 := range pubKeyList {
+		validator, err := app.getValidatorDetail(pubKeyBase64, committedState)
+		if err != nil {
+			return nil, err
+		}
+		if validator == nil {
+			continue
+		}
+		validatorList = append(validatorList, validator)
+	}
+	return validatorList, nil
+}
+
+func (app *ABCIApplication) getValidatorPowerCap(committedState bool) (*data.ValidatorPowerCap, error) {
+	value, err := app.state.Get(validatorPowerCapKeyBytes, committedState)
+	if err != nil {
+		return nil, &ApplicationError{
+			Code:    code.AppStateError,
+			Message: err.Error(),
+		}
+	}
+	var powerCap data.ValidatorPowerCap
+	if value != nil {
+		err = proto.Unmarshal(value, &powerCap)
+		if err != nil {
+			return nil, &ApplicationError{
+				Code:    code.UnmarshalError,
+				Message: err.Error(),
+			}
+		}
+	}
+	return &powerCap, nil
+}
+
 // add, update, or remove a validator
-func (app *ABCIApplication) updateValidator(v abcitypes.ValidatorUpdate) *abcitypes.ExecTxResult {
+// Removed validator is kept with power 0 for history.
+func (app *ABCIApplication) updateValidator(v abcitypes.ValidatorUpdate, operatorNodeID string, moniker string, callerNodeID string) *abcitypes.ExecTxResult {
 	pubKeyBase64 := base64.StdEncoding.EncodeToString(v.PubKey.GetEd25519())
 	key := []byte(validatorKeyPrefix + keySeparator + pubKeyBase64)
 
-	if v.Power == 0 {
-		// remove validator
-		hasKey, err := app.state.Has(key, false)
-		if err != nil {
-			panic(err)
-		}
-		if !hasKey {
-			return app.NewExecTxResult(code.NotExistValidator, fmt.Sprintf("Cannot remove non-existent validator %X", key), "")
+	validator, err := app.getValidatorDetail(pubKeyBase64, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
 		}
-		app.state.Delete(key)
-	} else {
-		// add or update validator
-		value := bytes.NewBuffer(make([]byte, 0))
-		if err := abcitypes.WriteMessage(&v, value); err != nil {
-			return app.NewExecTxResult(code.EncodingError, fmt.Sprintf("Error encoding validator: %v", err), "")
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	if v.Power == 0 && (validator == nil || validator.Power == 0) {
+		return app.NewExecTxResult(code.NotExistValidator, fmt.Sprintf("Cannot remove non-existent validator %X", key), "")
+	}
+
+	if validator == nil {
+		validator = &data.ValidatorDetail{
+			PublicKey:        pubKeyBase64,
+			AddedBlockHeight: app.state.CurrentBlockHeight,
 		}
-		app.state.Set(key, value.Bytes())
 	}
+	if operatorNodeID != "" {
+		validator.OperatorNodeId = operatorNodeID
+	}
+	if moniker != "" {
+		validator.Moniker = moniker
+	}
+	validator.Power = v.Power
+	validator.PowerHistory = append(validator.PowerHistory, &data.ValidatorPowerChange{
+		Power:       v.Power,
+		BlockHeight: app.state.CurrentBlockHeight,
+		SetByNodeId: callerNodeID,
+	})
+	value, err := utils.ProtoDeterministicMarshal(validator)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(key, value)
 
 	app.valUpdates[pubKeyBase64] = v
 	return app.NewExecTxResult(code.OK, "success", "")
 }
 
 type SetValidatorParam struct {
-	PublicKey string `json:"public_key"`
-	Power     int64  `json:"power"`
+	PublicKey      string `json:"public_key"`
+	Power          int64  `json:"power"`
+	OperatorNodeID string `json:"operator_node_id"`
+	Moniker        string `json:"moniker"`
 }
 
 func (app *ABCIApplication) validateSetValidator(funcParam SetValidatorParam, callerNodeID string, committedState bool, checktx bool) error {
 	// permission
-	ok, err := app.isNDIDNodeByNodeID(callerNodeID, committedState)
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeValidators, committedState)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return &ApplicationError{
-			Code:    code.NoPermissionForCallNDIDMethod,
-			Message: "This node does not have permission to call NDID method",
-		}
-	}
 
 	// stateless
 
@@ -124,6 +236,61 @@ func (app *ABCIApplication) validateSetValidator(funcParam SetValidatorParam, ca
 		}
 	}
 
+	if checktx {
+		return nil
+	}
+
+	// stateful
+
+	if funcParam.OperatorNodeID != "" {
+		_, err = app.getNodeDetail(funcParam.OperatorNodeID, committedState)
+		if err != nil {
+			return err
+		}
+	}
+
+	powerCap, err := app.getValidatorPowerCap(committedState)
+	if err != nil {
+		return err
+	}
+	if powerCap.MaxVotingPower > 0 && funcParam.Power > powerCap.MaxVotingPower {
+		return &ApplicationError{
+			Code:    code.ValidatorVotingPowerExceedsCap,
+			Message: fmt.Sprintf("voting power cannot be greater than %d", powerCap.MaxVotingPower),
+		}
+	}
+	if powerCap.MaxTotalVotingPower > 0 {
+		// voting power of validators after this update
+		powerByPubKey := make(map[string]int64)
+		validatorList, err := app.getValidatorList(committedState)
+		if err != nil {
+			return &ApplicationError{
+				Code:    code.AppStateError,
+				Message: err.Error(),
+			}
+		}
+		for _, validator := range validatorList {
+			powerByPubKey[validator.PublicKey] = validator.Power
+		}
+		if !committedState {
+			// include validator updates in current block
+			for pubKeyBase64, validatorUpdate := range app.valUpdates {
+				powerByPubKey[pubKeyBase64] = validatorUpdate.Power
+			}
+		}
+		powerByPubKey[base64.StdEncoding.EncodeToString(pubKey)] = funcParam.Power
+		var totalPower int64
+		for _, power := range powerByPubKey {
+			totalPower += power
+		}
+		if totalPower > powerCap.MaxTotalVotingPower {
+			return &ApplicationError{
+				Code:    code.TotalValidatorVotingPowerExceedsCap,
+				Message: fmt.Sprintf("total voting power cannot be greater than %d", powerCap.MaxTotalVotingPower),
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -166,5 +333,205 @@ func (app *ABCIApplication) setValidator(param []byte, callerNodeID string) *abc
 		return app.NewExecTxResult(code.DecodingError, err.Error(), "")
 	}
 
-	return app.updateValidator(abcitypes.UpdateValidator(pubKey, funcParam.Power, ed25519.KeyType))
+	return app.updateValidator(
+		abcitypes.UpdateValidator(pubKey, funcParam.Power, ed25519.KeyType),
+		funcParam.OperatorNodeID,
+		funcParam.Moniker,
+		callerNodeID,
+	)
+}
+
+type SetValidatorPowerCapParam struct {
+	MaxVotingPower      int64 `json:"max_voting_power"`
+	MaxTotalVotingPower int64 `json:"max_total_voting_power"`
+}
+
+func (app *ABCIApplication) validateSetValidatorPowerCap(funcParam SetValidatorPowerCapParam, callerNodeID string, committedState bool, checktx bool) error {
+	// permission
+	err := app.checkAdminPermission(callerNodeID, appTypes.AdminPermissionScopeValidators, committedState)
+	if err != nil {
+		return err
+	}
+
+	// stateless
+
+	if funcParam.MaxVotingPower < 0 || funcParam.MaxTotalVotingPower < 0 {
+		return &ApplicationError{
+			Code:    code.InvalidValidatorPowerCap,
+			Message: "voting power cap can't be negative",
+		}
+	}
+
+	return nil
+}
+
+func (app *ABCIApplication) setValidatorPowerCapCheckTx(param []byte, callerNodeID string) *abcitypes.ResponseCheckTx {
+	var funcParam SetValidatorPowerCapParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return NewResponseCheckTx(code.UnmarshalError, err.Error())
+	}
+
+	err = app.validateSetValidatorPowerCap(funcParam, callerNodeID, true, true)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return NewResponseCheckTx(appErr.Code, appErr.Message)
+		}
+		return NewResponseCheckTx(code.UnknownError, err.Error())
+	}
+
+	return NewResponseCheckTx(code.OK, "")
+}
+
+// setValidatorPowerCap sets caps checked by SetValidator. Existing validators are not affected.
+func (app *ABCIApplication) setValidatorPowerCap(param []byte, callerNodeID string) *abcitypes.ExecTxResult {
+	app.logger.Infof("SetValidatorPowerCap, Parameter: %s", param)
+	var funcParam SetValidatorPowerCapParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewExecTxResult(code.UnmarshalError, err.Error(), "")
+	}
+
+	err = app.validateSetValidatorPowerCap(funcParam, callerNodeID, false, false)
+	if err != nil {
+		if appErr, ok := err.(*ApplicationError); ok {
+			return app.NewExecTxResult(appErr.Code, appErr.Message, "")
+		}
+		return app.NewExecTxResult(code.UnknownError, err.Error(), "")
+	}
+
+	powerCap := data.ValidatorPowerCap{
+		MaxVotingPower:      funcParam.MaxVotingPower,
+		MaxTotalVotingPower: funcParam.MaxTotalVotingPower,
+	}
+	value, err := utils.ProtoDeterministicMarshal(&powerCap)
+	if err != nil {
+		return app.NewExecTxResult(code.MarshalError, err.Error(), "")
+	}
+	app.state.Set(validatorPowerCapKeyBytes, value)
+
+	return app.NewExecTxResult(code.OK, "success", "")
+}
+
+type GetValidatorListParam struct {
+	IncludeRemoved bool `json:"include_removed"`
+}
+
+type ValidatorInfo struct {
+	PublicKey        string `json:"public_key"`
+	Power            int64  `json:"power"`
+	OperatorNodeID   string `json:"operator_node_id"`
+	Moniker          string `json:"moniker"`
+	AddedBlockHeight int64  `json:"added_block_height"`
+}
+
+type GetValidatorListResult struct {
+	ValidatorList []ValidatorInfo `json:"validator_list"`
+}
+
+func (app *ABCIApplication) getValidatorListQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetValidatorList, Parameter: %s", param)
+	var funcParam GetValidatorListParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	validatorList, err := app.getValidatorList(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	result := GetValidatorListResult{
+		ValidatorList: make([]ValidatorInfo, 0, len(validatorList)),
+	}
+	for _, validator := range validatorList {
+		if validator.Power == 0 && !funcParam.IncludeRemoved {
+			continue
+		}
+		result.ValidatorList = append(result.ValidatorList, ValidatorInfo{
+			PublicKey:        validator.PublicKey,
+			Power:            validator.Power,
+			OperatorNodeID:   validator.OperatorNodeId,
+			Moniker:          validator.Moniker,
+			AddedBlockHeight: validator.AddedBlockHeight,
+		})
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
+
+type GetValidatorHistoryParam struct {
+	PublicKey string `json:"public_key"`
+}
+
+type ValidatorPowerChange struct {
+	Power       int64  `json:"power"`
+	BlockHeight int64  `json:"block_height"`
+	SetByNodeID string `json:"set_by_node_id"`
+}
+
+type GetValidatorHistoryResult struct {
+	PublicKey    string                 `json:"public_key"`
+	PowerHistory []ValidatorPowerChange `json:"power_history"`
+}
+
+func (app *ABCIApplication) getValidatorHistory(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetValidatorHistory, Parameter: %s", param)
+	var funcParam GetValidatorHistoryParam
+	err := json.Unmarshal(param, &funcParam)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+
+	validator, err := app.getValidatorDetail(funcParam.PublicKey, true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	if validator == nil {
+		return app.NewResponseQuery([]byte("{}"), "not found", app.state.Height)
+	}
+
+	result := GetValidatorHistoryResult{
+		PublicKey:    validator.PublicKey,
+		PowerHistory: make([]ValidatorPowerChange, 0, len(validator.PowerHistory)),
+	}
+	for _, powerChange := range validator.PowerHistory {
+		result.PowerHistory = append(result.PowerHistory, ValidatorPowerChange{
+			Power:       powerChange.Power,
+			BlockHeight: powerChange.BlockHeight,
+			SetByNodeID: powerChange.SetByNodeId,
+		})
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
+}
+
+type GetValidatorPowerCapResult struct {
+	MaxVotingPower      int64 `json:"max_voting_power"`
+	MaxTotalVotingPower int64 `json:"max_total_voting_power"`
+}
+
+func (app *ABCIApplication) getValidatorPowerCapQuery(param []byte) *abcitypes.ResponseQuery {
+	app.logger.Infof("GetValidatorPowerCap, Parameter: %s", param)
+	powerCap, err := app.getValidatorPowerCap(true)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	result := GetValidatorPowerCapResult{
+		MaxVotingPower:      powerCap.MaxVotingPower,
+		MaxTotalVotingPower: powerCap.MaxTotalVotingPower,
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return app.NewResponseQuery(nil, err.Error(), app.state.Height)
+	}
+	return app.NewResponseQuery(value, "success", app.state.Height)
 }
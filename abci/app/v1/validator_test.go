@@ -0,0 +1,163 @@
+/**
+ * Copyright (c) 2018, 2019 National Digital ID COMPANY LIMITED
+ *
+ * This file is part of NDID software.
+ *
+ * NDID is the free software: you can redistribute it and/or modify it under
+ * the terms of the Affero GNU General Public License as published by the
+ * Free Software Foundation, either version 3 of the License, or any later
+ * version.
+ *
+ * NDID is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+ * See the Affero GNU General Public License for more details.
+ *
+ * You should have received a copy of the Affero GNU General Public License
+ * along with the NDID source code. If not, see https://www.gnu.org/licenses/agpl.txt.
+ *
+ * Please contact info@ndid.co.th for any further questions
+ *
+ */
+
+package app
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ndidplatform/smart-contract/v9/abci/code"
+	"github.com/ndidplatform/smart-contract/v9/abci/utils"
+	data "github.com/ndidplatform/smart-contract/v9/protos/data"
+)
+
+func newTestValidatorPublicKey(t *testing.T) string {
+	pubKey := make([]byte, ed25519.PubKeySize)
+	_, err := rand.Read(pubKey)
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(pubKey)
+}
+
+func TestDecodeLegacyValidatorDetail(t *testing.T) {
+	pubKeyBase64 := newTestValidatorPublicKey(t)
+	pubKey, err := base64.StdEncoding.DecodeString(pubKeyBase64)
+	assert.NoError(t, err)
+
+	// validator stored before metadata support
+	legacyValue := bytes.NewBuffer(make([]byte, 0))
+	validatorUpdate := abcitypes.UpdateValidator(pubKey, 10, ed25519.KeyType)
+	assert.NoError(t, abcitypes.WriteMessage(&validatorUpdate, legacyValue))
+
+	validatorDetailValue, err := utils.ProtoDeterministicMarshal(&data.ValidatorDetail{PublicKey: pubKeyBase64, Power: 20})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		pubKey        string
+		value         []byte
+		expectedError bool
+	}{
+		{"legacy validator", pubKeyBase64, legacyValue.Bytes(), false},
+		{"stored under another key", newTestValidatorPublicKey(t), legacyValue.Bytes(), true},
+		{"validator detail", pubKeyBase64, validatorDetailValue, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			validator, err := decodeLegacyValidatorDetail(tc.pubKey, tc.value)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.pubKey, validator.PublicKey)
+			assert.Equal(t, int64(10), validator.Power)
+			assert.Len(t, validator.PowerHistory, 1)
+		})
+	}
+}
+
+func TestSetValidatorPowerCap(t *testing.T) {
+	app := newTestAppWithNDID(t)
+	beginTestBlock(app)
+	deliverTestTx(t, app, "SetValidatorPowerCap", SetValidatorPowerCapParam{
+		MaxVotingPower:      10,
+		MaxTotalVotingPower: 25,
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	validator1 := newTestValidatorPublicKey(t)
+	validator2 := newTestValidatorPublicKey(t)
+	validator3 := newTestValidatorPublicKey(t)
+
+	beginTestBlock(app)
+	res := callTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator1, Power: 11}, testNDIDNodeID)
+	assert.Equal(t, code.ValidatorVotingPowerExceedsCap, res.Code)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator1, Power: 10}, testNDIDNodeID)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator2, Power: 10}, testNDIDNodeID)
+	// validator updates earlier in the same block are counted
+	res = callTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator3, Power: 10}, testNDIDNodeID)
+	assert.Equal(t, code.TotalValidatorVotingPowerExceedsCap, res.Code)
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	res = callTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator3, Power: 10}, testNDIDNodeID)
+	assert.Equal(t, code.TotalValidatorVotingPowerExceedsCap, res.Code)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator3, Power: 5}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	// removal frees voting power
+	beginTestBlock(app)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator1, Power: 0}, testNDIDNodeID)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator3, Power: 10}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	// removed validator is kept with power 0
+	validator, err := app.getValidatorDetail(validator1, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), validator.Power)
+	assert.Len(t, validator.PowerHistory, 2)
+	validatorList, err := app.getValidatorList(true)
+	assert.NoError(t, err)
+	assert.Len(t, validatorList, 3)
+	for _, validator := range app.Validators() {
+		assert.NotEqual(t, int64(0), validator.Power)
+	}
+	assert.Len(t, app.Validators(), 2)
+
+	res = callTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: validator1, Power: 0}, testNDIDNodeID)
+	assert.Equal(t, code.NotExistValidator, res.Code)
+}
+
+func TestSetValidatorAfterStateMigrations(t *testing.T) {
+	app := newTestAppFromBaselineState(t)
+	validatorList, err := app.getValidatorList(true)
+	assert.NoError(t, err)
+	assert.Len(t, validatorList, 1)
+	legacyValidator := validatorList[0].PublicKey
+
+	// migrated validator power is counted toward total voting power cap
+	beginTestBlock(app)
+	deliverTestTx(t, app, "SetValidatorPowerCap", SetValidatorPowerCapParam{
+		MaxVotingPower:      10,
+		MaxTotalVotingPower: 15,
+	}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	beginTestBlock(app)
+	res := callTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: newTestValidatorPublicKey(t), Power: 10}, testNDIDNodeID)
+	assert.Equal(t, code.TotalValidatorVotingPowerExceedsCap, res.Code)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: legacyValidator, Power: 5}, testNDIDNodeID)
+	deliverTestTx(t, app, "SetValidator", SetValidatorParam{PublicKey: newTestValidatorPublicKey(t), Power: 10}, testNDIDNodeID)
+	commitTestBlock(app)
+
+	validator, err := app.getValidatorDetail(legacyValidator, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), validator.Power)
+	assert.Len(t, validator.PowerHistory, 2)
+}
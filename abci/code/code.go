@@ -154,6 +154,66 @@ const (
 	NodeSupportedFeatureDoesNotExist                              uint32 = 130
 	InvalidValidatorVotingPower                                   uint32 = 131
 	InvalidValidatorPublicKey                                     uint32 = 132
+	AccessorExpirationMustBeInTheFuture                           uint32 = 133
+	AccessorIsNotActive                                           uint32 = 134
+	IdentityListCannotBeEmpty                                     uint32 = 135
+	IdentityListTooLarge                                          uint32 = 136
+	ReferenceGroupIsFrozen                                        uint32 = 137
+	ReferenceGroupIsNotFrozen                                     uint32 = 138
+	FreezeReasonCodeCannotBeEmpty                                 uint32 = 139
+	TransferIDCannotBeEmpty                                       uint32 = 140
+	IdPAssociationTransferAlreadyExists                           uint32 = 141
+	IdPAssociationTransferNotFound                                uint32 = 142
+	InvalidIdPAssociationTransferStatus                           uint32 = 143
+	CannotTransferIdPAssociationsToSameNode                       uint32 = 144
+	NotReceivingIdPOfTransfer                                     uint32 = 145
+	InvalidTransferBatchSize                                      uint32 = 146
+	InvalidCertificate                                            uint32 = 147
+	CertificateNotTrusted                                         uint32 = 148
+	CertificateNotValidAtThisTime                                 uint32 = 149
+	CertificateKeyUsageNotAllowed                                 uint32 = 150
+	CertificateNotAccepted                                        uint32 = 151
+	NodeCertificateAuthorityAlreadyExists                         uint32 = 152
+	NodeCertificateAuthorityNotFound                              uint32 = 153
+	NodeCertificateAuthorityIDCannotBeEmpty                       uint32 = 154
+	InvalidNodeProfile                                            uint32 = 155
+	NodeProfileFieldCanOnlyBeSetByNDID                            uint32 = 156
+	InvalidMqAddress                                              uint32 = 157
+	NodeIsDecommissioned                                          uint32 = 158
+	CannotDecommissionNDIDNode                                    uint32 = 159
+	ProxyNodeStillHasNodesBehind                                  uint32 = 160
+	NodeRoleCannotBeRemoved                                       uint32 = 161
+	ValidatorVotingPowerExceedsCap                                uint32 = 162
+	TotalValidatorVotingPowerExceedsCap                           uint32 = 163
+	InvalidValidatorPowerCap                                      uint32 = 164
+	InvalidAdminPermissionScope                                   uint32 = 165
+	CannotGrantAdminPermissionToNDIDNode                          uint32 = 166
+	AdminPermissionNotFound                                       uint32 = 167
+	InvalidGovernanceConfig                                       uint32 = 168
+	GovernanceNotEnabled                                          uint32 = 169
+	NotGovernanceMember                                           uint32 = 170
+	MethodRequiresProposal                                        uint32 = 171
+	MethodCannotBeProposed                                        uint32 = 172
+	ProposalIDCannotBeEmpty                                       uint32 = 173
+	ProposalAlreadyExists                                         uint32 = 174
+	ProposalNotFound                                              uint32 = 175
+	ProposalIsNotPending                                          uint32 = 176
+	ProposalExpired                                               uint32 = 177
+	ProposalAlreadyApproved                                       uint32 = 178
+	InvalidProposalExpiryHeight                                   uint32 = 179
+	InvalidChangeActivation                                       uint32 = 180
+	MethodCannotBeScheduled                                       uint32 = 181
+	ScheduledChangeNotFound                                       uint32 = 182
+	MethodIsPaused                                                uint32 = 183
+	InvalidPauseTarget                                            uint32 = 184
+	MethodIsNotPaused                                             uint32 = 185
+	InvalidUpgradePlan                                            uint32 = 186
+	UnsupportedAppProtocolVersion                                 uint32 = 187
+	ParameterNotFound                                             uint32 = 188
+	InvalidParameterValue                                         uint32 = 189
+	DuplicateReferenceGroupCodeInIdentityList                     uint32 = 190
+	RefGroupCodeOrIdentityIsRequired                              uint32 = 191
+	AccessorTypeDoesNotMatchAlgorithm                             uint32 = 192
 
 	UnknownError uint32 = 999
 )
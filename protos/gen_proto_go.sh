@@ -2,4 +2,5 @@
 
 protoc -I=./data --go_out=./data ./data/data.proto
 protoc -I=./tendermint --go_out=./tendermint ./tendermint/tendermint.proto
-protoc -I=./param --go_out=./param ./param/param.proto
\ No newline at end of file
+protoc -I=./param --go_out=./param ./param/param.proto
+protoc -I=./query --go_out=./query ./query/query.proto
\ No newline at end of file
@@ -9,6 +9,7 @@ package ndid_abci_param_v9
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -122,70 +123,8702 @@ func (x *SetInitDataParam) GetKvList() []*KeyValue {
 	return nil
 }
 
-var File_param_proto protoreflect.FileDescriptor
+type InitNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_param_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6e,
-	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76,
-	0x39, 0x22, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x6b, 0x76, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x64, 0x69,
-	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e,
-	0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6b, 0x76, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x3b, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	NodeId                 string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SigningPublicKey       string `protobuf:"bytes,2,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningAlgorithm       string `protobuf:"bytes,3,opt,name=signing_algorithm,json=signingAlgorithm,proto3" json:"signing_algorithm,omitempty"`
+	SigningMasterPublicKey string `protobuf:"bytes,4,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	SigningMasterAlgorithm string `protobuf:"bytes,5,opt,name=signing_master_algorithm,json=signingMasterAlgorithm,proto3" json:"signing_master_algorithm,omitempty"`
+	EncryptionPublicKey    string `protobuf:"bytes,6,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	EncryptionAlgorithm    string `protobuf:"bytes,7,opt,name=encryption_algorithm,json=encryptionAlgorithm,proto3" json:"encryption_algorithm,omitempty"`
+	ChainHistoryInfo       string `protobuf:"bytes,8,opt,name=chain_history_info,json=chainHistoryInfo,proto3" json:"chain_history_info,omitempty"`
 }
 
-var (
-	file_param_proto_rawDescOnce sync.Once
-	file_param_proto_rawDescData = file_param_proto_rawDesc
-)
+func (x *InitNDIDParam) Reset() {
+	*x = InitNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_param_proto_rawDescGZIP() []byte {
-	file_param_proto_rawDescOnce.Do(func() {
-		file_param_proto_rawDescData = protoimpl.X.CompressGZIP(file_param_proto_rawDescData)
-	})
-	return file_param_proto_rawDescData
+func (x *InitNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_param_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_param_proto_goTypes = []interface{}{
-	(*KeyValue)(nil),         // 0: ndid_abci_param_v9.KeyValue
-	(*SetInitDataParam)(nil), // 1: ndid_abci_param_v9.SetInitDataParam
+func (*InitNDIDParam) ProtoMessage() {}
+
+func (x *InitNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_param_proto_depIdxs = []int32{
-	0, // 0: ndid_abci_param_v9.SetInitDataParam.kv_list:type_name -> ndid_abci_param_v9.KeyValue
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// Deprecated: Use InitNDIDParam.ProtoReflect.Descriptor instead.
+func (*InitNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{2}
 }
 
-func init() { file_param_proto_init() }
-func file_param_proto_init() {
-	if File_param_proto != nil {
-		return
+func (x *InitNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_param_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyValue); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *InitNDIDParam) GetSigningPublicKey() string {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetSigningAlgorithm() string {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetSigningMasterPublicKey() string {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetSigningMasterAlgorithm() string {
+	if x != nil {
+		return x.SigningMasterAlgorithm
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetEncryptionPublicKey() string {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetEncryptionAlgorithm() string {
+	if x != nil {
+		return x.EncryptionAlgorithm
+	}
+	return ""
+}
+
+func (x *InitNDIDParam) GetChainHistoryInfo() string {
+	if x != nil {
+		return x.ChainHistoryInfo
+	}
+	return ""
+}
+
+type EndInitParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EndInitParam) Reset() {
+	*x = EndInitParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndInitParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndInitParam) ProtoMessage() {}
+
+func (x *EndInitParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_param_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetInitDataParam); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndInitParam.ProtoReflect.Descriptor instead.
+func (*EndInitParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{3}
+}
+
+type SetLastBlockParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *SetLastBlockParam) Reset() {
+	*x = SetLastBlockParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastBlockParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastBlockParam) ProtoMessage() {}
+
+func (x *SetLastBlockParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastBlockParam.ProtoReflect.Descriptor instead.
+func (*SetLastBlockParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetLastBlockParam) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type SetValidatorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey      string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Power          int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	OperatorNodeId string `protobuf:"bytes,3,opt,name=operator_node_id,json=operatorNodeId,proto3" json:"operator_node_id,omitempty"`
+	Moniker        string `protobuf:"bytes,4,opt,name=moniker,proto3" json:"moniker,omitempty"`
+}
+
+func (x *SetValidatorParam) Reset() {
+	*x = SetValidatorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetValidatorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetValidatorParam) ProtoMessage() {}
+
+func (x *SetValidatorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetValidatorParam.ProtoReflect.Descriptor instead.
+func (*SetValidatorParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetValidatorParam) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SetValidatorParam) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *SetValidatorParam) GetOperatorNodeId() string {
+	if x != nil {
+		return x.OperatorNodeId
+	}
+	return ""
+}
+
+func (x *SetValidatorParam) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+type SetPriceFuncParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Func                string  `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	Price               float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	ActivationHeight    int64   `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64   `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetPriceFuncParam) Reset() {
+	*x = SetPriceFuncParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPriceFuncParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceFuncParam) ProtoMessage() {}
+
+func (x *SetPriceFuncParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceFuncParam.ProtoReflect.Descriptor instead.
+func (*SetPriceFuncParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetPriceFuncParam) GetFunc() string {
+	if x != nil {
+		return x.Func
+	}
+	return ""
+}
+
+func (x *SetPriceFuncParam) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SetPriceFuncParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetPriceFuncParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type RegisterNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                 string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SigningPublicKey       string   `protobuf:"bytes,2,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningAlgorithm       string   `protobuf:"bytes,3,opt,name=signing_algorithm,json=signingAlgorithm,proto3" json:"signing_algorithm,omitempty"`
+	SigningMasterPublicKey string   `protobuf:"bytes,4,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	SigningMasterAlgorithm string   `protobuf:"bytes,5,opt,name=signing_master_algorithm,json=signingMasterAlgorithm,proto3" json:"signing_master_algorithm,omitempty"`
+	EncryptionPublicKey    string   `protobuf:"bytes,6,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	EncryptionAlgorithm    string   `protobuf:"bytes,7,opt,name=encryption_algorithm,json=encryptionAlgorithm,proto3" json:"encryption_algorithm,omitempty"`
+	NodeName               string   `protobuf:"bytes,8,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Role                   string   `protobuf:"bytes,9,opt,name=role,proto3" json:"role,omitempty"`
+	Roles                  []string `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"`
+	MaxIal                 float64  `protobuf:"fixed64,11,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal                 float64  `protobuf:"fixed64,12,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	SupportedFeatureList   []string `protobuf:"bytes,13,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	Agent                  *bool    `protobuf:"varint,14,opt,name=agent,proto3,oneof" json:"agent,omitempty"`
+	NodeIdWhitelistActive  *bool    `protobuf:"varint,15,opt,name=node_id_whitelist_active,json=nodeIdWhitelistActive,proto3,oneof" json:"node_id_whitelist_active,omitempty"`
+	NodeIdWhitelist        []string `protobuf:"bytes,16,rep,name=node_id_whitelist,json=nodeIdWhitelist,proto3" json:"node_id_whitelist,omitempty"`
+}
+
+func (x *RegisterNodeParam) Reset() {
+	*x = RegisterNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterNodeParam) ProtoMessage() {}
+
+func (x *RegisterNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterNodeParam.ProtoReflect.Descriptor instead.
+func (*RegisterNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisterNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetSigningPublicKey() string {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetSigningAlgorithm() string {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetSigningMasterPublicKey() string {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetSigningMasterAlgorithm() string {
+	if x != nil {
+		return x.SigningMasterAlgorithm
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetEncryptionPublicKey() string {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetEncryptionAlgorithm() string {
+	if x != nil {
+		return x.EncryptionAlgorithm
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RegisterNodeParam) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RegisterNodeParam) GetMaxIal() float64 {
+	if x != nil {
+		return x.MaxIal
+	}
+	return 0
+}
+
+func (x *RegisterNodeParam) GetMaxAal() float64 {
+	if x != nil {
+		return x.MaxAal
+	}
+	return 0
+}
+
+func (x *RegisterNodeParam) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *RegisterNodeParam) GetAgent() bool {
+	if x != nil && x.Agent != nil {
+		return *x.Agent
+	}
+	return false
+}
+
+func (x *RegisterNodeParam) GetNodeIdWhitelistActive() bool {
+	if x != nil && x.NodeIdWhitelistActive != nil {
+		return *x.NodeIdWhitelistActive
+	}
+	return false
+}
+
+func (x *RegisterNodeParam) GetNodeIdWhitelist() []string {
+	if x != nil {
+		return x.NodeIdWhitelist
+	}
+	return nil
+}
+
+type NodeProfileParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LegalEntityName    *string `protobuf:"bytes,1,opt,name=legal_entity_name,json=legalEntityName,proto3,oneof" json:"legal_entity_name,omitempty"`
+	RegistrationNumber *string `protobuf:"bytes,2,opt,name=registration_number,json=registrationNumber,proto3,oneof" json:"registration_number,omitempty"`
+	ContactEmail       *string `protobuf:"bytes,3,opt,name=contact_email,json=contactEmail,proto3,oneof" json:"contact_email,omitempty"`
+	SupportUrl         *string `protobuf:"bytes,4,opt,name=support_url,json=supportUrl,proto3,oneof" json:"support_url,omitempty"`
+	LogoHash           *string `protobuf:"bytes,5,opt,name=logo_hash,json=logoHash,proto3,oneof" json:"logo_hash,omitempty"`
+	OperatingHours     *string `protobuf:"bytes,6,opt,name=operating_hours,json=operatingHours,proto3,oneof" json:"operating_hours,omitempty"`
+}
+
+func (x *NodeProfileParam) Reset() {
+	*x = NodeProfileParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeProfileParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeProfileParam) ProtoMessage() {}
+
+func (x *NodeProfileParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeProfileParam.ProtoReflect.Descriptor instead.
+func (*NodeProfileParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NodeProfileParam) GetLegalEntityName() string {
+	if x != nil && x.LegalEntityName != nil {
+		return *x.LegalEntityName
+	}
+	return ""
+}
+
+func (x *NodeProfileParam) GetRegistrationNumber() string {
+	if x != nil && x.RegistrationNumber != nil {
+		return *x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *NodeProfileParam) GetContactEmail() string {
+	if x != nil && x.ContactEmail != nil {
+		return *x.ContactEmail
+	}
+	return ""
+}
+
+func (x *NodeProfileParam) GetSupportUrl() string {
+	if x != nil && x.SupportUrl != nil {
+		return *x.SupportUrl
+	}
+	return ""
+}
+
+func (x *NodeProfileParam) GetLogoHash() string {
+	if x != nil && x.LogoHash != nil {
+		return *x.LogoHash
+	}
+	return ""
+}
+
+func (x *NodeProfileParam) GetOperatingHours() string {
+	if x != nil && x.OperatingHours != nil {
+		return *x.OperatingHours
+	}
+	return ""
+}
+
+type UpdateNodeByNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                string            `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MaxIal                float64           `protobuf:"fixed64,2,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal                float64           `protobuf:"fixed64,3,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	SupportedFeatureList  []string          `protobuf:"bytes,4,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	NodeName              string            `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Agent                 *bool             `protobuf:"varint,6,opt,name=agent,proto3,oneof" json:"agent,omitempty"`
+	NodeIdWhitelistActive *bool             `protobuf:"varint,7,opt,name=node_id_whitelist_active,json=nodeIdWhitelistActive,proto3,oneof" json:"node_id_whitelist_active,omitempty"`
+	NodeIdWhitelist       []string          `protobuf:"bytes,8,rep,name=node_id_whitelist,json=nodeIdWhitelist,proto3" json:"node_id_whitelist,omitempty"`
+	Profile               *NodeProfileParam `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	Roles                 []string          `protobuf:"bytes,10,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *UpdateNodeByNDIDParam) Reset() {
+	*x = UpdateNodeByNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNodeByNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeByNDIDParam) ProtoMessage() {}
+
+func (x *UpdateNodeByNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeByNDIDParam.ProtoReflect.Descriptor instead.
+func (*UpdateNodeByNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateNodeByNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *UpdateNodeByNDIDParam) GetMaxIal() float64 {
+	if x != nil {
+		return x.MaxIal
+	}
+	return 0
+}
+
+func (x *UpdateNodeByNDIDParam) GetMaxAal() float64 {
+	if x != nil {
+		return x.MaxAal
+	}
+	return 0
+}
+
+func (x *UpdateNodeByNDIDParam) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *UpdateNodeByNDIDParam) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *UpdateNodeByNDIDParam) GetAgent() bool {
+	if x != nil && x.Agent != nil {
+		return *x.Agent
+	}
+	return false
+}
+
+func (x *UpdateNodeByNDIDParam) GetNodeIdWhitelistActive() bool {
+	if x != nil && x.NodeIdWhitelistActive != nil {
+		return *x.NodeIdWhitelistActive
+	}
+	return false
+}
+
+func (x *UpdateNodeByNDIDParam) GetNodeIdWhitelist() []string {
+	if x != nil {
+		return x.NodeIdWhitelist
+	}
+	return nil
+}
+
+func (x *UpdateNodeByNDIDParam) GetProfile() *NodeProfileParam {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UpdateNodeByNDIDParam) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type DisableNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *DisableNodeParam) Reset() {
+	*x = DisableNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableNodeParam) ProtoMessage() {}
+
+func (x *DisableNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableNodeParam.ProtoReflect.Descriptor instead.
+func (*DisableNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DisableNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type EnableNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *EnableNodeParam) Reset() {
+	*x = EnableNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableNodeParam) ProtoMessage() {}
+
+func (x *EnableNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableNodeParam.ProtoReflect.Descriptor instead.
+func (*EnableNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnableNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type AddNodeToProxyNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId      string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyNodeId string `protobuf:"bytes,2,opt,name=proxy_node_id,json=proxyNodeId,proto3" json:"proxy_node_id,omitempty"`
+	Config      string `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *AddNodeToProxyNodeParam) Reset() {
+	*x = AddNodeToProxyNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeToProxyNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeToProxyNodeParam) ProtoMessage() {}
+
+func (x *AddNodeToProxyNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeToProxyNodeParam.ProtoReflect.Descriptor instead.
+func (*AddNodeToProxyNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddNodeToProxyNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AddNodeToProxyNodeParam) GetProxyNodeId() string {
+	if x != nil {
+		return x.ProxyNodeId
+	}
+	return ""
+}
+
+func (x *AddNodeToProxyNodeParam) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type UpdateNodeProxyNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId      string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyNodeId string `protobuf:"bytes,2,opt,name=proxy_node_id,json=proxyNodeId,proto3" json:"proxy_node_id,omitempty"`
+	Config      string `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *UpdateNodeProxyNodeParam) Reset() {
+	*x = UpdateNodeProxyNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNodeProxyNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeProxyNodeParam) ProtoMessage() {}
+
+func (x *UpdateNodeProxyNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeProxyNodeParam.ProtoReflect.Descriptor instead.
+func (*UpdateNodeProxyNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateNodeProxyNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *UpdateNodeProxyNodeParam) GetProxyNodeId() string {
+	if x != nil {
+		return x.ProxyNodeId
+	}
+	return ""
+}
+
+func (x *UpdateNodeProxyNodeParam) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type RemoveNodeFromProxyNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *RemoveNodeFromProxyNodeParam) Reset() {
+	*x = RemoveNodeFromProxyNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveNodeFromProxyNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNodeFromProxyNodeParam) ProtoMessage() {}
+
+func (x *RemoveNodeFromProxyNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNodeFromProxyNodeParam.ProtoReflect.Descriptor instead.
+func (*RemoveNodeFromProxyNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveNodeFromProxyNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type AddNodeTokenParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *AddNodeTokenParam) Reset() {
+	*x = AddNodeTokenParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeTokenParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeTokenParam) ProtoMessage() {}
+
+func (x *AddNodeTokenParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeTokenParam.ProtoReflect.Descriptor instead.
+func (*AddNodeTokenParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddNodeTokenParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AddNodeTokenParam) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ReduceNodeTokenParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ReduceNodeTokenParam) Reset() {
+	*x = ReduceNodeTokenParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReduceNodeTokenParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReduceNodeTokenParam) ProtoMessage() {}
+
+func (x *ReduceNodeTokenParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReduceNodeTokenParam.ProtoReflect.Descriptor instead.
+func (*ReduceNodeTokenParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReduceNodeTokenParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ReduceNodeTokenParam) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SetNodeTokenParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SetNodeTokenParam) Reset() {
+	*x = SetNodeTokenParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNodeTokenParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeTokenParam) ProtoMessage() {}
+
+func (x *SetNodeTokenParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeTokenParam.ProtoReflect.Descriptor instead.
+func (*SetNodeTokenParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetNodeTokenParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SetNodeTokenParam) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AddAllowedNodeSupportedFeatureParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *AddAllowedNodeSupportedFeatureParam) Reset() {
+	*x = AddAllowedNodeSupportedFeatureParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAllowedNodeSupportedFeatureParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAllowedNodeSupportedFeatureParam) ProtoMessage() {}
+
+func (x *AddAllowedNodeSupportedFeatureParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAllowedNodeSupportedFeatureParam.ProtoReflect.Descriptor instead.
+func (*AddAllowedNodeSupportedFeatureParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddAllowedNodeSupportedFeatureParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAllowedNodeSupportedFeatureParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AddAllowedNodeSupportedFeatureParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type RemoveAllowedNodeSupportedFeatureParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) Reset() {
+	*x = RemoveAllowedNodeSupportedFeatureParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAllowedNodeSupportedFeatureParam) ProtoMessage() {}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAllowedNodeSupportedFeatureParam.ProtoReflect.Descriptor instead.
+func (*RemoveAllowedNodeSupportedFeatureParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *RemoveAllowedNodeSupportedFeatureParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type AddNamespaceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace                                    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description                                  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Active                                       bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	AllowedIdentifierCountInReferenceGroup       int32  `protobuf:"varint,4,opt,name=allowed_identifier_count_in_reference_group,json=allowedIdentifierCountInReferenceGroup,proto3" json:"allowed_identifier_count_in_reference_group,omitempty"`
+	AllowedActiveIdentifierCountInReferenceGroup int32  `protobuf:"varint,5,opt,name=allowed_active_identifier_count_in_reference_group,json=allowedActiveIdentifierCountInReferenceGroup,proto3" json:"allowed_active_identifier_count_in_reference_group,omitempty"`
+	ActivationHeight                             int64  `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime                          int64  `protobuf:"varint,7,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *AddNamespaceParam) Reset() {
+	*x = AddNamespaceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNamespaceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNamespaceParam) ProtoMessage() {}
+
+func (x *AddNamespaceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNamespaceParam.ProtoReflect.Descriptor instead.
+func (*AddNamespaceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddNamespaceParam) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AddNamespaceParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddNamespaceParam) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AddNamespaceParam) GetAllowedIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+func (x *AddNamespaceParam) GetAllowedActiveIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedActiveIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+func (x *AddNamespaceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AddNamespaceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type DisableNamespaceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace           string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *DisableNamespaceParam) Reset() {
+	*x = DisableNamespaceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableNamespaceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableNamespaceParam) ProtoMessage() {}
+
+func (x *DisableNamespaceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableNamespaceParam.ProtoReflect.Descriptor instead.
+func (*DisableNamespaceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisableNamespaceParam) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DisableNamespaceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *DisableNamespaceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type EnableNamespaceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace           string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *EnableNamespaceParam) Reset() {
+	*x = EnableNamespaceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableNamespaceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableNamespaceParam) ProtoMessage() {}
+
+func (x *EnableNamespaceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableNamespaceParam.ProtoReflect.Descriptor instead.
+func (*EnableNamespaceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnableNamespaceParam) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *EnableNamespaceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *EnableNamespaceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type UpdateNamespaceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace                                    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description                                  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AllowedIdentifierCountInReferenceGroup       int32  `protobuf:"varint,3,opt,name=allowed_identifier_count_in_reference_group,json=allowedIdentifierCountInReferenceGroup,proto3" json:"allowed_identifier_count_in_reference_group,omitempty"`
+	AllowedActiveIdentifierCountInReferenceGroup int32  `protobuf:"varint,4,opt,name=allowed_active_identifier_count_in_reference_group,json=allowedActiveIdentifierCountInReferenceGroup,proto3" json:"allowed_active_identifier_count_in_reference_group,omitempty"`
+	ActivationHeight                             int64  `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime                          int64  `protobuf:"varint,6,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *UpdateNamespaceParam) Reset() {
+	*x = UpdateNamespaceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNamespaceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceParam) ProtoMessage() {}
+
+func (x *UpdateNamespaceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceParam.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateNamespaceParam) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceParam) GetAllowedIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceParam) GetAllowedActiveIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedActiveIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *UpdateNamespaceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetAllowedMinIalForRegisterIdentityAtFirstIdpParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinIal              float64 `protobuf:"fixed64,1,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	ActivationHeight    int64   `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64   `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) Reset() {
+	*x = SetAllowedMinIalForRegisterIdentityAtFirstIdpParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) ProtoMessage() {}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowedMinIalForRegisterIdentityAtFirstIdpParam.ProtoReflect.Descriptor instead.
+func (*SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetAllowedMinIalForRegisterIdentityAtFirstIdpParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetTimeOutBlockRegisterIdentityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeOutBlock        int64 `protobuf:"varint,1,opt,name=time_out_block,json=timeOutBlock,proto3" json:"time_out_block,omitempty"`
+	ActivationHeight    int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64 `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) Reset() {
+	*x = SetTimeOutBlockRegisterIdentityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimeOutBlockRegisterIdentityParam) ProtoMessage() {}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimeOutBlockRegisterIdentityParam.ProtoReflect.Descriptor instead.
+func (*SetTimeOutBlockRegisterIdentityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) GetTimeOutBlock() int64 {
+	if x != nil {
+		return x.TimeOutBlock
+	}
+	return 0
+}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetTimeOutBlockRegisterIdentityParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type AddSuppressedIdentityModificationNotificationNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *AddSuppressedIdentityModificationNotificationNodeParam) Reset() {
+	*x = AddSuppressedIdentityModificationNotificationNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSuppressedIdentityModificationNotificationNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSuppressedIdentityModificationNotificationNodeParam) ProtoMessage() {}
+
+func (x *AddSuppressedIdentityModificationNotificationNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSuppressedIdentityModificationNotificationNodeParam.ProtoReflect.Descriptor instead.
+func (*AddSuppressedIdentityModificationNotificationNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddSuppressedIdentityModificationNotificationNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type RemoveSuppressedIdentityModificationNotificationNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *RemoveSuppressedIdentityModificationNotificationNodeParam) Reset() {
+	*x = RemoveSuppressedIdentityModificationNotificationNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSuppressedIdentityModificationNotificationNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSuppressedIdentityModificationNotificationNodeParam) ProtoMessage() {}
+
+func (x *RemoveSuppressedIdentityModificationNotificationNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSuppressedIdentityModificationNotificationNodeParam.ProtoReflect.Descriptor instead.
+func (*RemoveSuppressedIdentityModificationNotificationNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveSuppressedIdentityModificationNotificationNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type AddServiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName         string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	DataSchema          string `protobuf:"bytes,3,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
+	DataSchemaVersion   string `protobuf:"bytes,4,opt,name=data_schema_version,json=dataSchemaVersion,proto3" json:"data_schema_version,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,6,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *AddServiceParam) Reset() {
+	*x = AddServiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddServiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceParam) ProtoMessage() {}
+
+func (x *AddServiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddServiceParam.ProtoReflect.Descriptor instead.
+func (*AddServiceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddServiceParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AddServiceParam) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *AddServiceParam) GetDataSchema() string {
+	if x != nil {
+		return x.DataSchema
+	}
+	return ""
+}
+
+func (x *AddServiceParam) GetDataSchemaVersion() string {
+	if x != nil {
+		return x.DataSchemaVersion
+	}
+	return ""
+}
+
+func (x *AddServiceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AddServiceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type DisableServiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *DisableServiceParam) Reset() {
+	*x = DisableServiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableServiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableServiceParam) ProtoMessage() {}
+
+func (x *DisableServiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableServiceParam.ProtoReflect.Descriptor instead.
+func (*DisableServiceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DisableServiceParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DisableServiceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *DisableServiceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type EnableServiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *EnableServiceParam) Reset() {
+	*x = EnableServiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableServiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableServiceParam) ProtoMessage() {}
+
+func (x *EnableServiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableServiceParam.ProtoReflect.Descriptor instead.
+func (*EnableServiceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EnableServiceParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EnableServiceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *EnableServiceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type UpdateServiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName         string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	DataSchema          string `protobuf:"bytes,3,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
+	DataSchemaVersion   string `protobuf:"bytes,4,opt,name=data_schema_version,json=dataSchemaVersion,proto3" json:"data_schema_version,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,6,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *UpdateServiceParam) Reset() {
+	*x = UpdateServiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceParam) ProtoMessage() {}
+
+func (x *UpdateServiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceParam.ProtoReflect.Descriptor instead.
+func (*UpdateServiceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateServiceParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *UpdateServiceParam) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *UpdateServiceParam) GetDataSchema() string {
+	if x != nil {
+		return x.DataSchema
+	}
+	return ""
+}
+
+func (x *UpdateServiceParam) GetDataSchemaVersion() string {
+	if x != nil {
+		return x.DataSchemaVersion
+	}
+	return ""
+}
+
+func (x *UpdateServiceParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *UpdateServiceParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type RegisterServiceDestinationByNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId    string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *RegisterServiceDestinationByNDIDParam) Reset() {
+	*x = RegisterServiceDestinationByNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterServiceDestinationByNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterServiceDestinationByNDIDParam) ProtoMessage() {}
+
+func (x *RegisterServiceDestinationByNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterServiceDestinationByNDIDParam.ProtoReflect.Descriptor instead.
+func (*RegisterServiceDestinationByNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RegisterServiceDestinationByNDIDParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *RegisterServiceDestinationByNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type DisableServiceDestinationByNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId    string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *DisableServiceDestinationByNDIDParam) Reset() {
+	*x = DisableServiceDestinationByNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableServiceDestinationByNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableServiceDestinationByNDIDParam) ProtoMessage() {}
+
+func (x *DisableServiceDestinationByNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableServiceDestinationByNDIDParam.ProtoReflect.Descriptor instead.
+func (*DisableServiceDestinationByNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DisableServiceDestinationByNDIDParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DisableServiceDestinationByNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type EnableServiceDestinationByNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	NodeId    string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *EnableServiceDestinationByNDIDParam) Reset() {
+	*x = EnableServiceDestinationByNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableServiceDestinationByNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableServiceDestinationByNDIDParam) ProtoMessage() {}
+
+func (x *EnableServiceDestinationByNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableServiceDestinationByNDIDParam.ProtoReflect.Descriptor instead.
+func (*EnableServiceDestinationByNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EnableServiceDestinationByNDIDParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EnableServiceDestinationByNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type PriceCeilingByCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Price    float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceCeilingByCurrency) Reset() {
+	*x = PriceCeilingByCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceCeilingByCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCeilingByCurrency) ProtoMessage() {}
+
+func (x *PriceCeilingByCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCeilingByCurrency.ProtoReflect.Descriptor instead.
+func (*PriceCeilingByCurrency) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PriceCeilingByCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceCeilingByCurrency) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type SetServicePriceCeilingParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId                  string                    `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PriceCeilingByCurrencyList []*PriceCeilingByCurrency `protobuf:"bytes,2,rep,name=price_ceiling_by_currency_list,json=priceCeilingByCurrencyList,proto3" json:"price_ceiling_by_currency_list,omitempty"`
+	ActivationHeight           int64                     `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime        int64                     `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetServicePriceCeilingParam) Reset() {
+	*x = SetServicePriceCeilingParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServicePriceCeilingParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServicePriceCeilingParam) ProtoMessage() {}
+
+func (x *SetServicePriceCeilingParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServicePriceCeilingParam.ProtoReflect.Descriptor instead.
+func (*SetServicePriceCeilingParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetServicePriceCeilingParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SetServicePriceCeilingParam) GetPriceCeilingByCurrencyList() []*PriceCeilingByCurrency {
+	if x != nil {
+		return x.PriceCeilingByCurrencyList
+	}
+	return nil
+}
+
+func (x *SetServicePriceCeilingParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetServicePriceCeilingParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetServicePriceMinEffectiveDatetimeDelayParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	DurationSecond      uint32 `protobuf:"varint,2,opt,name=duration_second,json=durationSecond,proto3" json:"duration_second,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) Reset() {
+	*x = SetServicePriceMinEffectiveDatetimeDelayParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServicePriceMinEffectiveDatetimeDelayParam) ProtoMessage() {}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServicePriceMinEffectiveDatetimeDelayParam.ProtoReflect.Descriptor instead.
+func (*SetServicePriceMinEffectiveDatetimeDelayParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) GetDurationSecond() uint32 {
+	if x != nil {
+		return x.DurationSecond
+	}
+	return 0
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetServicePriceMinEffectiveDatetimeDelayParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetSupportedIALListParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupportedIalList    []float64 `protobuf:"fixed64,1,rep,packed,name=supported_ial_list,json=supportedIalList,proto3" json:"supported_ial_list,omitempty"`
+	ActivationHeight    int64     `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64     `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetSupportedIALListParam) Reset() {
+	*x = SetSupportedIALListParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSupportedIALListParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSupportedIALListParam) ProtoMessage() {}
+
+func (x *SetSupportedIALListParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSupportedIALListParam.ProtoReflect.Descriptor instead.
+func (*SetSupportedIALListParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetSupportedIALListParam) GetSupportedIalList() []float64 {
+	if x != nil {
+		return x.SupportedIalList
+	}
+	return nil
+}
+
+func (x *SetSupportedIALListParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetSupportedIALListParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetSupportedAALListParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupportedAalList    []float64 `protobuf:"fixed64,1,rep,packed,name=supported_aal_list,json=supportedAalList,proto3" json:"supported_aal_list,omitempty"`
+	ActivationHeight    int64     `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64     `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetSupportedAALListParam) Reset() {
+	*x = SetSupportedAALListParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSupportedAALListParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSupportedAALListParam) ProtoMessage() {}
+
+func (x *SetSupportedAALListParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSupportedAALListParam.ProtoReflect.Descriptor instead.
+func (*SetSupportedAALListParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetSupportedAALListParam) GetSupportedAalList() []float64 {
+	if x != nil {
+		return x.SupportedAalList
+	}
+	return nil
+}
+
+func (x *SetSupportedAALListParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetSupportedAALListParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type SetAllowedModeListParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purpose             string  `protobuf:"bytes,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	AllowedModeList     []int32 `protobuf:"varint,2,rep,packed,name=allowed_mode_list,json=allowedModeList,proto3" json:"allowed_mode_list,omitempty"`
+	ActivationHeight    int64   `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64   `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetAllowedModeListParam) Reset() {
+	*x = SetAllowedModeListParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllowedModeListParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowedModeListParam) ProtoMessage() {}
+
+func (x *SetAllowedModeListParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowedModeListParam.ProtoReflect.Descriptor instead.
+func (*SetAllowedModeListParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetAllowedModeListParam) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *SetAllowedModeListParam) GetAllowedModeList() []int32 {
+	if x != nil {
+		return x.AllowedModeList
+	}
+	return nil
+}
+
+func (x *SetAllowedModeListParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetAllowedModeListParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type AddRequestTypeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *AddRequestTypeParam) Reset() {
+	*x = AddRequestTypeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRequestTypeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequestTypeParam) ProtoMessage() {}
+
+func (x *AddRequestTypeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequestTypeParam.ProtoReflect.Descriptor instead.
+func (*AddRequestTypeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddRequestTypeParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddRequestTypeParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AddRequestTypeParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type RemoveRequestTypeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,3,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *RemoveRequestTypeParam) Reset() {
+	*x = RemoveRequestTypeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveRequestTypeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequestTypeParam) ProtoMessage() {}
+
+func (x *RemoveRequestTypeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequestTypeParam.ProtoReflect.Descriptor instead.
+func (*RemoveRequestTypeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoveRequestTypeParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveRequestTypeParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *RemoveRequestTypeParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type AddErrorCodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode           int32  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Description         string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type                string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,5,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *AddErrorCodeParam) Reset() {
+	*x = AddErrorCodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddErrorCodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddErrorCodeParam) ProtoMessage() {}
+
+func (x *AddErrorCodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddErrorCodeParam.ProtoReflect.Descriptor instead.
+func (*AddErrorCodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AddErrorCodeParam) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *AddErrorCodeParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddErrorCodeParam) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddErrorCodeParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AddErrorCodeParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type RemoveErrorCodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode           int32  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ActivationHeight    int64  `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64  `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *RemoveErrorCodeParam) Reset() {
+	*x = RemoveErrorCodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveErrorCodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveErrorCodeParam) ProtoMessage() {}
+
+func (x *RemoveErrorCodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveErrorCodeParam.ProtoReflect.Descriptor instead.
+func (*RemoveErrorCodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RemoveErrorCodeParam) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *RemoveErrorCodeParam) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RemoveErrorCodeParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *RemoveErrorCodeParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type UpdateNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SigningPublicKey                       string            `protobuf:"bytes,1,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningAlgorithm                       string            `protobuf:"bytes,2,opt,name=signing_algorithm,json=signingAlgorithm,proto3" json:"signing_algorithm,omitempty"`
+	SigningMasterPublicKey                 string            `protobuf:"bytes,3,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	SigningMasterAlgorithm                 string            `protobuf:"bytes,4,opt,name=signing_master_algorithm,json=signingMasterAlgorithm,proto3" json:"signing_master_algorithm,omitempty"`
+	EncryptionPublicKey                    string            `protobuf:"bytes,5,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	EncryptionAlgorithm                    string            `protobuf:"bytes,6,opt,name=encryption_algorithm,json=encryptionAlgorithm,proto3" json:"encryption_algorithm,omitempty"`
+	SupportedRequestMessageDataUrlTypeList []string          `protobuf:"bytes,7,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+	Profile                                *NodeProfileParam `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateNodeParam) Reset() {
+	*x = UpdateNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNodeParam) ProtoMessage() {}
+
+func (x *UpdateNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNodeParam.ProtoReflect.Descriptor instead.
+func (*UpdateNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateNodeParam) GetSigningPublicKey() string {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetSigningAlgorithm() string {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetSigningMasterPublicKey() string {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetSigningMasterAlgorithm() string {
+	if x != nil {
+		return x.SigningMasterAlgorithm
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetEncryptionPublicKey() string {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetEncryptionAlgorithm() string {
+	if x != nil {
+		return x.EncryptionAlgorithm
+	}
+	return ""
+}
+
+func (x *UpdateNodeParam) GetSupportedRequestMessageDataUrlTypeList() []string {
+	if x != nil {
+		return x.SupportedRequestMessageDataUrlTypeList
+	}
+	return nil
+}
+
+func (x *UpdateNodeParam) GetProfile() *NodeProfileParam {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type MsqAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip                        string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port                      int64  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Hostname                  string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Protocol                  string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	TlsCertificateFingerprint string `protobuf:"bytes,5,opt,name=tls_certificate_fingerprint,json=tlsCertificateFingerprint,proto3" json:"tls_certificate_fingerprint,omitempty"`
+	Priority                  int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight                    int32  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *MsqAddress) Reset() {
+	*x = MsqAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsqAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsqAddress) ProtoMessage() {}
+
+func (x *MsqAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsqAddress.ProtoReflect.Descriptor instead.
+func (*MsqAddress) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MsqAddress) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MsqAddress) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *MsqAddress) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *MsqAddress) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MsqAddress) GetTlsCertificateFingerprint() string {
+	if x != nil {
+		return x.TlsCertificateFingerprint
+	}
+	return ""
+}
+
+func (x *MsqAddress) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MsqAddress) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type SetMqAddressesParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*MsqAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *SetMqAddressesParam) Reset() {
+	*x = SetMqAddressesParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMqAddressesParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMqAddressesParam) ProtoMessage() {}
+
+func (x *SetMqAddressesParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMqAddressesParam.ProtoReflect.Descriptor instead.
+func (*SetMqAddressesParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetMqAddressesParam) GetAddresses() []*MsqAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type Identity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentityNamespace      string `protobuf:"bytes,1,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string `protobuf:"bytes,2,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Identity) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *Identity) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+type RegisterIdentityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode string      `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	NewIdentityList    []*Identity `protobuf:"bytes,2,rep,name=new_identity_list,json=newIdentityList,proto3" json:"new_identity_list,omitempty"`
+	Ial                float64     `protobuf:"fixed64,3,opt,name=ial,proto3" json:"ial,omitempty"`
+	Lial               *bool       `protobuf:"varint,4,opt,name=lial,proto3,oneof" json:"lial,omitempty"`
+	Laal               *bool       `protobuf:"varint,5,opt,name=laal,proto3,oneof" json:"laal,omitempty"`
+	ModeList           []int32     `protobuf:"varint,6,rep,packed,name=mode_list,json=modeList,proto3" json:"mode_list,omitempty"`
+	AccessorId         string      `protobuf:"bytes,7,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorPublicKey  string      `protobuf:"bytes,8,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorType       string      `protobuf:"bytes,9,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorAlgorithm  string      `protobuf:"bytes,10,opt,name=accessor_algorithm,json=accessorAlgorithm,proto3" json:"accessor_algorithm,omitempty"`
+	AccessorExpiration *string     `protobuf:"bytes,11,opt,name=accessor_expiration,json=accessorExpiration,proto3,oneof" json:"accessor_expiration,omitempty"`
+	RequestId          string      `protobuf:"bytes,12,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RegisterIdentityParam) Reset() {
+	*x = RegisterIdentityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterIdentityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterIdentityParam) ProtoMessage() {}
+
+func (x *RegisterIdentityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterIdentityParam.ProtoReflect.Descriptor instead.
+func (*RegisterIdentityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RegisterIdentityParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetNewIdentityList() []*Identity {
+	if x != nil {
+		return x.NewIdentityList
+	}
+	return nil
+}
+
+func (x *RegisterIdentityParam) GetIal() float64 {
+	if x != nil {
+		return x.Ial
+	}
+	return 0
+}
+
+func (x *RegisterIdentityParam) GetLial() bool {
+	if x != nil && x.Lial != nil {
+		return *x.Lial
+	}
+	return false
+}
+
+func (x *RegisterIdentityParam) GetLaal() bool {
+	if x != nil && x.Laal != nil {
+		return *x.Laal
+	}
+	return false
+}
+
+func (x *RegisterIdentityParam) GetModeList() []int32 {
+	if x != nil {
+		return x.ModeList
+	}
+	return nil
+}
+
+func (x *RegisterIdentityParam) GetAccessorId() string {
+	if x != nil {
+		return x.AccessorId
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetAccessorPublicKey() string {
+	if x != nil {
+		return x.AccessorPublicKey
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetAccessorType() string {
+	if x != nil {
+		return x.AccessorType
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetAccessorAlgorithm() string {
+	if x != nil {
+		return x.AccessorAlgorithm
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetAccessorExpiration() string {
+	if x != nil && x.AccessorExpiration != nil {
+		return *x.AccessorExpiration
+	}
+	return ""
+}
+
+func (x *RegisterIdentityParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type UpdateIdentityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string   `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string   `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string   `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	Ial                    *float64 `protobuf:"fixed64,4,opt,name=ial,proto3,oneof" json:"ial,omitempty"`
+	Lial                   *bool    `protobuf:"varint,5,opt,name=lial,proto3,oneof" json:"lial,omitempty"`
+	Laal                   *bool    `protobuf:"varint,6,opt,name=laal,proto3,oneof" json:"laal,omitempty"`
+}
+
+func (x *UpdateIdentityParam) Reset() {
+	*x = UpdateIdentityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIdentityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentityParam) ProtoMessage() {}
+
+func (x *UpdateIdentityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentityParam.ProtoReflect.Descriptor instead.
+func (*UpdateIdentityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateIdentityParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *UpdateIdentityParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *UpdateIdentityParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *UpdateIdentityParam) GetIal() float64 {
+	if x != nil && x.Ial != nil {
+		return *x.Ial
+	}
+	return 0
+}
+
+func (x *UpdateIdentityParam) GetLial() bool {
+	if x != nil && x.Lial != nil {
+		return *x.Lial
+	}
+	return false
+}
+
+func (x *UpdateIdentityParam) GetLaal() bool {
+	if x != nil && x.Laal != nil {
+		return *x.Laal
+	}
+	return false
+}
+
+type AddIdentityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode string      `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	NewIdentityList    []*Identity `protobuf:"bytes,2,rep,name=new_identity_list,json=newIdentityList,proto3" json:"new_identity_list,omitempty"`
+	RequestId          string      `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *AddIdentityParam) Reset() {
+	*x = AddIdentityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddIdentityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIdentityParam) ProtoMessage() {}
+
+func (x *AddIdentityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIdentityParam.ProtoReflect.Descriptor instead.
+func (*AddIdentityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AddIdentityParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *AddIdentityParam) GetNewIdentityList() []*Identity {
+	if x != nil {
+		return x.NewIdentityList
+	}
+	return nil
+}
+
+func (x *AddIdentityParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RevokeIdentityAssociationParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	RequestId              string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RevokeIdentityAssociationParam) Reset() {
+	*x = RevokeIdentityAssociationParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeIdentityAssociationParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIdentityAssociationParam) ProtoMessage() {}
+
+func (x *RevokeIdentityAssociationParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIdentityAssociationParam.ProtoReflect.Descriptor instead.
+func (*RevokeIdentityAssociationParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeIdentityAssociationParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *RevokeIdentityAssociationParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *RevokeIdentityAssociationParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *RevokeIdentityAssociationParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AddAccessorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string  `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string  `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string  `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	AccessorId             string  `protobuf:"bytes,4,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorPublicKey      string  `protobuf:"bytes,5,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorType           string  `protobuf:"bytes,6,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorAlgorithm      string  `protobuf:"bytes,7,opt,name=accessor_algorithm,json=accessorAlgorithm,proto3" json:"accessor_algorithm,omitempty"`
+	AccessorExpiration     *string `protobuf:"bytes,8,opt,name=accessor_expiration,json=accessorExpiration,proto3,oneof" json:"accessor_expiration,omitempty"`
+	RequestId              string  `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *AddAccessorParam) Reset() {
+	*x = AddAccessorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAccessorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccessorParam) ProtoMessage() {}
+
+func (x *AddAccessorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccessorParam.ProtoReflect.Descriptor instead.
+func (*AddAccessorParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddAccessorParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetAccessorId() string {
+	if x != nil {
+		return x.AccessorId
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetAccessorPublicKey() string {
+	if x != nil {
+		return x.AccessorPublicKey
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetAccessorType() string {
+	if x != nil {
+		return x.AccessorType
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetAccessorAlgorithm() string {
+	if x != nil {
+		return x.AccessorAlgorithm
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetAccessorExpiration() string {
+	if x != nil && x.AccessorExpiration != nil {
+		return *x.AccessorExpiration
+	}
+	return ""
+}
+
+func (x *AddAccessorParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RevokeAccessorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessorIdList []string `protobuf:"bytes,1,rep,name=accessor_id_list,json=accessorIdList,proto3" json:"accessor_id_list,omitempty"`
+	RequestId      string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RevokeAccessorParam) Reset() {
+	*x = RevokeAccessorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAccessorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAccessorParam) ProtoMessage() {}
+
+func (x *RevokeAccessorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAccessorParam.ProtoReflect.Descriptor instead.
+func (*RevokeAccessorParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RevokeAccessorParam) GetAccessorIdList() []string {
+	if x != nil {
+		return x.AccessorIdList
+	}
+	return nil
+}
+
+func (x *RevokeAccessorParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RevokeAndAddAccessorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevokingAccessorId string  `protobuf:"bytes,1,opt,name=revoking_accessor_id,json=revokingAccessorId,proto3" json:"revoking_accessor_id,omitempty"`
+	AccessorId         string  `protobuf:"bytes,2,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorPublicKey  string  `protobuf:"bytes,3,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorType       string  `protobuf:"bytes,4,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorAlgorithm  string  `protobuf:"bytes,5,opt,name=accessor_algorithm,json=accessorAlgorithm,proto3" json:"accessor_algorithm,omitempty"`
+	AccessorExpiration *string `protobuf:"bytes,6,opt,name=accessor_expiration,json=accessorExpiration,proto3,oneof" json:"accessor_expiration,omitempty"`
+	RequestId          string  `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *RevokeAndAddAccessorParam) Reset() {
+	*x = RevokeAndAddAccessorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAndAddAccessorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAndAddAccessorParam) ProtoMessage() {}
+
+func (x *RevokeAndAddAccessorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAndAddAccessorParam.ProtoReflect.Descriptor instead.
+func (*RevokeAndAddAccessorParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevokeAndAddAccessorParam) GetRevokingAccessorId() string {
+	if x != nil {
+		return x.RevokingAccessorId
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetAccessorId() string {
+	if x != nil {
+		return x.AccessorId
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetAccessorPublicKey() string {
+	if x != nil {
+		return x.AccessorPublicKey
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetAccessorType() string {
+	if x != nil {
+		return x.AccessorType
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetAccessorAlgorithm() string {
+	if x != nil {
+		return x.AccessorAlgorithm
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetAccessorExpiration() string {
+	if x != nil && x.AccessorExpiration != nil {
+		return *x.AccessorExpiration
+	}
+	return ""
+}
+
+func (x *RevokeAndAddAccessorParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RenewAccessorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessorId         string  `protobuf:"bytes,1,opt,name=accessor_id,json=accessorId,proto3" json:"accessor_id,omitempty"`
+	AccessorExpiration *string `protobuf:"bytes,2,opt,name=accessor_expiration,json=accessorExpiration,proto3,oneof" json:"accessor_expiration,omitempty"`
+}
+
+func (x *RenewAccessorParam) Reset() {
+	*x = RenewAccessorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewAccessorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewAccessorParam) ProtoMessage() {}
+
+func (x *RenewAccessorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewAccessorParam.ProtoReflect.Descriptor instead.
+func (*RenewAccessorParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RenewAccessorParam) GetAccessorId() string {
+	if x != nil {
+		return x.AccessorId
+	}
+	return ""
+}
+
+func (x *RenewAccessorParam) GetAccessorExpiration() string {
+	if x != nil && x.AccessorExpiration != nil {
+		return *x.AccessorExpiration
+	}
+	return ""
+}
+
+type BulkRegisterIdentityByNDIDParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId       string                   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	IdentityList []*RegisterIdentityParam `protobuf:"bytes,2,rep,name=identity_list,json=identityList,proto3" json:"identity_list,omitempty"`
+}
+
+func (x *BulkRegisterIdentityByNDIDParam) Reset() {
+	*x = BulkRegisterIdentityByNDIDParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkRegisterIdentityByNDIDParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRegisterIdentityByNDIDParam) ProtoMessage() {}
+
+func (x *BulkRegisterIdentityByNDIDParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRegisterIdentityByNDIDParam.ProtoReflect.Descriptor instead.
+func (*BulkRegisterIdentityByNDIDParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BulkRegisterIdentityByNDIDParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *BulkRegisterIdentityByNDIDParam) GetIdentityList() []*RegisterIdentityParam {
+	if x != nil {
+		return x.IdentityList
+	}
+	return nil
+}
+
+type FreezeReferenceGroupParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	ReasonCode             string `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+}
+
+func (x *FreezeReferenceGroupParam) Reset() {
+	*x = FreezeReferenceGroupParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreezeReferenceGroupParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeReferenceGroupParam) ProtoMessage() {}
+
+func (x *FreezeReferenceGroupParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeReferenceGroupParam.ProtoReflect.Descriptor instead.
+func (*FreezeReferenceGroupParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FreezeReferenceGroupParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *FreezeReferenceGroupParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *FreezeReferenceGroupParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *FreezeReferenceGroupParam) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+type UnfreezeReferenceGroupParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	ReasonCode             string `protobuf:"bytes,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+}
+
+func (x *UnfreezeReferenceGroupParam) Reset() {
+	*x = UnfreezeReferenceGroupParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeReferenceGroupParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeReferenceGroupParam) ProtoMessage() {}
+
+func (x *UnfreezeReferenceGroupParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeReferenceGroupParam.ProtoReflect.Descriptor instead.
+func (*UnfreezeReferenceGroupParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UnfreezeReferenceGroupParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *UnfreezeReferenceGroupParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *UnfreezeReferenceGroupParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *UnfreezeReferenceGroupParam) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+type TransferIdPAssociationsParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId        string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	FromNodeId        string `protobuf:"bytes,2,opt,name=from_node_id,json=fromNodeId,proto3" json:"from_node_id,omitempty"`
+	ToNodeId          string `protobuf:"bytes,3,opt,name=to_node_id,json=toNodeId,proto3" json:"to_node_id,omitempty"`
+	TransferAccessors bool   `protobuf:"varint,4,opt,name=transfer_accessors,json=transferAccessors,proto3" json:"transfer_accessors,omitempty"`
+}
+
+func (x *TransferIdPAssociationsParam) Reset() {
+	*x = TransferIdPAssociationsParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferIdPAssociationsParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferIdPAssociationsParam) ProtoMessage() {}
+
+func (x *TransferIdPAssociationsParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferIdPAssociationsParam.ProtoReflect.Descriptor instead.
+func (*TransferIdPAssociationsParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TransferIdPAssociationsParam) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *TransferIdPAssociationsParam) GetFromNodeId() string {
+	if x != nil {
+		return x.FromNodeId
+	}
+	return ""
+}
+
+func (x *TransferIdPAssociationsParam) GetToNodeId() string {
+	if x != nil {
+		return x.ToNodeId
+	}
+	return ""
+}
+
+func (x *TransferIdPAssociationsParam) GetTransferAccessors() bool {
+	if x != nil {
+		return x.TransferAccessors
+	}
+	return false
+}
+
+type AcceptIdPAssociationTransferParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+}
+
+func (x *AcceptIdPAssociationTransferParam) Reset() {
+	*x = AcceptIdPAssociationTransferParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptIdPAssociationTransferParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptIdPAssociationTransferParam) ProtoMessage() {}
+
+func (x *AcceptIdPAssociationTransferParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptIdPAssociationTransferParam.ProtoReflect.Descriptor instead.
+func (*AcceptIdPAssociationTransferParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AcceptIdPAssociationTransferParam) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type ProcessIdPAssociationTransferBatchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	BatchSize  int64  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *ProcessIdPAssociationTransferBatchParam) Reset() {
+	*x = ProcessIdPAssociationTransferBatchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessIdPAssociationTransferBatchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessIdPAssociationTransferBatchParam) ProtoMessage() {}
+
+func (x *ProcessIdPAssociationTransferBatchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessIdPAssociationTransferBatchParam.ProtoReflect.Descriptor instead.
+func (*ProcessIdPAssociationTransferBatchParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ProcessIdPAssociationTransferBatchParam) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *ProcessIdPAssociationTransferBatchParam) GetBatchSize() int64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type CancelIdPAssociationTransferParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+}
+
+func (x *CancelIdPAssociationTransferParam) Reset() {
+	*x = CancelIdPAssociationTransferParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelIdPAssociationTransferParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelIdPAssociationTransferParam) ProtoMessage() {}
+
+func (x *CancelIdPAssociationTransferParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelIdPAssociationTransferParam.ProtoReflect.Descriptor instead.
+func (*CancelIdPAssociationTransferParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelIdPAssociationTransferParam) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type AddNodeCertificateAuthorityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaId        string `protobuf:"bytes,1,opt,name=ca_id,json=caId,proto3" json:"ca_id,omitempty"`
+	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Root        bool   `protobuf:"varint,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *AddNodeCertificateAuthorityParam) Reset() {
+	*x = AddNodeCertificateAuthorityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNodeCertificateAuthorityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeCertificateAuthorityParam) ProtoMessage() {}
+
+func (x *AddNodeCertificateAuthorityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeCertificateAuthorityParam.ProtoReflect.Descriptor instead.
+func (*AddNodeCertificateAuthorityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddNodeCertificateAuthorityParam) GetCaId() string {
+	if x != nil {
+		return x.CaId
+	}
+	return ""
+}
+
+func (x *AddNodeCertificateAuthorityParam) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *AddNodeCertificateAuthorityParam) GetRoot() bool {
+	if x != nil {
+		return x.Root
+	}
+	return false
+}
+
+type RemoveNodeCertificateAuthorityParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaId string `protobuf:"bytes,1,opt,name=ca_id,json=caId,proto3" json:"ca_id,omitempty"`
+}
+
+func (x *RemoveNodeCertificateAuthorityParam) Reset() {
+	*x = RemoveNodeCertificateAuthorityParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveNodeCertificateAuthorityParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveNodeCertificateAuthorityParam) ProtoMessage() {}
+
+func (x *RemoveNodeCertificateAuthorityParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveNodeCertificateAuthorityParam.ProtoReflect.Descriptor instead.
+func (*RemoveNodeCertificateAuthorityParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RemoveNodeCertificateAuthorityParam) GetCaId() string {
+	if x != nil {
+		return x.CaId
+	}
+	return ""
+}
+
+type DecommissionNodeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *DecommissionNodeParam) Reset() {
+	*x = DecommissionNodeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecommissionNodeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionNodeParam) ProtoMessage() {}
+
+func (x *DecommissionNodeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionNodeParam.ProtoReflect.Descriptor instead.
+func (*DecommissionNodeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DecommissionNodeParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type HeartbeatParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatParam) Reset() {
+	*x = HeartbeatParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatParam) ProtoMessage() {}
+
+func (x *HeartbeatParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatParam.ProtoReflect.Descriptor instead.
+func (*HeartbeatParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{67}
+}
+
+type SetValidatorPowerCapParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxVotingPower      int64 `protobuf:"varint,1,opt,name=max_voting_power,json=maxVotingPower,proto3" json:"max_voting_power,omitempty"`
+	MaxTotalVotingPower int64 `protobuf:"varint,2,opt,name=max_total_voting_power,json=maxTotalVotingPower,proto3" json:"max_total_voting_power,omitempty"`
+	ActivationHeight    int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64 `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetValidatorPowerCapParam) Reset() {
+	*x = SetValidatorPowerCapParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetValidatorPowerCapParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetValidatorPowerCapParam) ProtoMessage() {}
+
+func (x *SetValidatorPowerCapParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetValidatorPowerCapParam.ProtoReflect.Descriptor instead.
+func (*SetValidatorPowerCapParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetValidatorPowerCapParam) GetMaxVotingPower() int64 {
+	if x != nil {
+		return x.MaxVotingPower
+	}
+	return 0
+}
+
+func (x *SetValidatorPowerCapParam) GetMaxTotalVotingPower() int64 {
+	if x != nil {
+		return x.MaxTotalVotingPower
+	}
+	return 0
+}
+
+func (x *SetValidatorPowerCapParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetValidatorPowerCapParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type GrantAdminPermissionParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *GrantAdminPermissionParam) Reset() {
+	*x = GrantAdminPermissionParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantAdminPermissionParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantAdminPermissionParam) ProtoMessage() {}
+
+func (x *GrantAdminPermissionParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantAdminPermissionParam.ProtoReflect.Descriptor instead.
+func (*GrantAdminPermissionParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GrantAdminPermissionParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GrantAdminPermissionParam) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type RevokeAdminPermissionParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *RevokeAdminPermissionParam) Reset() {
+	*x = RevokeAdminPermissionParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAdminPermissionParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAdminPermissionParam) ProtoMessage() {}
+
+func (x *RevokeAdminPermissionParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAdminPermissionParam.ProtoReflect.Descriptor instead.
+func (*RevokeAdminPermissionParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RevokeAdminPermissionParam) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RevokeAdminPermissionParam) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type SetGovernanceConfigParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberNodeIdList []string `protobuf:"bytes,1,rep,name=member_node_id_list,json=memberNodeIdList,proto3" json:"member_node_id_list,omitempty"`
+	Threshold        int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *SetGovernanceConfigParam) Reset() {
+	*x = SetGovernanceConfigParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGovernanceConfigParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGovernanceConfigParam) ProtoMessage() {}
+
+func (x *SetGovernanceConfigParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGovernanceConfigParam.ProtoReflect.Descriptor instead.
+func (*SetGovernanceConfigParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetGovernanceConfigParam) GetMemberNodeIdList() []string {
+	if x != nil {
+		return x.MemberNodeIdList
+	}
+	return nil
+}
+
+func (x *SetGovernanceConfigParam) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type CreateProposalParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId        string          `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Method            string          `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params            *structpb.Value `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	ExpiryBlockHeight int64           `protobuf:"varint,4,opt,name=expiry_block_height,json=expiryBlockHeight,proto3" json:"expiry_block_height,omitempty"`
+}
+
+func (x *CreateProposalParam) Reset() {
+	*x = CreateProposalParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProposalParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProposalParam) ProtoMessage() {}
+
+func (x *CreateProposalParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProposalParam.ProtoReflect.Descriptor instead.
+func (*CreateProposalParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateProposalParam) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *CreateProposalParam) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreateProposalParam) GetParams() *structpb.Value {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *CreateProposalParam) GetExpiryBlockHeight() int64 {
+	if x != nil {
+		return x.ExpiryBlockHeight
+	}
+	return 0
+}
+
+type ApproveProposalParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId string `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *ApproveProposalParam) Reset() {
+	*x = ApproveProposalParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveProposalParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProposalParam) ProtoMessage() {}
+
+func (x *ApproveProposalParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProposalParam.ProtoReflect.Descriptor instead.
+func (*ApproveProposalParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ApproveProposalParam) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type CancelScheduledChangeParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId int64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (x *CancelScheduledChangeParam) Reset() {
+	*x = CancelScheduledChangeParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledChangeParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledChangeParam) ProtoMessage() {}
+
+func (x *CancelScheduledChangeParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledChangeParam.ProtoReflect.Descriptor instead.
+func (*CancelScheduledChangeParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CancelScheduledChangeParam) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+type PauseMethodParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method      string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MethodGroup string   `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
+	Roles       []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	NodeIdList  []string `protobuf:"bytes,4,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	Reason      string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PauseMethodParam) Reset() {
+	*x = PauseMethodParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseMethodParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMethodParam) ProtoMessage() {}
+
+func (x *PauseMethodParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMethodParam.ProtoReflect.Descriptor instead.
+func (*PauseMethodParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PauseMethodParam) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PauseMethodParam) GetMethodGroup() string {
+	if x != nil {
+		return x.MethodGroup
+	}
+	return ""
+}
+
+func (x *PauseMethodParam) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PauseMethodParam) GetNodeIdList() []string {
+	if x != nil {
+		return x.NodeIdList
+	}
+	return nil
+}
+
+func (x *PauseMethodParam) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResumeMethodParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method      string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MethodGroup string `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
+}
+
+func (x *ResumeMethodParam) Reset() {
+	*x = ResumeMethodParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeMethodParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeMethodParam) ProtoMessage() {}
+
+func (x *ResumeMethodParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeMethodParam.ProtoReflect.Descriptor instead.
+func (*ResumeMethodParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ResumeMethodParam) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ResumeMethodParam) GetMethodGroup() string {
+	if x != nil {
+		return x.MethodGroup
+	}
+	return ""
+}
+
+type SetUpgradePlanParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProtocolVersion  uint64 `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (x *SetUpgradePlanParam) Reset() {
+	*x = SetUpgradePlanParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUpgradePlanParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUpgradePlanParam) ProtoMessage() {}
+
+func (x *SetUpgradePlanParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUpgradePlanParam.ProtoReflect.Descriptor instead.
+func (*SetUpgradePlanParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetUpgradePlanParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetUpgradePlanParam) GetProtocolVersion() uint64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *SetUpgradePlanParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+type SetParameterParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value               *structpb.Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ActivationHeight    int64           `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime int64           `protobuf:"varint,4,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+}
+
+func (x *SetParameterParam) Reset() {
+	*x = SetParameterParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetParameterParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParameterParam) ProtoMessage() {}
+
+func (x *SetParameterParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParameterParam.ProtoReflect.Descriptor instead.
+func (*SetParameterParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetParameterParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetParameterParam) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetParameterParam) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *SetParameterParam) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+type UpdateIdentityModeListParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode     string  `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+	IdentityNamespace      string  `protobuf:"bytes,2,opt,name=identity_namespace,json=identityNamespace,proto3" json:"identity_namespace,omitempty"`
+	IdentityIdentifierHash string  `protobuf:"bytes,3,opt,name=identity_identifier_hash,json=identityIdentifierHash,proto3" json:"identity_identifier_hash,omitempty"`
+	ModeList               []int32 `protobuf:"varint,4,rep,packed,name=mode_list,json=modeList,proto3" json:"mode_list,omitempty"`
+	RequestId              string  `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *UpdateIdentityModeListParam) Reset() {
+	*x = UpdateIdentityModeListParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIdentityModeListParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentityModeListParam) ProtoMessage() {}
+
+func (x *UpdateIdentityModeListParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentityModeListParam.ProtoReflect.Descriptor instead.
+func (*UpdateIdentityModeListParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateIdentityModeListParam) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *UpdateIdentityModeListParam) GetIdentityNamespace() string {
+	if x != nil {
+		return x.IdentityNamespace
+	}
+	return ""
+}
+
+func (x *UpdateIdentityModeListParam) GetIdentityIdentifierHash() string {
+	if x != nil {
+		return x.IdentityIdentifierHash
+	}
+	return ""
+}
+
+func (x *UpdateIdentityModeListParam) GetModeList() []int32 {
+	if x != nil {
+		return x.ModeList
+	}
+	return nil
+}
+
+func (x *UpdateIdentityModeListParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RegisterServiceDestinationParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinAal                 float64  `protobuf:"fixed64,1,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	MinIal                 float64  `protobuf:"fixed64,2,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	ServiceId              string   `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	SupportedNamespaceList []string `protobuf:"bytes,4,rep,name=supported_namespace_list,json=supportedNamespaceList,proto3" json:"supported_namespace_list,omitempty"`
+}
+
+func (x *RegisterServiceDestinationParam) Reset() {
+	*x = RegisterServiceDestinationParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterServiceDestinationParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterServiceDestinationParam) ProtoMessage() {}
+
+func (x *RegisterServiceDestinationParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterServiceDestinationParam.ProtoReflect.Descriptor instead.
+func (*RegisterServiceDestinationParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RegisterServiceDestinationParam) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *RegisterServiceDestinationParam) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *RegisterServiceDestinationParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *RegisterServiceDestinationParam) GetSupportedNamespaceList() []string {
+	if x != nil {
+		return x.SupportedNamespaceList
+	}
+	return nil
+}
+
+type UpdateServiceDestinationParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId              string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	MinIal                 float64  `protobuf:"fixed64,2,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	MinAal                 float64  `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	SupportedNamespaceList []string `protobuf:"bytes,4,rep,name=supported_namespace_list,json=supportedNamespaceList,proto3" json:"supported_namespace_list,omitempty"`
+}
+
+func (x *UpdateServiceDestinationParam) Reset() {
+	*x = UpdateServiceDestinationParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateServiceDestinationParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceDestinationParam) ProtoMessage() {}
+
+func (x *UpdateServiceDestinationParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceDestinationParam.ProtoReflect.Descriptor instead.
+func (*UpdateServiceDestinationParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateServiceDestinationParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *UpdateServiceDestinationParam) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *UpdateServiceDestinationParam) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *UpdateServiceDestinationParam) GetSupportedNamespaceList() []string {
+	if x != nil {
+		return x.SupportedNamespaceList
+	}
+	return nil
+}
+
+type DisableServiceDestinationParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (x *DisableServiceDestinationParam) Reset() {
+	*x = DisableServiceDestinationParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableServiceDestinationParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableServiceDestinationParam) ProtoMessage() {}
+
+func (x *DisableServiceDestinationParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableServiceDestinationParam.ProtoReflect.Descriptor instead.
+func (*DisableServiceDestinationParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DisableServiceDestinationParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type EnableServiceDestinationParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+}
+
+func (x *EnableServiceDestinationParam) Reset() {
+	*x = EnableServiceDestinationParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableServiceDestinationParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableServiceDestinationParam) ProtoMessage() {}
+
+func (x *EnableServiceDestinationParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableServiceDestinationParam.ProtoReflect.Descriptor instead.
+func (*EnableServiceDestinationParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *EnableServiceDestinationParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type ServicePriceByCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinPrice float64 `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice float64 `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+}
+
+func (x *ServicePriceByCurrency) Reset() {
+	*x = ServicePriceByCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePriceByCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePriceByCurrency) ProtoMessage() {}
+
+func (x *ServicePriceByCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePriceByCurrency.ProtoReflect.Descriptor instead.
+func (*ServicePriceByCurrency) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ServicePriceByCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ServicePriceByCurrency) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ServicePriceByCurrency) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+type SetServicePriceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId           string                    `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PriceByCurrencyList []*ServicePriceByCurrency `protobuf:"bytes,2,rep,name=price_by_currency_list,json=priceByCurrencyList,proto3" json:"price_by_currency_list,omitempty"`
+	EffectiveDatetime   string                    `protobuf:"bytes,3,opt,name=effective_datetime,json=effectiveDatetime,proto3" json:"effective_datetime,omitempty"`
+	MoreInfoUrl         string                    `protobuf:"bytes,4,opt,name=more_info_url,json=moreInfoUrl,proto3" json:"more_info_url,omitempty"`
+	Detail              string                    `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *SetServicePriceParam) Reset() {
+	*x = SetServicePriceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetServicePriceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetServicePriceParam) ProtoMessage() {}
+
+func (x *SetServicePriceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetServicePriceParam.ProtoReflect.Descriptor instead.
+func (*SetServicePriceParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetServicePriceParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SetServicePriceParam) GetPriceByCurrencyList() []*ServicePriceByCurrency {
+	if x != nil {
+		return x.PriceByCurrencyList
+	}
+	return nil
+}
+
+func (x *SetServicePriceParam) GetEffectiveDatetime() string {
+	if x != nil {
+		return x.EffectiveDatetime
+	}
+	return ""
+}
+
+func (x *SetServicePriceParam) GetMoreInfoUrl() string {
+	if x != nil {
+		return x.MoreInfoUrl
+	}
+	return ""
+}
+
+func (x *SetServicePriceParam) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ASResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsId         string `protobuf:"bytes,1,opt,name=as_id,json=asId,proto3" json:"as_id,omitempty"`
+	Signed       *bool  `protobuf:"varint,2,opt,name=signed,proto3,oneof" json:"signed,omitempty"`
+	ReceivedData *bool  `protobuf:"varint,3,opt,name=received_data,json=receivedData,proto3,oneof" json:"received_data,omitempty"`
+	ErrorCode    *int32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+}
+
+func (x *ASResponse) Reset() {
+	*x = ASResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ASResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASResponse) ProtoMessage() {}
+
+func (x *ASResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASResponse.ProtoReflect.Descriptor instead.
+func (*ASResponse) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ASResponse) GetAsId() string {
+	if x != nil {
+		return x.AsId
+	}
+	return ""
+}
+
+func (x *ASResponse) GetSigned() bool {
+	if x != nil && x.Signed != nil {
+		return *x.Signed
+	}
+	return false
+}
+
+func (x *ASResponse) GetReceivedData() bool {
+	if x != nil && x.ReceivedData != nil {
+		return *x.ReceivedData
+	}
+	return false
+}
+
+func (x *ASResponse) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+type DataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId         string        `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AsIdList          []string      `protobuf:"bytes,2,rep,name=as_id_list,json=asIdList,proto3" json:"as_id_list,omitempty"`
+	MinAs             int64         `protobuf:"varint,3,opt,name=min_as,json=minAs,proto3" json:"min_as,omitempty"`
+	RequestParamsHash string        `protobuf:"bytes,4,opt,name=request_params_hash,json=requestParamsHash,proto3" json:"request_params_hash,omitempty"`
+	ResponseList      []*ASResponse `protobuf:"bytes,5,rep,name=response_list,json=responseList,proto3" json:"response_list,omitempty"`
+}
+
+func (x *DataRequest) Reset() {
+	*x = DataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequest) ProtoMessage() {}
+
+func (x *DataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequest.ProtoReflect.Descriptor instead.
+func (*DataRequest) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DataRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DataRequest) GetAsIdList() []string {
+	if x != nil {
+		return x.AsIdList
+	}
+	return nil
+}
+
+func (x *DataRequest) GetMinAs() int64 {
+	if x != nil {
+		return x.MinAs
+	}
+	return 0
+}
+
+func (x *DataRequest) GetRequestParamsHash() string {
+	if x != nil {
+		return x.RequestParamsHash
+	}
+	return ""
+}
+
+func (x *DataRequest) GetResponseList() []*ASResponse {
+	if x != nil {
+		return x.ResponseList
+	}
+	return nil
+}
+
+type CreateRequestParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId          string         `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinIdp             int64          `protobuf:"varint,2,opt,name=min_idp,json=minIdp,proto3" json:"min_idp,omitempty"`
+	MinAal             float64        `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	MinIal             float64        `protobuf:"fixed64,4,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	RequestTimeout     int64          `protobuf:"varint,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	IdpIdList          []string       `protobuf:"bytes,6,rep,name=idp_id_list,json=idpIdList,proto3" json:"idp_id_list,omitempty"`
+	DataRequestList    []*DataRequest `protobuf:"bytes,7,rep,name=data_request_list,json=dataRequestList,proto3" json:"data_request_list,omitempty"`
+	RequestMessageHash string         `protobuf:"bytes,8,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
+	Purpose            string         `protobuf:"bytes,9,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Mode               int32          `protobuf:"varint,10,opt,name=mode,proto3" json:"mode,omitempty"`
+	RequestType        *string        `protobuf:"bytes,11,opt,name=request_type,json=requestType,proto3,oneof" json:"request_type,omitempty"`
+}
+
+func (x *CreateRequestParam) Reset() {
+	*x = CreateRequestParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRequestParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequestParam) ProtoMessage() {}
+
+func (x *CreateRequestParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequestParam.ProtoReflect.Descriptor instead.
+func (*CreateRequestParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateRequestParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateRequestParam) GetMinIdp() int64 {
+	if x != nil {
+		return x.MinIdp
+	}
+	return 0
+}
+
+func (x *CreateRequestParam) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *CreateRequestParam) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *CreateRequestParam) GetRequestTimeout() int64 {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return 0
+}
+
+func (x *CreateRequestParam) GetIdpIdList() []string {
+	if x != nil {
+		return x.IdpIdList
+	}
+	return nil
+}
+
+func (x *CreateRequestParam) GetDataRequestList() []*DataRequest {
+	if x != nil {
+		return x.DataRequestList
+	}
+	return nil
+}
+
+func (x *CreateRequestParam) GetRequestMessageHash() string {
+	if x != nil {
+		return x.RequestMessageHash
+	}
+	return ""
+}
+
+func (x *CreateRequestParam) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CreateRequestParam) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *CreateRequestParam) GetRequestType() string {
+	if x != nil && x.RequestType != nil {
+		return *x.RequestType
+	}
+	return ""
+}
+
+type CreateIdpResponseParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aal       float64 `protobuf:"fixed64,1,opt,name=aal,proto3" json:"aal,omitempty"`
+	Ial       float64 `protobuf:"fixed64,2,opt,name=ial,proto3" json:"ial,omitempty"`
+	RequestId string  `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Signature string  `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Status    string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorCode *int32  `protobuf:"varint,6,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+}
+
+func (x *CreateIdpResponseParam) Reset() {
+	*x = CreateIdpResponseParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIdpResponseParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIdpResponseParam) ProtoMessage() {}
+
+func (x *CreateIdpResponseParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIdpResponseParam.ProtoReflect.Descriptor instead.
+func (*CreateIdpResponseParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreateIdpResponseParam) GetAal() float64 {
+	if x != nil {
+		return x.Aal
+	}
+	return 0
+}
+
+func (x *CreateIdpResponseParam) GetIal() float64 {
+	if x != nil {
+		return x.Ial
+	}
+	return 0
+}
+
+func (x *CreateIdpResponseParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateIdpResponseParam) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *CreateIdpResponseParam) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateIdpResponseParam) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+type CreateAsResponseParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	ErrorCode *int32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+}
+
+func (x *CreateAsResponseParam) Reset() {
+	*x = CreateAsResponseParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAsResponseParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAsResponseParam) ProtoMessage() {}
+
+func (x *CreateAsResponseParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAsResponseParam.ProtoReflect.Descriptor instead.
+func (*CreateAsResponseParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateAsResponseParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CreateAsResponseParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateAsResponseParam) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *CreateAsResponseParam) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+type SetDataReceivedParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AsId      string `protobuf:"bytes,3,opt,name=as_id,json=asId,proto3" json:"as_id,omitempty"`
+}
+
+func (x *SetDataReceivedParam) Reset() {
+	*x = SetDataReceivedParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDataReceivedParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDataReceivedParam) ProtoMessage() {}
+
+func (x *SetDataReceivedParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDataReceivedParam.ProtoReflect.Descriptor instead.
+func (*SetDataReceivedParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetDataReceivedParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SetDataReceivedParam) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *SetDataReceivedParam) GetAsId() string {
+	if x != nil {
+		return x.AsId
+	}
+	return ""
+}
+
+type ResponseValid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdpId          string `protobuf:"bytes,1,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	ValidIal       *bool  `protobuf:"varint,2,opt,name=valid_ial,json=validIal,proto3,oneof" json:"valid_ial,omitempty"`
+	ValidSignature *bool  `protobuf:"varint,3,opt,name=valid_signature,json=validSignature,proto3,oneof" json:"valid_signature,omitempty"`
+}
+
+func (x *ResponseValid) Reset() {
+	*x = ResponseValid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseValid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseValid) ProtoMessage() {}
+
+func (x *ResponseValid) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseValid.ProtoReflect.Descriptor instead.
+func (*ResponseValid) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ResponseValid) GetIdpId() string {
+	if x != nil {
+		return x.IdpId
+	}
+	return ""
+}
+
+func (x *ResponseValid) GetValidIal() bool {
+	if x != nil && x.ValidIal != nil {
+		return *x.ValidIal
+	}
+	return false
+}
+
+func (x *ResponseValid) GetValidSignature() bool {
+	if x != nil && x.ValidSignature != nil {
+		return *x.ValidSignature
+	}
+	return false
+}
+
+type CloseRequestParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId         string           `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ResponseValidList []*ResponseValid `protobuf:"bytes,2,rep,name=response_valid_list,json=responseValidList,proto3" json:"response_valid_list,omitempty"`
+}
+
+func (x *CloseRequestParam) Reset() {
+	*x = CloseRequestParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseRequestParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRequestParam) ProtoMessage() {}
+
+func (x *CloseRequestParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRequestParam.ProtoReflect.Descriptor instead.
+func (*CloseRequestParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CloseRequestParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CloseRequestParam) GetResponseValidList() []*ResponseValid {
+	if x != nil {
+		return x.ResponseValidList
+	}
+	return nil
+}
+
+type TimeOutRequestParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId         string           `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ResponseValidList []*ResponseValid `protobuf:"bytes,2,rep,name=response_valid_list,json=responseValidList,proto3" json:"response_valid_list,omitempty"`
+}
+
+func (x *TimeOutRequestParam) Reset() {
+	*x = TimeOutRequestParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeOutRequestParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOutRequestParam) ProtoMessage() {}
+
+func (x *TimeOutRequestParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOutRequestParam.ProtoReflect.Descriptor instead.
+func (*TimeOutRequestParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TimeOutRequestParam) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TimeOutRequestParam) GetResponseValidList() []*ResponseValid {
+	if x != nil {
+		return x.ResponseValidList
+	}
+	return nil
+}
+
+type CreateMessageParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Purpose   string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *CreateMessageParam) Reset() {
+	*x = CreateMessageParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_param_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMessageParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMessageParam) ProtoMessage() {}
+
+func (x *CreateMessageParam) ProtoReflect() protoreflect.Message {
+	mi := &file_param_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMessageParam.ProtoReflect.Descriptor instead.
+func (*CreateMessageParam) Descriptor() ([]byte, []int) {
+	return file_param_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateMessageParam) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *CreateMessageParam) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateMessageParam) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+var File_param_proto protoreflect.FileDescriptor
+
+var file_param_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76,
+	0x39, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x4b, 0x65,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6b, 0x76, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x8d,
+	0x03, 0x0a, 0x0d, 0x49, 0x6e, 0x69, 0x74, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a,
+	0x14, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x0e,
+	0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x36,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f,
+	0x6e, 0x69, 0x6b, 0x65, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x75, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbe, 0x05, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x39, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x41, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x18, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x15, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2f, 0x0a, 0x11,
+	0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x65, 0x67, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
+	0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x48, 0x61,
+	0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x75, 0x72, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x22, 0xb7, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x49, 0x61,
+	0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x18, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x15, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22,
+	0x2b, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0f,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6f, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x37, 0x0a, 0x1c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x14, 0x52, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x44, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x23, 0x41, 0x64, 0x64, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x26, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x93, 0x03, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x5b, 0x0a, 0x2b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x26, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x68, 0x0a, 0x32, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x2c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x2b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x26, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x68, 0x0a, 0x32, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x2c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xae, 0x01, 0x0a,
+	0x32, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x49, 0x61,
+	0x6c, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x41, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x70, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xad, 0x01,
+	0x0a, 0x24, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a,
+	0x36, 0x41, 0x64, 0x64, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x22, 0x54, 0x0a, 0x39, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a,
+	0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x95,
+	0x01, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x02,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x25, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x24, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x23, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x2d, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xa9, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x49, 0x41, 0x4c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x49, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x41, 0x4c, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0xe6, 0x03, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x39, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x5c, 0x0a, 0x2c, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72,
+	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x26, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x0a, 0x4d, 0x73,
+	0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x1b, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x74, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d,
+	0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x3c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x4d, 0x73, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x73, 0x0a,
+	0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x98, 0x04, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x64, 0x69, 0x64,
+	0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x69,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x61, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x34,
+	0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x12, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6c, 0x61, 0x61, 0x6c, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x15, 0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x03, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x69, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x04, 0x6c, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x61,
+	0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c,
+	0x61, 0x61, 0x6c, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x11, 0x6e, 0x65,
+	0x77, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x22, 0xda, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0xbf, 0x03, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0xdf, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6e, 0x64,
+	0x41, 0x64, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x13,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x1f, 0x42,
+	0x75, 0x6c, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x44, 0x49, 0x44, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x76, 0x39, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x19, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xae, 0x01,
+	0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x50, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x44,
+	0x0a, 0x21, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x50, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x27, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x50, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x44, 0x0a, 0x21, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x64, 0x50, 0x41, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x20, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x13, 0x0a, 0x05, 0x63, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x22, 0x3a, 0x0a, 0x23, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x13, 0x0a, 0x05, 0x63,
+	0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x49, 0x64,
+	0x22, 0x30, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x22, 0xdb, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x16,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61,
+	0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x19, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x22, 0x4d, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0x67, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x37, 0x0a, 0x14, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0x39, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x9d, 0x01,
+	0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4e, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x81, 0x01,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xb6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xac, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41, 0x61, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0xaa, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x41, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x3f, 0x0a,
+	0x1e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x3e,
+	0x0a, 0x1d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6e,
+	0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x79,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x81,
+	0x02, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x62, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x22, 0xb8, 0x01, 0x0a, 0x0a, 0x41, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x02, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xd6, 0x01,
+	0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a,
+	0x61, 0x73, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x73, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x69,
+	0x6e, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x41,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x41, 0x53,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xad, 0x03, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x49, 0x64, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41, 0x61, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x64, 0x70, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64, 0x70, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x4b, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x61, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa6, 0x01,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x69, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05,
+	0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x49,
+	0x64, 0x22, 0x98, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x64, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a,
+	0x11, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x51, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x76, 0x39, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x52, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x13, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x11, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x67,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x3b, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x39,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_param_proto_rawDescOnce sync.Once
+	file_param_proto_rawDescData = file_param_proto_rawDesc
+)
+
+func file_param_proto_rawDescGZIP() []byte {
+	file_param_proto_rawDescOnce.Do(func() {
+		file_param_proto_rawDescData = protoimpl.X.CompressGZIP(file_param_proto_rawDescData)
+	})
+	return file_param_proto_rawDescData
+}
+
+var file_param_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
+var file_param_proto_goTypes = []interface{}{
+	(*KeyValue)(nil),                                                  // 0: ndid_abci_param_v9.KeyValue
+	(*SetInitDataParam)(nil),                                          // 1: ndid_abci_param_v9.SetInitDataParam
+	(*InitNDIDParam)(nil),                                             // 2: ndid_abci_param_v9.InitNDIDParam
+	(*EndInitParam)(nil),                                              // 3: ndid_abci_param_v9.EndInitParam
+	(*SetLastBlockParam)(nil),                                         // 4: ndid_abci_param_v9.SetLastBlockParam
+	(*SetValidatorParam)(nil),                                         // 5: ndid_abci_param_v9.SetValidatorParam
+	(*SetPriceFuncParam)(nil),                                         // 6: ndid_abci_param_v9.SetPriceFuncParam
+	(*RegisterNodeParam)(nil),                                         // 7: ndid_abci_param_v9.RegisterNodeParam
+	(*NodeProfileParam)(nil),                                          // 8: ndid_abci_param_v9.NodeProfileParam
+	(*UpdateNodeByNDIDParam)(nil),                                     // 9: ndid_abci_param_v9.UpdateNodeByNDIDParam
+	(*DisableNodeParam)(nil),                                          // 10: ndid_abci_param_v9.DisableNodeParam
+	(*EnableNodeParam)(nil),                                           // 11: ndid_abci_param_v9.EnableNodeParam
+	(*AddNodeToProxyNodeParam)(nil),                                   // 12: ndid_abci_param_v9.AddNodeToProxyNodeParam
+	(*UpdateNodeProxyNodeParam)(nil),                                  // 13: ndid_abci_param_v9.UpdateNodeProxyNodeParam
+	(*RemoveNodeFromProxyNodeParam)(nil),                              // 14: ndid_abci_param_v9.RemoveNodeFromProxyNodeParam
+	(*AddNodeTokenParam)(nil),                                         // 15: ndid_abci_param_v9.AddNodeTokenParam
+	(*ReduceNodeTokenParam)(nil),                                      // 16: ndid_abci_param_v9.ReduceNodeTokenParam
+	(*SetNodeTokenParam)(nil),                                         // 17: ndid_abci_param_v9.SetNodeTokenParam
+	(*AddAllowedNodeSupportedFeatureParam)(nil),                       // 18: ndid_abci_param_v9.AddAllowedNodeSupportedFeatureParam
+	(*RemoveAllowedNodeSupportedFeatureParam)(nil),                    // 19: ndid_abci_param_v9.RemoveAllowedNodeSupportedFeatureParam
+	(*AddNamespaceParam)(nil),                                         // 20: ndid_abci_param_v9.AddNamespaceParam
+	(*DisableNamespaceParam)(nil),                                     // 21: ndid_abci_param_v9.DisableNamespaceParam
+	(*EnableNamespaceParam)(nil),                                      // 22: ndid_abci_param_v9.EnableNamespaceParam
+	(*UpdateNamespaceParam)(nil),                                      // 23: ndid_abci_param_v9.UpdateNamespaceParam
+	(*SetAllowedMinIalForRegisterIdentityAtFirstIdpParam)(nil),        // 24: ndid_abci_param_v9.SetAllowedMinIalForRegisterIdentityAtFirstIdpParam
+	(*SetTimeOutBlockRegisterIdentityParam)(nil),                      // 25: ndid_abci_param_v9.SetTimeOutBlockRegisterIdentityParam
+	(*AddSuppressedIdentityModificationNotificationNodeParam)(nil),    // 26: ndid_abci_param_v9.AddSuppressedIdentityModificationNotificationNodeParam
+	(*RemoveSuppressedIdentityModificationNotificationNodeParam)(nil), // 27: ndid_abci_param_v9.RemoveSuppressedIdentityModificationNotificationNodeParam
+	(*AddServiceParam)(nil),                                           // 28: ndid_abci_param_v9.AddServiceParam
+	(*DisableServiceParam)(nil),                                       // 29: ndid_abci_param_v9.DisableServiceParam
+	(*EnableServiceParam)(nil),                                        // 30: ndid_abci_param_v9.EnableServiceParam
+	(*UpdateServiceParam)(nil),                                        // 31: ndid_abci_param_v9.UpdateServiceParam
+	(*RegisterServiceDestinationByNDIDParam)(nil),                     // 32: ndid_abci_param_v9.RegisterServiceDestinationByNDIDParam
+	(*DisableServiceDestinationByNDIDParam)(nil),                      // 33: ndid_abci_param_v9.DisableServiceDestinationByNDIDParam
+	(*EnableServiceDestinationByNDIDParam)(nil),                       // 34: ndid_abci_param_v9.EnableServiceDestinationByNDIDParam
+	(*PriceCeilingByCurrency)(nil),                                    // 35: ndid_abci_param_v9.PriceCeilingByCurrency
+	(*SetServicePriceCeilingParam)(nil),                               // 36: ndid_abci_param_v9.SetServicePriceCeilingParam
+	(*SetServicePriceMinEffectiveDatetimeDelayParam)(nil),             // 37: ndid_abci_param_v9.SetServicePriceMinEffectiveDatetimeDelayParam
+	(*SetSupportedIALListParam)(nil),                                  // 38: ndid_abci_param_v9.SetSupportedIALListParam
+	(*SetSupportedAALListParam)(nil),                                  // 39: ndid_abci_param_v9.SetSupportedAALListParam
+	(*SetAllowedModeListParam)(nil),                                   // 40: ndid_abci_param_v9.SetAllowedModeListParam
+	(*AddRequestTypeParam)(nil),                                       // 41: ndid_abci_param_v9.AddRequestTypeParam
+	(*RemoveRequestTypeParam)(nil),                                    // 42: ndid_abci_param_v9.RemoveRequestTypeParam
+	(*AddErrorCodeParam)(nil),                                         // 43: ndid_abci_param_v9.AddErrorCodeParam
+	(*RemoveErrorCodeParam)(nil),                                      // 44: ndid_abci_param_v9.RemoveErrorCodeParam
+	(*UpdateNodeParam)(nil),                                           // 45: ndid_abci_param_v9.UpdateNodeParam
+	(*MsqAddress)(nil),                                                // 46: ndid_abci_param_v9.MsqAddress
+	(*SetMqAddressesParam)(nil),                                       // 47: ndid_abci_param_v9.SetMqAddressesParam
+	(*Identity)(nil),                                                  // 48: ndid_abci_param_v9.Identity
+	(*RegisterIdentityParam)(nil),                                     // 49: ndid_abci_param_v9.RegisterIdentityParam
+	(*UpdateIdentityParam)(nil),                                       // 50: ndid_abci_param_v9.UpdateIdentityParam
+	(*AddIdentityParam)(nil),                                          // 51: ndid_abci_param_v9.AddIdentityParam
+	(*RevokeIdentityAssociationParam)(nil),                            // 52: ndid_abci_param_v9.RevokeIdentityAssociationParam
+	(*AddAccessorParam)(nil),                                          // 53: ndid_abci_param_v9.AddAccessorParam
+	(*RevokeAccessorParam)(nil),                                       // 54: ndid_abci_param_v9.RevokeAccessorParam
+	(*RevokeAndAddAccessorParam)(nil),                                 // 55: ndid_abci_param_v9.RevokeAndAddAccessorParam
+	(*RenewAccessorParam)(nil),                                        // 56: ndid_abci_param_v9.RenewAccessorParam
+	(*BulkRegisterIdentityByNDIDParam)(nil),                           // 57: ndid_abci_param_v9.BulkRegisterIdentityByNDIDParam
+	(*FreezeReferenceGroupParam)(nil),                                 // 58: ndid_abci_param_v9.FreezeReferenceGroupParam
+	(*UnfreezeReferenceGroupParam)(nil),                               // 59: ndid_abci_param_v9.UnfreezeReferenceGroupParam
+	(*TransferIdPAssociationsParam)(nil),                              // 60: ndid_abci_param_v9.TransferIdPAssociationsParam
+	(*AcceptIdPAssociationTransferParam)(nil),                         // 61: ndid_abci_param_v9.AcceptIdPAssociationTransferParam
+	(*ProcessIdPAssociationTransferBatchParam)(nil),                   // 62: ndid_abci_param_v9.ProcessIdPAssociationTransferBatchParam
+	(*CancelIdPAssociationTransferParam)(nil),                         // 63: ndid_abci_param_v9.CancelIdPAssociationTransferParam
+	(*AddNodeCertificateAuthorityParam)(nil),                          // 64: ndid_abci_param_v9.AddNodeCertificateAuthorityParam
+	(*RemoveNodeCertificateAuthorityParam)(nil),                       // 65: ndid_abci_param_v9.RemoveNodeCertificateAuthorityParam
+	(*DecommissionNodeParam)(nil),                                     // 66: ndid_abci_param_v9.DecommissionNodeParam
+	(*HeartbeatParam)(nil),                                            // 67: ndid_abci_param_v9.HeartbeatParam
+	(*SetValidatorPowerCapParam)(nil),                                 // 68: ndid_abci_param_v9.SetValidatorPowerCapParam
+	(*GrantAdminPermissionParam)(nil),                                 // 69: ndid_abci_param_v9.GrantAdminPermissionParam
+	(*RevokeAdminPermissionParam)(nil),                                // 70: ndid_abci_param_v9.RevokeAdminPermissionParam
+	(*SetGovernanceConfigParam)(nil),                                  // 71: ndid_abci_param_v9.SetGovernanceConfigParam
+	(*CreateProposalParam)(nil),                                       // 72: ndid_abci_param_v9.CreateProposalParam
+	(*ApproveProposalParam)(nil),                                      // 73: ndid_abci_param_v9.ApproveProposalParam
+	(*CancelScheduledChangeParam)(nil),                                // 74: ndid_abci_param_v9.CancelScheduledChangeParam
+	(*PauseMethodParam)(nil),                                          // 75: ndid_abci_param_v9.PauseMethodParam
+	(*ResumeMethodParam)(nil),                                         // 76: ndid_abci_param_v9.ResumeMethodParam
+	(*SetUpgradePlanParam)(nil),                                       // 77: ndid_abci_param_v9.SetUpgradePlanParam
+	(*SetParameterParam)(nil),                                         // 78: ndid_abci_param_v9.SetParameterParam
+	(*UpdateIdentityModeListParam)(nil),                               // 79: ndid_abci_param_v9.UpdateIdentityModeListParam
+	(*RegisterServiceDestinationParam)(nil),                           // 80: ndid_abci_param_v9.RegisterServiceDestinationParam
+	(*UpdateServiceDestinationParam)(nil),                             // 81: ndid_abci_param_v9.UpdateServiceDestinationParam
+	(*DisableServiceDestinationParam)(nil),                            // 82: ndid_abci_param_v9.DisableServiceDestinationParam
+	(*EnableServiceDestinationParam)(nil),                             // 83: ndid_abci_param_v9.EnableServiceDestinationParam
+	(*ServicePriceByCurrency)(nil),                                    // 84: ndid_abci_param_v9.ServicePriceByCurrency
+	(*SetServicePriceParam)(nil),                                      // 85: ndid_abci_param_v9.SetServicePriceParam
+	(*ASResponse)(nil),                                                // 86: ndid_abci_param_v9.ASResponse
+	(*DataRequest)(nil),                                               // 87: ndid_abci_param_v9.DataRequest
+	(*CreateRequestParam)(nil),                                        // 88: ndid_abci_param_v9.CreateRequestParam
+	(*CreateIdpResponseParam)(nil),                                    // 89: ndid_abci_param_v9.CreateIdpResponseParam
+	(*CreateAsResponseParam)(nil),                                     // 90: ndid_abci_param_v9.CreateAsResponseParam
+	(*SetDataReceivedParam)(nil),                                      // 91: ndid_abci_param_v9.SetDataReceivedParam
+	(*ResponseValid)(nil),                                             // 92: ndid_abci_param_v9.ResponseValid
+	(*CloseRequestParam)(nil),                                         // 93: ndid_abci_param_v9.CloseRequestParam
+	(*TimeOutRequestParam)(nil),                                       // 94: ndid_abci_param_v9.TimeOutRequestParam
+	(*CreateMessageParam)(nil),                                        // 95: ndid_abci_param_v9.CreateMessageParam
+	(*structpb.Value)(nil),                                            // 96: google.protobuf.Value
+}
+var file_param_proto_depIdxs = []int32{
+	0,  // 0: ndid_abci_param_v9.SetInitDataParam.kv_list:type_name -> ndid_abci_param_v9.KeyValue
+	8,  // 1: ndid_abci_param_v9.UpdateNodeByNDIDParam.profile:type_name -> ndid_abci_param_v9.NodeProfileParam
+	35, // 2: ndid_abci_param_v9.SetServicePriceCeilingParam.price_ceiling_by_currency_list:type_name -> ndid_abci_param_v9.PriceCeilingByCurrency
+	8,  // 3: ndid_abci_param_v9.UpdateNodeParam.profile:type_name -> ndid_abci_param_v9.NodeProfileParam
+	46, // 4: ndid_abci_param_v9.SetMqAddressesParam.addresses:type_name -> ndid_abci_param_v9.MsqAddress
+	48, // 5: ndid_abci_param_v9.RegisterIdentityParam.new_identity_list:type_name -> ndid_abci_param_v9.Identity
+	48, // 6: ndid_abci_param_v9.AddIdentityParam.new_identity_list:type_name -> ndid_abci_param_v9.Identity
+	49, // 7: ndid_abci_param_v9.BulkRegisterIdentityByNDIDParam.identity_list:type_name -> ndid_abci_param_v9.RegisterIdentityParam
+	96, // 8: ndid_abci_param_v9.CreateProposalParam.params:type_name -> google.protobuf.Value
+	96, // 9: ndid_abci_param_v9.SetParameterParam.value:type_name -> google.protobuf.Value
+	84, // 10: ndid_abci_param_v9.SetServicePriceParam.price_by_currency_list:type_name -> ndid_abci_param_v9.ServicePriceByCurrency
+	86, // 11: ndid_abci_param_v9.DataRequest.response_list:type_name -> ndid_abci_param_v9.ASResponse
+	87, // 12: ndid_abci_param_v9.CreateRequestParam.data_request_list:type_name -> ndid_abci_param_v9.DataRequest
+	92, // 13: ndid_abci_param_v9.CloseRequestParam.response_valid_list:type_name -> ndid_abci_param_v9.ResponseValid
+	92, // 14: ndid_abci_param_v9.TimeOutRequestParam.response_valid_list:type_name -> ndid_abci_param_v9.ResponseValid
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_param_proto_init() }
+func file_param_proto_init() {
+	if File_param_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_param_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInitDataParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndInitParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastBlockParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetValidatorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPriceFuncParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeProfileParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNodeByNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeToProxyNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNodeProxyNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveNodeFromProxyNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeTokenParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReduceNodeTokenParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodeTokenParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAllowedNodeSupportedFeatureParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAllowedNodeSupportedFeatureParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNamespaceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableNamespaceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableNamespaceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNamespaceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowedMinIalForRegisterIdentityAtFirstIdpParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTimeOutBlockRegisterIdentityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSuppressedIdentityModificationNotificationNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSuppressedIdentityModificationNotificationNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddServiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableServiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableServiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterServiceDestinationByNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableServiceDestinationByNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableServiceDestinationByNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceCeilingByCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServicePriceCeilingParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServicePriceMinEffectiveDatetimeDelayParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSupportedIALListParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSupportedAALListParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowedModeListParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRequestTypeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveRequestTypeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddErrorCodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveErrorCodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsqAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMqAddressesParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterIdentityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIdentityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIdentityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeIdentityAssociationParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAccessorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAccessorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAndAddAccessorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewAccessorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkRegisterIdentityByNDIDParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeReferenceGroupParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeReferenceGroupParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferIdPAssociationsParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptIdPAssociationTransferParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessIdPAssociationTransferBatchParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelIdPAssociationTransferParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNodeCertificateAuthorityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveNodeCertificateAuthorityParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecommissionNodeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetValidatorPowerCapParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantAdminPermissionParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAdminPermissionParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGovernanceConfigParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProposalParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveProposalParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledChangeParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseMethodParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeMethodParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUpgradePlanParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParameterParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIdentityModeListParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterServiceDestinationParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceDestinationParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableServiceDestinationParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableServiceDestinationParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePriceByCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServicePriceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ASResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRequestParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIdpResponseParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAsResponseParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDataReceivedParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseValid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRequestParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeOutRequestParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_param_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMessageParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -197,13 +8830,26 @@ func file_param_proto_init() {
 			}
 		}
 	}
+	file_param_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[55].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[86].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[88].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[89].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[90].OneofWrappers = []interface{}{}
+	file_param_proto_msgTypes[92].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_param_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,8686 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.24.0
+// source: query.proto
+
+package ndid_abci_query_v9
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetNodeSigningPublicKey, GetNodeSigningMasterPublicKey, GetNodeEncryptionPublicKey
+type NodeKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey           string                  `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Algorithm           string                  `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Version             int64                   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	CreationBlockHeight int64                   `protobuf:"varint,4,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	CreationChainId     string                  `protobuf:"bytes,5,opt,name=creation_chain_id,json=creationChainId,proto3" json:"creation_chain_id,omitempty"`
+	Active              bool                    `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	CertificateSubject  *NodeCertificateSubject `protobuf:"bytes,7,opt,name=certificate_subject,json=certificateSubject,proto3" json:"certificate_subject,omitempty"`
+}
+
+func (x *NodeKey) Reset() {
+	*x = NodeKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeKey) ProtoMessage() {}
+
+func (x *NodeKey) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeKey.ProtoReflect.Descriptor instead.
+func (*NodeKey) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *NodeKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *NodeKey) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NodeKey) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *NodeKey) GetCreationChainId() string {
+	if x != nil {
+		return x.CreationChainId
+	}
+	return ""
+}
+
+func (x *NodeKey) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *NodeKey) GetCertificateSubject() *NodeCertificateSubject {
+	if x != nil {
+		return x.CertificateSubject
+	}
+	return nil
+}
+
+type NodeCertificateSubject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject            string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	CommonName         string   `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Organization       []string `protobuf:"bytes,3,rep,name=organization,proto3" json:"organization,omitempty"`
+	OrganizationalUnit []string `protobuf:"bytes,4,rep,name=organizational_unit,json=organizationalUnit,proto3" json:"organizational_unit,omitempty"`
+	Country            []string `protobuf:"bytes,5,rep,name=country,proto3" json:"country,omitempty"`
+	SerialNumber       string   `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Issuer             string   `protobuf:"bytes,7,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotBefore          string   `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter           string   `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+}
+
+func (x *NodeCertificateSubject) Reset() {
+	*x = NodeCertificateSubject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCertificateSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCertificateSubject) ProtoMessage() {}
+
+func (x *NodeCertificateSubject) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCertificateSubject.ProtoReflect.Descriptor instead.
+func (*NodeCertificateSubject) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NodeCertificateSubject) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *NodeCertificateSubject) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *NodeCertificateSubject) GetOrganization() []string {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *NodeCertificateSubject) GetOrganizationalUnit() []string {
+	if x != nil {
+		return x.OrganizationalUnit
+	}
+	return nil
+}
+
+func (x *NodeCertificateSubject) GetCountry() []string {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+func (x *NodeCertificateSubject) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *NodeCertificateSubject) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *NodeCertificateSubject) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *NodeCertificateSubject) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+type GetIdpNodesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node []*IdpNode `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *GetIdpNodesResult) Reset() {
+	*x = GetIdpNodesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdpNodesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdpNodesResult) ProtoMessage() {}
+
+func (x *GetIdpNodesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdpNodesResult.ProtoReflect.Descriptor instead.
+func (*GetIdpNodesResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetIdpNodesResult) GetNode() []*IdpNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type IdpNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                                 string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName                               string   `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	MaxIal                                 float64  `protobuf:"fixed64,3,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal                                 float64  `protobuf:"fixed64,4,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	SupportedFeatureList                   []string `protobuf:"bytes,5,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	Ial                                    *float64 `protobuf:"fixed64,6,opt,name=ial,proto3,oneof" json:"ial,omitempty"`
+	Lial                                   *bool    `protobuf:"varint,7,opt,name=lial,proto3,oneof" json:"lial,omitempty"`
+	Laal                                   *bool    `protobuf:"varint,8,opt,name=laal,proto3,oneof" json:"laal,omitempty"`
+	ModeList                               []int32  `protobuf:"varint,9,rep,packed,name=mode_list,json=modeList,proto3" json:"mode_list,omitempty"`
+	SupportedRequestMessageDataUrlTypeList []string `protobuf:"bytes,10,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+	Agent                                  bool     `protobuf:"varint,11,opt,name=agent,proto3" json:"agent,omitempty"`
+}
+
+func (x *IdpNode) Reset() {
+	*x = IdpNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdpNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdpNode) ProtoMessage() {}
+
+func (x *IdpNode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdpNode.ProtoReflect.Descriptor instead.
+func (*IdpNode) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IdpNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IdpNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *IdpNode) GetMaxIal() float64 {
+	if x != nil {
+		return x.MaxIal
+	}
+	return 0
+}
+
+func (x *IdpNode) GetMaxAal() float64 {
+	if x != nil {
+		return x.MaxAal
+	}
+	return 0
+}
+
+func (x *IdpNode) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *IdpNode) GetIal() float64 {
+	if x != nil && x.Ial != nil {
+		return *x.Ial
+	}
+	return 0
+}
+
+func (x *IdpNode) GetLial() bool {
+	if x != nil && x.Lial != nil {
+		return *x.Lial
+	}
+	return false
+}
+
+func (x *IdpNode) GetLaal() bool {
+	if x != nil && x.Laal != nil {
+		return *x.Laal
+	}
+	return false
+}
+
+func (x *IdpNode) GetModeList() []int32 {
+	if x != nil {
+		return x.ModeList
+	}
+	return nil
+}
+
+func (x *IdpNode) GetSupportedRequestMessageDataUrlTypeList() []string {
+	if x != nil {
+		return x.SupportedRequestMessageDataUrlTypeList
+	}
+	return nil
+}
+
+func (x *IdpNode) GetAgent() bool {
+	if x != nil {
+		return x.Agent
+	}
+	return false
+}
+
+type GetRequestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Closed             bool   `protobuf:"varint,1,opt,name=closed,proto3" json:"closed,omitempty"`
+	TimedOut           bool   `protobuf:"varint,2,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	RequestMessageHash string `protobuf:"bytes,3,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
+	Mode               int32  `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *GetRequestResult) Reset() {
+	*x = GetRequestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestResult) ProtoMessage() {}
+
+func (x *GetRequestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestResult.ProtoReflect.Descriptor instead.
+func (*GetRequestResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRequestResult) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *GetRequestResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *GetRequestResult) GetRequestMessageHash() string {
+	if x != nil {
+		return x.RequestMessageHash
+	}
+	return ""
+}
+
+func (x *GetRequestResult) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type GetRequestDetailResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId           string         `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	MinIdp              int64          `protobuf:"varint,2,opt,name=min_idp,json=minIdp,proto3" json:"min_idp,omitempty"`
+	MinAal              float64        `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	MinIal              float64        `protobuf:"fixed64,4,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	RequestTimeout      int64          `protobuf:"varint,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	IdpIdList           []string       `protobuf:"bytes,6,rep,name=idp_id_list,json=idpIdList,proto3" json:"idp_id_list,omitempty"`
+	DataRequestList     []*DataRequest `protobuf:"bytes,7,rep,name=data_request_list,json=dataRequestList,proto3" json:"data_request_list,omitempty"`
+	RequestMessageHash  string         `protobuf:"bytes,8,opt,name=request_message_hash,json=requestMessageHash,proto3" json:"request_message_hash,omitempty"`
+	ResponseList        []*IdpResponse `protobuf:"bytes,9,rep,name=response_list,json=responseList,proto3" json:"response_list,omitempty"`
+	Closed              bool           `protobuf:"varint,10,opt,name=closed,proto3" json:"closed,omitempty"`
+	TimedOut            bool           `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Purpose             string         `protobuf:"bytes,12,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Mode                int32          `protobuf:"varint,13,opt,name=mode,proto3" json:"mode,omitempty"`
+	RequestType         *string        `protobuf:"bytes,14,opt,name=request_type,json=requestType,proto3,oneof" json:"request_type,omitempty"`
+	RequesterNodeId     string         `protobuf:"bytes,15,opt,name=requester_node_id,json=requesterNodeId,proto3" json:"requester_node_id,omitempty"`
+	CreationBlockHeight int64          `protobuf:"varint,16,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	CreationChainId     string         `protobuf:"bytes,17,opt,name=creation_chain_id,json=creationChainId,proto3" json:"creation_chain_id,omitempty"`
+}
+
+func (x *GetRequestDetailResult) Reset() {
+	*x = GetRequestDetailResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequestDetailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestDetailResult) ProtoMessage() {}
+
+func (x *GetRequestDetailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestDetailResult.ProtoReflect.Descriptor instead.
+func (*GetRequestDetailResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRequestDetailResult) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GetRequestDetailResult) GetMinIdp() int64 {
+	if x != nil {
+		return x.MinIdp
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetRequestTimeout() int64 {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetIdpIdList() []string {
+	if x != nil {
+		return x.IdpIdList
+	}
+	return nil
+}
+
+func (x *GetRequestDetailResult) GetDataRequestList() []*DataRequest {
+	if x != nil {
+		return x.DataRequestList
+	}
+	return nil
+}
+
+func (x *GetRequestDetailResult) GetRequestMessageHash() string {
+	if x != nil {
+		return x.RequestMessageHash
+	}
+	return ""
+}
+
+func (x *GetRequestDetailResult) GetResponseList() []*IdpResponse {
+	if x != nil {
+		return x.ResponseList
+	}
+	return nil
+}
+
+func (x *GetRequestDetailResult) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *GetRequestDetailResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *GetRequestDetailResult) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *GetRequestDetailResult) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetRequestType() string {
+	if x != nil && x.RequestType != nil {
+		return *x.RequestType
+	}
+	return ""
+}
+
+func (x *GetRequestDetailResult) GetRequesterNodeId() string {
+	if x != nil {
+		return x.RequesterNodeId
+	}
+	return ""
+}
+
+func (x *GetRequestDetailResult) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *GetRequestDetailResult) GetCreationChainId() string {
+	if x != nil {
+		return x.CreationChainId
+	}
+	return ""
+}
+
+type DataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId         string        `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	AsIdList          []string      `protobuf:"bytes,2,rep,name=as_id_list,json=asIdList,proto3" json:"as_id_list,omitempty"`
+	MinAs             int64         `protobuf:"varint,3,opt,name=min_as,json=minAs,proto3" json:"min_as,omitempty"`
+	RequestParamsHash string        `protobuf:"bytes,4,opt,name=request_params_hash,json=requestParamsHash,proto3" json:"request_params_hash,omitempty"`
+	ResponseList      []*AsResponse `protobuf:"bytes,5,rep,name=response_list,json=responseList,proto3" json:"response_list,omitempty"`
+}
+
+func (x *DataRequest) Reset() {
+	*x = DataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRequest) ProtoMessage() {}
+
+func (x *DataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRequest.ProtoReflect.Descriptor instead.
+func (*DataRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DataRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DataRequest) GetAsIdList() []string {
+	if x != nil {
+		return x.AsIdList
+	}
+	return nil
+}
+
+func (x *DataRequest) GetMinAs() int64 {
+	if x != nil {
+		return x.MinAs
+	}
+	return 0
+}
+
+func (x *DataRequest) GetRequestParamsHash() string {
+	if x != nil {
+		return x.RequestParamsHash
+	}
+	return ""
+}
+
+func (x *DataRequest) GetResponseList() []*AsResponse {
+	if x != nil {
+		return x.ResponseList
+	}
+	return nil
+}
+
+type AsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AsId         string `protobuf:"bytes,1,opt,name=as_id,json=asId,proto3" json:"as_id,omitempty"`
+	Signed       *bool  `protobuf:"varint,2,opt,name=signed,proto3,oneof" json:"signed,omitempty"`
+	ReceivedData *bool  `protobuf:"varint,3,opt,name=received_data,json=receivedData,proto3,oneof" json:"received_data,omitempty"`
+	ErrorCode    *int32 `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+}
+
+func (x *AsResponse) Reset() {
+	*x = AsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsResponse) ProtoMessage() {}
+
+func (x *AsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsResponse.ProtoReflect.Descriptor instead.
+func (*AsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AsResponse) GetAsId() string {
+	if x != nil {
+		return x.AsId
+	}
+	return ""
+}
+
+func (x *AsResponse) GetSigned() bool {
+	if x != nil && x.Signed != nil {
+		return *x.Signed
+	}
+	return false
+}
+
+func (x *AsResponse) GetReceivedData() bool {
+	if x != nil && x.ReceivedData != nil {
+		return *x.ReceivedData
+	}
+	return false
+}
+
+func (x *AsResponse) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+type IdpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ial            *float64 `protobuf:"fixed64,1,opt,name=ial,proto3,oneof" json:"ial,omitempty"`
+	Aal            *float64 `protobuf:"fixed64,2,opt,name=aal,proto3,oneof" json:"aal,omitempty"`
+	Status         *string  `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Signature      *string  `protobuf:"bytes,4,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
+	IdpId          string   `protobuf:"bytes,5,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	ValidIal       *bool    `protobuf:"varint,6,opt,name=valid_ial,json=validIal,proto3,oneof" json:"valid_ial,omitempty"`
+	ValidSignature *bool    `protobuf:"varint,7,opt,name=valid_signature,json=validSignature,proto3,oneof" json:"valid_signature,omitempty"`
+	ErrorCode      *int32   `protobuf:"varint,8,opt,name=error_code,json=errorCode,proto3,oneof" json:"error_code,omitempty"`
+}
+
+func (x *IdpResponse) Reset() {
+	*x = IdpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdpResponse) ProtoMessage() {}
+
+func (x *IdpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdpResponse.ProtoReflect.Descriptor instead.
+func (*IdpResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IdpResponse) GetIal() float64 {
+	if x != nil && x.Ial != nil {
+		return *x.Ial
+	}
+	return 0
+}
+
+func (x *IdpResponse) GetAal() float64 {
+	if x != nil && x.Aal != nil {
+		return *x.Aal
+	}
+	return 0
+}
+
+func (x *IdpResponse) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *IdpResponse) GetSignature() string {
+	if x != nil && x.Signature != nil {
+		return *x.Signature
+	}
+	return ""
+}
+
+func (x *IdpResponse) GetIdpId() string {
+	if x != nil {
+		return x.IdpId
+	}
+	return ""
+}
+
+func (x *IdpResponse) GetValidIal() bool {
+	if x != nil && x.ValidIal != nil {
+		return *x.ValidIal
+	}
+	return false
+}
+
+func (x *IdpResponse) GetValidSignature() bool {
+	if x != nil && x.ValidSignature != nil {
+		return *x.ValidSignature
+	}
+	return false
+}
+
+func (x *IdpResponse) GetErrorCode() int32 {
+	if x != nil && x.ErrorCode != nil {
+		return *x.ErrorCode
+	}
+	return 0
+}
+
+type GetAsNodesByServiceIdResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node []*AsNode `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *GetAsNodesByServiceIdResult) Reset() {
+	*x = GetAsNodesByServiceIdResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAsNodesByServiceIdResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAsNodesByServiceIdResult) ProtoMessage() {}
+
+func (x *GetAsNodesByServiceIdResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAsNodesByServiceIdResult.ProtoReflect.Descriptor instead.
+func (*GetAsNodesByServiceIdResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAsNodesByServiceIdResult) GetNode() []*AsNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type AsNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId    string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName  string  `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	MinIal    float64 `protobuf:"fixed64,3,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	MinAal    float64 `protobuf:"fixed64,4,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	ServiceId string  `protobuf:"bytes,5,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Active    bool    `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *AsNode) Reset() {
+	*x = AsNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsNode) ProtoMessage() {}
+
+func (x *AsNode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsNode.ProtoReflect.Descriptor instead.
+func (*AsNode) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AsNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AsNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *AsNode) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *AsNode) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *AsNode) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AsNode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type MqAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip                        string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port                      int64  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Hostname                  string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Protocol                  string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	TlsCertificateFingerprint string `protobuf:"bytes,5,opt,name=tls_certificate_fingerprint,json=tlsCertificateFingerprint,proto3" json:"tls_certificate_fingerprint,omitempty"`
+	Priority                  int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight                    int32  `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *MqAddress) Reset() {
+	*x = MqAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MqAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MqAddress) ProtoMessage() {}
+
+func (x *MqAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MqAddress.ProtoReflect.Descriptor instead.
+func (*MqAddress) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MqAddress) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *MqAddress) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *MqAddress) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *MqAddress) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MqAddress) GetTlsCertificateFingerprint() string {
+	if x != nil {
+		return x.TlsCertificateFingerprint
+	}
+	return ""
+}
+
+func (x *MqAddress) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MqAddress) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GetMqAddressesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*MqAddress `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetMqAddressesResult) Reset() {
+	*x = GetMqAddressesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMqAddressesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMqAddressesResult) ProtoMessage() {}
+
+func (x *GetMqAddressesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMqAddressesResult.ProtoReflect.Descriptor instead.
+func (*GetMqAddressesResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMqAddressesResult) GetItems() []*MqAddress {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetNodeTokenResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GetNodeTokenResult) Reset() {
+	*x = GetNodeTokenResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeTokenResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeTokenResult) ProtoMessage() {}
+
+func (x *GetNodeTokenResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeTokenResult.ProtoReflect.Descriptor instead.
+func (*GetNodeTokenResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetNodeTokenResult) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetPriceFuncResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *GetPriceFuncResult) Reset() {
+	*x = GetPriceFuncResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceFuncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceFuncResult) ProtoMessage() {}
+
+func (x *GetPriceFuncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceFuncResult.ProtoReflect.Descriptor instead.
+func (*GetPriceFuncResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPriceFuncResult) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// GetServiceDetail
+type ServiceDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId         string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName       string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	DataSchema        string `protobuf:"bytes,3,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
+	DataSchemaVersion string `protobuf:"bytes,4,opt,name=data_schema_version,json=dataSchemaVersion,proto3" json:"data_schema_version,omitempty"`
+	Active            bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *ServiceDetail) Reset() {
+	*x = ServiceDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDetail) ProtoMessage() {}
+
+func (x *ServiceDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDetail.ProtoReflect.Descriptor instead.
+func (*ServiceDetail) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ServiceDetail) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ServiceDetail) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceDetail) GetDataSchema() string {
+	if x != nil {
+		return x.DataSchema
+	}
+	return ""
+}
+
+func (x *ServiceDetail) GetDataSchemaVersion() string {
+	if x != nil {
+		return x.DataSchemaVersion
+	}
+	return ""
+}
+
+func (x *ServiceDetail) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Namespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace                                    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Description                                  string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Active                                       bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	AllowedIdentifierCountInReferenceGroup       int32  `protobuf:"varint,4,opt,name=allowed_identifier_count_in_reference_group,json=allowedIdentifierCountInReferenceGroup,proto3" json:"allowed_identifier_count_in_reference_group,omitempty"`
+	AllowedActiveIdentifierCountInReferenceGroup int32  `protobuf:"varint,5,opt,name=allowed_active_identifier_count_in_reference_group,json=allowedActiveIdentifierCountInReferenceGroup,proto3" json:"allowed_active_identifier_count_in_reference_group,omitempty"`
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Namespace) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Namespace) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Namespace) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Namespace) GetAllowedIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+func (x *Namespace) GetAllowedActiveIdentifierCountInReferenceGroup() int32 {
+	if x != nil {
+		return x.AllowedActiveIdentifierCountInReferenceGroup
+	}
+	return 0
+}
+
+type GetNamespaceListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*Namespace `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetNamespaceListResult) Reset() {
+	*x = GetNamespaceListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNamespaceListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceListResult) ProtoMessage() {}
+
+func (x *GetNamespaceListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceListResult.ProtoReflect.Descriptor instead.
+func (*GetNamespaceListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetNamespaceListResult) GetItems() []*Namespace {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetNamespaceListResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CheckExistingIdentityResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exist bool `protobuf:"varint,1,opt,name=exist,proto3" json:"exist,omitempty"`
+}
+
+func (x *CheckExistingIdentityResult) Reset() {
+	*x = CheckExistingIdentityResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckExistingIdentityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExistingIdentityResult) ProtoMessage() {}
+
+func (x *CheckExistingIdentityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExistingIdentityResult.ProtoReflect.Descriptor instead.
+func (*CheckExistingIdentityResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckExistingIdentityResult) GetExist() bool {
+	if x != nil {
+		return x.Exist
+	}
+	return false
+}
+
+type GetAccessorKeyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessorPublicKey   string  `protobuf:"bytes,1,opt,name=accessor_public_key,json=accessorPublicKey,proto3" json:"accessor_public_key,omitempty"`
+	AccessorType        string  `protobuf:"bytes,2,opt,name=accessor_type,json=accessorType,proto3" json:"accessor_type,omitempty"`
+	AccessorAlgorithm   string  `protobuf:"bytes,3,opt,name=accessor_algorithm,json=accessorAlgorithm,proto3" json:"accessor_algorithm,omitempty"`
+	Active              bool    `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	OwnerNodeId         string  `protobuf:"bytes,5,opt,name=owner_node_id,json=ownerNodeId,proto3" json:"owner_node_id,omitempty"`
+	CreationBlockHeight int64   `protobuf:"varint,6,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	CreationChainId     string  `protobuf:"bytes,7,opt,name=creation_chain_id,json=creationChainId,proto3" json:"creation_chain_id,omitempty"`
+	AccessorExpiration  *string `protobuf:"bytes,8,opt,name=accessor_expiration,json=accessorExpiration,proto3,oneof" json:"accessor_expiration,omitempty"`
+}
+
+func (x *GetAccessorKeyResult) Reset() {
+	*x = GetAccessorKeyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccessorKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessorKeyResult) ProtoMessage() {}
+
+func (x *GetAccessorKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessorKeyResult.ProtoReflect.Descriptor instead.
+func (*GetAccessorKeyResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAccessorKeyResult) GetAccessorPublicKey() string {
+	if x != nil {
+		return x.AccessorPublicKey
+	}
+	return ""
+}
+
+func (x *GetAccessorKeyResult) GetAccessorType() string {
+	if x != nil {
+		return x.AccessorType
+	}
+	return ""
+}
+
+func (x *GetAccessorKeyResult) GetAccessorAlgorithm() string {
+	if x != nil {
+		return x.AccessorAlgorithm
+	}
+	return ""
+}
+
+func (x *GetAccessorKeyResult) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *GetAccessorKeyResult) GetOwnerNodeId() string {
+	if x != nil {
+		return x.OwnerNodeId
+	}
+	return ""
+}
+
+func (x *GetAccessorKeyResult) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *GetAccessorKeyResult) GetCreationChainId() string {
+	if x != nil {
+		return x.CreationChainId
+	}
+	return ""
+}
+
+func (x *GetAccessorKeyResult) GetAccessorExpiration() string {
+	if x != nil && x.AccessorExpiration != nil {
+		return *x.AccessorExpiration
+	}
+	return ""
+}
+
+type GetServiceListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*ServiceDetail `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetServiceListResult) Reset() {
+	*x = GetServiceListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceListResult) ProtoMessage() {}
+
+func (x *GetServiceListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceListResult.ProtoReflect.Descriptor instead.
+func (*GetServiceListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetServiceListResult) GetItems() []*ServiceDetail {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetServiceListResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetNodeInfoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SigningPublicKey                       *NodeKey       `protobuf:"bytes,1,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningMasterPublicKey                 *NodeKey       `protobuf:"bytes,2,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	EncryptionPublicKey                    *NodeKey       `protobuf:"bytes,3,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	NodeName                               string         `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Role                                   string         `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Roles                                  []string       `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	MaxIal                                 *float64       `protobuf:"fixed64,7,opt,name=max_ial,json=maxIal,proto3,oneof" json:"max_ial,omitempty"`
+	MaxAal                                 *float64       `protobuf:"fixed64,8,opt,name=max_aal,json=maxAal,proto3,oneof" json:"max_aal,omitempty"`
+	SupportedFeatureList                   []string       `protobuf:"bytes,9,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	SupportedRequestMessageDataUrlTypeList []string       `protobuf:"bytes,10,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+	Agent                                  *bool          `protobuf:"varint,11,opt,name=agent,proto3,oneof" json:"agent,omitempty"`
+	NodeIdWhitelistActive                  *bool          `protobuf:"varint,12,opt,name=node_id_whitelist_active,json=nodeIdWhitelistActive,proto3,oneof" json:"node_id_whitelist_active,omitempty"`
+	NodeIdWhitelist                        []string       `protobuf:"bytes,13,rep,name=node_id_whitelist,json=nodeIdWhitelist,proto3" json:"node_id_whitelist,omitempty"`
+	Proxy                                  *ProxyNodeInfo `protobuf:"bytes,14,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Mq                                     []*MqAddress   `protobuf:"bytes,15,rep,name=mq,proto3" json:"mq,omitempty"`
+	Active                                 bool           `protobuf:"varint,16,opt,name=active,proto3" json:"active,omitempty"`
+	Profile                                *NodeProfile   `protobuf:"bytes,17,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetNodeInfoResult) Reset() {
+	*x = GetNodeInfoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeInfoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeInfoResult) ProtoMessage() {}
+
+func (x *GetNodeInfoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeInfoResult.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNodeInfoResult) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetSigningMasterPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResult) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetMaxIal() float64 {
+	if x != nil && x.MaxIal != nil {
+		return *x.MaxIal
+	}
+	return 0
+}
+
+func (x *GetNodeInfoResult) GetMaxAal() float64 {
+	if x != nil && x.MaxAal != nil {
+		return *x.MaxAal
+	}
+	return 0
+}
+
+func (x *GetNodeInfoResult) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetSupportedRequestMessageDataUrlTypeList() []string {
+	if x != nil {
+		return x.SupportedRequestMessageDataUrlTypeList
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetAgent() bool {
+	if x != nil && x.Agent != nil {
+		return *x.Agent
+	}
+	return false
+}
+
+func (x *GetNodeInfoResult) GetNodeIdWhitelistActive() bool {
+	if x != nil && x.NodeIdWhitelistActive != nil {
+		return *x.NodeIdWhitelistActive
+	}
+	return false
+}
+
+func (x *GetNodeInfoResult) GetNodeIdWhitelist() []string {
+	if x != nil {
+		return x.NodeIdWhitelist
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetProxy() *ProxyNodeInfo {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *GetNodeInfoResult) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *GetNodeInfoResult) GetProfile() *NodeProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ProxyNodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                 string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName               string       `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	SigningPublicKey       *NodeKey     `protobuf:"bytes,3,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningMasterPublicKey *NodeKey     `protobuf:"bytes,4,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	EncryptionPublicKey    *NodeKey     `protobuf:"bytes,5,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	Mq                     []*MqAddress `protobuf:"bytes,6,rep,name=mq,proto3" json:"mq,omitempty"`
+	Config                 string       `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ProxyNodeInfo) Reset() {
+	*x = ProxyNodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyNodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyNodeInfo) ProtoMessage() {}
+
+func (x *ProxyNodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyNodeInfo.ProtoReflect.Descriptor instead.
+func (*ProxyNodeInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProxyNodeInfo) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ProxyNodeInfo) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *ProxyNodeInfo) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *ProxyNodeInfo) GetSigningMasterPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return nil
+}
+
+func (x *ProxyNodeInfo) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *ProxyNodeInfo) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *ProxyNodeInfo) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type NodeProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version            int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	LegalEntityName    string `protobuf:"bytes,2,opt,name=legal_entity_name,json=legalEntityName,proto3" json:"legal_entity_name,omitempty"`
+	RegistrationNumber string `protobuf:"bytes,3,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	ContactEmail       string `protobuf:"bytes,4,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	SupportUrl         string `protobuf:"bytes,5,opt,name=support_url,json=supportUrl,proto3" json:"support_url,omitempty"`
+	LogoHash           string `protobuf:"bytes,6,opt,name=logo_hash,json=logoHash,proto3" json:"logo_hash,omitempty"`
+	OperatingHours     string `protobuf:"bytes,7,opt,name=operating_hours,json=operatingHours,proto3" json:"operating_hours,omitempty"`
+	UpdateBlockHeight  int64  `protobuf:"varint,8,opt,name=update_block_height,json=updateBlockHeight,proto3" json:"update_block_height,omitempty"`
+}
+
+func (x *NodeProfile) Reset() {
+	*x = NodeProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeProfile) ProtoMessage() {}
+
+func (x *NodeProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeProfile.ProtoReflect.Descriptor instead.
+func (*NodeProfile) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NodeProfile) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NodeProfile) GetLegalEntityName() string {
+	if x != nil {
+		return x.LegalEntityName
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetContactEmail() string {
+	if x != nil {
+		return x.ContactEmail
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetSupportUrl() string {
+	if x != nil {
+		return x.SupportUrl
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetLogoHash() string {
+	if x != nil {
+		return x.LogoHash
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetOperatingHours() string {
+	if x != nil {
+		return x.OperatingHours
+	}
+	return ""
+}
+
+func (x *NodeProfile) GetUpdateBlockHeight() int64 {
+	if x != nil {
+		return x.UpdateBlockHeight
+	}
+	return 0
+}
+
+type GetNodePublicKeyListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SigningPublicKeyList       []*NodeKey `protobuf:"bytes,1,rep,name=signing_public_key_list,json=signingPublicKeyList,proto3" json:"signing_public_key_list,omitempty"`
+	SigningMasterPublicKeyList []*NodeKey `protobuf:"bytes,2,rep,name=signing_master_public_key_list,json=signingMasterPublicKeyList,proto3" json:"signing_master_public_key_list,omitempty"`
+	EncryptionPublicKeyList    []*NodeKey `protobuf:"bytes,3,rep,name=encryption_public_key_list,json=encryptionPublicKeyList,proto3" json:"encryption_public_key_list,omitempty"`
+	NextCursor                 string     `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetNodePublicKeyListResult) Reset() {
+	*x = GetNodePublicKeyListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodePublicKeyListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodePublicKeyListResult) ProtoMessage() {}
+
+func (x *GetNodePublicKeyListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodePublicKeyListResult.ProtoReflect.Descriptor instead.
+func (*GetNodePublicKeyListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetNodePublicKeyListResult) GetSigningPublicKeyList() []*NodeKey {
+	if x != nil {
+		return x.SigningPublicKeyList
+	}
+	return nil
+}
+
+func (x *GetNodePublicKeyListResult) GetSigningMasterPublicKeyList() []*NodeKey {
+	if x != nil {
+		return x.SigningMasterPublicKeyList
+	}
+	return nil
+}
+
+func (x *GetNodePublicKeyListResult) GetEncryptionPublicKeyList() []*NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKeyList
+	}
+	return nil
+}
+
+func (x *GetNodePublicKeyListResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// CheckExistingAccessorID
+type CheckExistingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exist bool `protobuf:"varint,1,opt,name=exist,proto3" json:"exist,omitempty"`
+}
+
+func (x *CheckExistingResult) Reset() {
+	*x = CheckExistingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckExistingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckExistingResult) ProtoMessage() {}
+
+func (x *CheckExistingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckExistingResult.ProtoReflect.Descriptor instead.
+func (*CheckExistingResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckExistingResult) GetExist() bool {
+	if x != nil {
+		return x.Exist
+	}
+	return false
+}
+
+type GetIdentityInfoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ial              float64 `protobuf:"fixed64,1,opt,name=ial,proto3" json:"ial,omitempty"`
+	Lial             *bool   `protobuf:"varint,2,opt,name=lial,proto3,oneof" json:"lial,omitempty"`
+	Laal             *bool   `protobuf:"varint,3,opt,name=laal,proto3,oneof" json:"laal,omitempty"`
+	ModeList         []int32 `protobuf:"varint,4,rep,packed,name=mode_list,json=modeList,proto3" json:"mode_list,omitempty"`
+	Frozen           bool    `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FreezeReasonCode string  `protobuf:"bytes,6,opt,name=freeze_reason_code,json=freezeReasonCode,proto3" json:"freeze_reason_code,omitempty"`
+}
+
+func (x *GetIdentityInfoResult) Reset() {
+	*x = GetIdentityInfoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentityInfoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityInfoResult) ProtoMessage() {}
+
+func (x *GetIdentityInfoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityInfoResult.ProtoReflect.Descriptor instead.
+func (*GetIdentityInfoResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetIdentityInfoResult) GetIal() float64 {
+	if x != nil {
+		return x.Ial
+	}
+	return 0
+}
+
+func (x *GetIdentityInfoResult) GetLial() bool {
+	if x != nil && x.Lial != nil {
+		return *x.Lial
+	}
+	return false
+}
+
+func (x *GetIdentityInfoResult) GetLaal() bool {
+	if x != nil && x.Laal != nil {
+		return *x.Laal
+	}
+	return false
+}
+
+func (x *GetIdentityInfoResult) GetModeList() []int32 {
+	if x != nil {
+		return x.ModeList
+	}
+	return nil
+}
+
+func (x *GetIdentityInfoResult) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *GetIdentityInfoResult) GetFreezeReasonCode() string {
+	if x != nil {
+		return x.FreezeReasonCode
+	}
+	return ""
+}
+
+type GetDataSignatureResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GetDataSignatureResult) Reset() {
+	*x = GetDataSignatureResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataSignatureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataSignatureResult) ProtoMessage() {}
+
+func (x *GetDataSignatureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataSignatureResult.ProtoReflect.Descriptor instead.
+func (*GetDataSignatureResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDataSignatureResult) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type GetServicesByAsIDResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *GetServicesByAsIDResult) Reset() {
+	*x = GetServicesByAsIDResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServicesByAsIDResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicesByAsIDResult) ProtoMessage() {}
+
+func (x *GetServicesByAsIDResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicesByAsIDResult.ProtoReflect.Descriptor instead.
+func (*GetServicesByAsIDResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetServicesByAsIDResult) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId              string   `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	MinIal                 float64  `protobuf:"fixed64,2,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	MinAal                 float64  `protobuf:"fixed64,3,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	Active                 bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Suspended              bool     `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	SupportedNamespaceList []string `protobuf:"bytes,6,rep,name=supported_namespace_list,json=supportedNamespaceList,proto3" json:"supported_namespace_list,omitempty"`
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Service) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *Service) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *Service) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *Service) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Service) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *Service) GetSupportedNamespaceList() []string {
+	if x != nil {
+		return x.SupportedNamespaceList
+	}
+	return nil
+}
+
+type GetIdpNodesInfoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node       []*IdpNodeInfo `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	NextCursor string         `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetIdpNodesInfoResult) Reset() {
+	*x = GetIdpNodesInfoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdpNodesInfoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdpNodesInfoResult) ProtoMessage() {}
+
+func (x *GetIdpNodesInfoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdpNodesInfoResult.ProtoReflect.Descriptor instead.
+func (*GetIdpNodesInfoResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetIdpNodesInfoResult) GetNode() []*IdpNodeInfo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *GetIdpNodesInfoResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type IdpNodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                                 string            `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name                                   string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaxIal                                 float64           `protobuf:"fixed64,3,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal                                 float64           `protobuf:"fixed64,4,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	SupportedFeatureList                   []string          `protobuf:"bytes,5,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	SigningPublicKey                       *NodeKey          `protobuf:"bytes,6,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	EncryptionPublicKey                    *NodeKey          `protobuf:"bytes,7,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	Mq                                     []*MqAddress      `protobuf:"bytes,8,rep,name=mq,proto3" json:"mq,omitempty"`
+	Agent                                  bool              `protobuf:"varint,9,opt,name=agent,proto3" json:"agent,omitempty"`
+	NodeIdWhitelistActive                  *bool             `protobuf:"varint,10,opt,name=node_id_whitelist_active,json=nodeIdWhitelistActive,proto3,oneof" json:"node_id_whitelist_active,omitempty"`
+	NodeIdWhitelist                        []string          `protobuf:"bytes,11,rep,name=node_id_whitelist,json=nodeIdWhitelist,proto3" json:"node_id_whitelist,omitempty"`
+	Ial                                    *float64          `protobuf:"fixed64,12,opt,name=ial,proto3,oneof" json:"ial,omitempty"`
+	ModeList                               []int32           `protobuf:"varint,13,rep,packed,name=mode_list,json=modeList,proto3" json:"mode_list,omitempty"`
+	SupportedRequestMessageDataUrlTypeList []string          `protobuf:"bytes,14,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+	Proxy                                  *IdpNodeInfoProxy `protobuf:"bytes,15,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Profile                                *NodeProfile      `protobuf:"bytes,16,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *IdpNodeInfo) Reset() {
+	*x = IdpNodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdpNodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdpNodeInfo) ProtoMessage() {}
+
+func (x *IdpNodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdpNodeInfo.ProtoReflect.Descriptor instead.
+func (*IdpNodeInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *IdpNodeInfo) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IdpNodeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IdpNodeInfo) GetMaxIal() float64 {
+	if x != nil {
+		return x.MaxIal
+	}
+	return 0
+}
+
+func (x *IdpNodeInfo) GetMaxAal() float64 {
+	if x != nil {
+		return x.MaxAal
+	}
+	return 0
+}
+
+func (x *IdpNodeInfo) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetAgent() bool {
+	if x != nil {
+		return x.Agent
+	}
+	return false
+}
+
+func (x *IdpNodeInfo) GetNodeIdWhitelistActive() bool {
+	if x != nil && x.NodeIdWhitelistActive != nil {
+		return *x.NodeIdWhitelistActive
+	}
+	return false
+}
+
+func (x *IdpNodeInfo) GetNodeIdWhitelist() []string {
+	if x != nil {
+		return x.NodeIdWhitelist
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetIal() float64 {
+	if x != nil && x.Ial != nil {
+		return *x.Ial
+	}
+	return 0
+}
+
+func (x *IdpNodeInfo) GetModeList() []int32 {
+	if x != nil {
+		return x.ModeList
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetSupportedRequestMessageDataUrlTypeList() []string {
+	if x != nil {
+		return x.SupportedRequestMessageDataUrlTypeList
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetProxy() *IdpNodeInfoProxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *IdpNodeInfo) GetProfile() *NodeProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type IdpNodeInfoProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId              string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SigningPublicKey    *NodeKey     `protobuf:"bytes,2,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	EncryptionPublicKey *NodeKey     `protobuf:"bytes,3,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	Mq                  []*MqAddress `protobuf:"bytes,4,rep,name=mq,proto3" json:"mq,omitempty"`
+	Config              string       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *IdpNodeInfoProxy) Reset() {
+	*x = IdpNodeInfoProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdpNodeInfoProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdpNodeInfoProxy) ProtoMessage() {}
+
+func (x *IdpNodeInfoProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdpNodeInfoProxy.ProtoReflect.Descriptor instead.
+func (*IdpNodeInfoProxy) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IdpNodeInfoProxy) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *IdpNodeInfoProxy) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *IdpNodeInfoProxy) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *IdpNodeInfoProxy) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *IdpNodeInfoProxy) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type GetAsNodesInfoByServiceIdResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node []*AsNodeInfo `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *GetAsNodesInfoByServiceIdResult) Reset() {
+	*x = GetAsNodesInfoByServiceIdResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAsNodesInfoByServiceIdResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAsNodesInfoByServiceIdResult) ProtoMessage() {}
+
+func (x *GetAsNodesInfoByServiceIdResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAsNodesInfoByServiceIdResult.ProtoReflect.Descriptor instead.
+func (*GetAsNodesInfoByServiceIdResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAsNodesInfoByServiceIdResult) GetNode() []*AsNodeInfo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// AS node with its own message queue addresses or behind proxy node (with proxy)
+type AsNodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                 string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name                   string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinIal                 float64      `protobuf:"fixed64,3,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+	MinAal                 float64      `protobuf:"fixed64,4,opt,name=min_aal,json=minAal,proto3" json:"min_aal,omitempty"`
+	SigningPublicKey       *NodeKey     `protobuf:"bytes,5,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	EncryptionPublicKey    *NodeKey     `protobuf:"bytes,6,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	Mq                     []*MqAddress `protobuf:"bytes,7,rep,name=mq,proto3" json:"mq,omitempty"`
+	SupportedNamespaceList []string     `protobuf:"bytes,8,rep,name=supported_namespace_list,json=supportedNamespaceList,proto3" json:"supported_namespace_list,omitempty"`
+	Profile                *NodeProfile `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	Proxy                  *AsNodeProxy `protobuf:"bytes,10,opt,name=proxy,proto3" json:"proxy,omitempty"`
+}
+
+func (x *AsNodeInfo) Reset() {
+	*x = AsNodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsNodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsNodeInfo) ProtoMessage() {}
+
+func (x *AsNodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsNodeInfo.ProtoReflect.Descriptor instead.
+func (*AsNodeInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AsNodeInfo) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AsNodeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AsNodeInfo) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+func (x *AsNodeInfo) GetMinAal() float64 {
+	if x != nil {
+		return x.MinAal
+	}
+	return 0
+}
+
+func (x *AsNodeInfo) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *AsNodeInfo) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *AsNodeInfo) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *AsNodeInfo) GetSupportedNamespaceList() []string {
+	if x != nil {
+		return x.SupportedNamespaceList
+	}
+	return nil
+}
+
+func (x *AsNodeInfo) GetProfile() *NodeProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *AsNodeInfo) GetProxy() *AsNodeProxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+type AsNodeProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId              string       `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SigningPublicKey    *NodeKey     `protobuf:"bytes,2,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	EncryptionPublicKey *NodeKey     `protobuf:"bytes,3,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	Mq                  []*MqAddress `protobuf:"bytes,4,rep,name=mq,proto3" json:"mq,omitempty"`
+	Config              string       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *AsNodeProxy) Reset() {
+	*x = AsNodeProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsNodeProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsNodeProxy) ProtoMessage() {}
+
+func (x *AsNodeProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsNodeProxy.ProtoReflect.Descriptor instead.
+func (*AsNodeProxy) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AsNodeProxy) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AsNodeProxy) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *AsNodeProxy) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *AsNodeProxy) GetMq() []*MqAddress {
+	if x != nil {
+		return x.Mq
+	}
+	return nil
+}
+
+func (x *AsNodeProxy) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type GetNodesBehindProxyNodeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*NodeBehindProxy `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (x *GetNodesBehindProxyNodeResult) Reset() {
+	*x = GetNodesBehindProxyNodeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodesBehindProxyNodeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodesBehindProxyNodeResult) ProtoMessage() {}
+
+func (x *GetNodesBehindProxyNodeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodesBehindProxyNodeResult.ProtoReflect.Descriptor instead.
+func (*GetNodesBehindProxyNodeResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetNodesBehindProxyNodeResult) GetNodes() []*NodeBehindProxy {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+// IdP (with IdP fields), RP or AS node behind proxy node
+type NodeBehindProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                                 string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName                               string   `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Role                                   string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Roles                                  []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	SigningPublicKey                       *NodeKey `protobuf:"bytes,5,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"`
+	SigningMasterPublicKey                 *NodeKey `protobuf:"bytes,6,opt,name=signing_master_public_key,json=signingMasterPublicKey,proto3" json:"signing_master_public_key,omitempty"`
+	EncryptionPublicKey                    *NodeKey `protobuf:"bytes,7,opt,name=encryption_public_key,json=encryptionPublicKey,proto3" json:"encryption_public_key,omitempty"`
+	MaxIal                                 float64  `protobuf:"fixed64,8,opt,name=max_ial,json=maxIal,proto3" json:"max_ial,omitempty"`
+	MaxAal                                 float64  `protobuf:"fixed64,9,opt,name=max_aal,json=maxAal,proto3" json:"max_aal,omitempty"`
+	SupportedFeatureList                   []string `protobuf:"bytes,10,rep,name=supported_feature_list,json=supportedFeatureList,proto3" json:"supported_feature_list,omitempty"`
+	Agent                                  bool     `protobuf:"varint,11,opt,name=agent,proto3" json:"agent,omitempty"`
+	Config                                 string   `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`
+	SupportedRequestMessageDataUrlTypeList []string `protobuf:"bytes,13,rep,name=supported_request_message_data_url_type_list,json=supportedRequestMessageDataUrlTypeList,proto3" json:"supported_request_message_data_url_type_list,omitempty"`
+}
+
+func (x *NodeBehindProxy) Reset() {
+	*x = NodeBehindProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeBehindProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBehindProxy) ProtoMessage() {}
+
+func (x *NodeBehindProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBehindProxy.ProtoReflect.Descriptor instead.
+func (*NodeBehindProxy) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NodeBehindProxy) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeBehindProxy) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *NodeBehindProxy) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *NodeBehindProxy) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *NodeBehindProxy) GetSigningPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *NodeBehindProxy) GetSigningMasterPublicKey() *NodeKey {
+	if x != nil {
+		return x.SigningMasterPublicKey
+	}
+	return nil
+}
+
+func (x *NodeBehindProxy) GetEncryptionPublicKey() *NodeKey {
+	if x != nil {
+		return x.EncryptionPublicKey
+	}
+	return nil
+}
+
+func (x *NodeBehindProxy) GetMaxIal() float64 {
+	if x != nil {
+		return x.MaxIal
+	}
+	return 0
+}
+
+func (x *NodeBehindProxy) GetMaxAal() float64 {
+	if x != nil {
+		return x.MaxAal
+	}
+	return 0
+}
+
+func (x *NodeBehindProxy) GetSupportedFeatureList() []string {
+	if x != nil {
+		return x.SupportedFeatureList
+	}
+	return nil
+}
+
+func (x *NodeBehindProxy) GetAgent() bool {
+	if x != nil {
+		return x.Agent
+	}
+	return false
+}
+
+func (x *NodeBehindProxy) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *NodeBehindProxy) GetSupportedRequestMessageDataUrlTypeList() []string {
+	if x != nil {
+		return x.SupportedRequestMessageDataUrlTypeList
+	}
+	return nil
+}
+
+type GetNodeIDListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIdList []string `protobuf:"bytes,1,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetNodeIDListResult) Reset() {
+	*x = GetNodeIDListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeIDListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeIDListResult) ProtoMessage() {}
+
+func (x *GetNodeIDListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeIDListResult.ProtoReflect.Descriptor instead.
+func (*GetNodeIDListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetNodeIDListResult) GetNodeIdList() []string {
+	if x != nil {
+		return x.NodeIdList
+	}
+	return nil
+}
+
+func (x *GetNodeIDListResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetAccessorOwnerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *GetAccessorOwnerResult) Reset() {
+	*x = GetAccessorOwnerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccessorOwnerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessorOwnerResult) ProtoMessage() {}
+
+func (x *GetAccessorOwnerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessorOwnerResult.ProtoReflect.Descriptor instead.
+func (*GetAccessorOwnerResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAccessorOwnerResult) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type ErrorCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode   int32  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ErrorCode) Reset() {
+	*x = ErrorCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorCode) ProtoMessage() {}
+
+func (x *ErrorCode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorCode.ProtoReflect.Descriptor instead.
+func (*ErrorCode) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ErrorCode) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ErrorCode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetErrorCodeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*ErrorCode `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetErrorCodeListResult) Reset() {
+	*x = GetErrorCodeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetErrorCodeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErrorCodeListResult) ProtoMessage() {}
+
+func (x *GetErrorCodeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErrorCodeListResult.ProtoReflect.Descriptor instead.
+func (*GetErrorCodeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetErrorCodeListResult) GetItems() []*ErrorCode {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetErrorCodeListResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type IsInitEndedResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitEnded bool `protobuf:"varint,1,opt,name=init_ended,json=initEnded,proto3" json:"init_ended,omitempty"`
+}
+
+func (x *IsInitEndedResult) Reset() {
+	*x = IsInitEndedResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsInitEndedResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsInitEndedResult) ProtoMessage() {}
+
+func (x *IsInitEndedResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsInitEndedResult.ProtoReflect.Descriptor instead.
+func (*IsInitEndedResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *IsInitEndedResult) GetInitEnded() bool {
+	if x != nil {
+		return x.InitEnded
+	}
+	return false
+}
+
+type GetChainHistoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chains []*ChainHistoryDetail `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *GetChainHistoryResult) Reset() {
+	*x = GetChainHistoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainHistoryResult) ProtoMessage() {}
+
+func (x *GetChainHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainHistoryResult.ProtoReflect.Descriptor instead.
+func (*GetChainHistoryResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetChainHistoryResult) GetChains() []*ChainHistoryDetail {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type ChainHistoryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	LatestBlockHash   string `protobuf:"bytes,2,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	LatestAppHash     string `protobuf:"bytes,3,opt,name=latest_app_hash,json=latestAppHash,proto3" json:"latest_app_hash,omitempty"`
+	LatestBlockHeight string `protobuf:"bytes,4,opt,name=latest_block_height,json=latestBlockHeight,proto3" json:"latest_block_height,omitempty"`
+}
+
+func (x *ChainHistoryDetail) Reset() {
+	*x = ChainHistoryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainHistoryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainHistoryDetail) ProtoMessage() {}
+
+func (x *ChainHistoryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainHistoryDetail.ProtoReflect.Descriptor instead.
+func (*ChainHistoryDetail) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChainHistoryDetail) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainHistoryDetail) GetLatestBlockHash() string {
+	if x != nil {
+		return x.LatestBlockHash
+	}
+	return ""
+}
+
+func (x *ChainHistoryDetail) GetLatestAppHash() string {
+	if x != nil {
+		return x.LatestAppHash
+	}
+	return ""
+}
+
+func (x *ChainHistoryDetail) GetLatestBlockHeight() string {
+	if x != nil {
+		return x.LatestBlockHeight
+	}
+	return ""
+}
+
+// GetReferenceGroupCode, GetReferenceGroupCodeByAccessorID
+type GetReferenceGroupCodeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceGroupCode string `protobuf:"bytes,1,opt,name=reference_group_code,json=referenceGroupCode,proto3" json:"reference_group_code,omitempty"`
+}
+
+func (x *GetReferenceGroupCodeResult) Reset() {
+	*x = GetReferenceGroupCodeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReferenceGroupCodeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferenceGroupCodeResult) ProtoMessage() {}
+
+func (x *GetReferenceGroupCodeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferenceGroupCodeResult.ProtoReflect.Descriptor instead.
+func (*GetReferenceGroupCodeResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetReferenceGroupCodeResult) GetReferenceGroupCode() string {
+	if x != nil {
+		return x.ReferenceGroupCode
+	}
+	return ""
+}
+
+type GetSupportedIALListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupportedIalList []float64 `protobuf:"fixed64,1,rep,packed,name=supported_ial_list,json=supportedIalList,proto3" json:"supported_ial_list,omitempty"`
+}
+
+func (x *GetSupportedIALListResult) Reset() {
+	*x = GetSupportedIALListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSupportedIALListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedIALListResult) ProtoMessage() {}
+
+func (x *GetSupportedIALListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedIALListResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedIALListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetSupportedIALListResult) GetSupportedIalList() []float64 {
+	if x != nil {
+		return x.SupportedIalList
+	}
+	return nil
+}
+
+type GetSupportedAALListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SupportedAalList []float64 `protobuf:"fixed64,1,rep,packed,name=supported_aal_list,json=supportedAalList,proto3" json:"supported_aal_list,omitempty"`
+}
+
+func (x *GetSupportedAALListResult) Reset() {
+	*x = GetSupportedAALListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSupportedAALListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedAALListResult) ProtoMessage() {}
+
+func (x *GetSupportedAALListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedAALListResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedAALListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSupportedAALListResult) GetSupportedAalList() []float64 {
+	if x != nil {
+		return x.SupportedAalList
+	}
+	return nil
+}
+
+type GetAllowedModeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedModeList []int32 `protobuf:"varint,1,rep,packed,name=allowed_mode_list,json=allowedModeList,proto3" json:"allowed_mode_list,omitempty"`
+}
+
+func (x *GetAllowedModeListResult) Reset() {
+	*x = GetAllowedModeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllowedModeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedModeListResult) ProtoMessage() {}
+
+func (x *GetAllowedModeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedModeListResult.ProtoReflect.Descriptor instead.
+func (*GetAllowedModeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetAllowedModeListResult) GetAllowedModeList() []int32 {
+	if x != nil {
+		return x.AllowedModeList
+	}
+	return nil
+}
+
+type GetAllowedMinIalForRegisterIdentityAtFirstIdpResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinIal float64 `protobuf:"fixed64,1,opt,name=min_ial,json=minIal,proto3" json:"min_ial,omitempty"`
+}
+
+func (x *GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) Reset() {
+	*x = GetAllowedMinIalForRegisterIdentityAtFirstIdpResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) ProtoMessage() {}
+
+func (x *GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedMinIalForRegisterIdentityAtFirstIdpResult.ProtoReflect.Descriptor instead.
+func (*GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetAllowedMinIalForRegisterIdentityAtFirstIdpResult) GetMinIal() float64 {
+	if x != nil {
+		return x.MinIal
+	}
+	return 0
+}
+
+type GetServicePriceListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceListByNode []*ServicePriceListByNode `protobuf:"bytes,1,rep,name=price_list_by_node,json=priceListByNode,proto3" json:"price_list_by_node,omitempty"`
+}
+
+func (x *GetServicePriceListResult) Reset() {
+	*x = GetServicePriceListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServicePriceListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicePriceListResult) ProtoMessage() {}
+
+func (x *GetServicePriceListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicePriceListResult.ProtoReflect.Descriptor instead.
+func (*GetServicePriceListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetServicePriceListResult) GetPriceListByNode() []*ServicePriceListByNode {
+	if x != nil {
+		return x.PriceListByNode
+	}
+	return nil
+}
+
+type ServicePriceListByNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId    string          `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PriceList []*ServicePrice `protobuf:"bytes,2,rep,name=price_list,json=priceList,proto3" json:"price_list,omitempty"`
+}
+
+func (x *ServicePriceListByNode) Reset() {
+	*x = ServicePriceListByNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePriceListByNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePriceListByNode) ProtoMessage() {}
+
+func (x *ServicePriceListByNode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePriceListByNode.ProtoReflect.Descriptor instead.
+func (*ServicePriceListByNode) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ServicePriceListByNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ServicePriceListByNode) GetPriceList() []*ServicePrice {
+	if x != nil {
+		return x.PriceList
+	}
+	return nil
+}
+
+type ServicePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceByCurrencyList []*ServicePriceByCurrency `protobuf:"bytes,1,rep,name=price_by_currency_list,json=priceByCurrencyList,proto3" json:"price_by_currency_list,omitempty"`
+	EffectiveDatetime   string                    `protobuf:"bytes,2,opt,name=effective_datetime,json=effectiveDatetime,proto3" json:"effective_datetime,omitempty"`
+	MoreInfoUrl         string                    `protobuf:"bytes,3,opt,name=more_info_url,json=moreInfoUrl,proto3" json:"more_info_url,omitempty"`
+	Detail              string                    `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreationBlockHeight int64                     `protobuf:"varint,5,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	CreationChainId     string                    `protobuf:"bytes,6,opt,name=creation_chain_id,json=creationChainId,proto3" json:"creation_chain_id,omitempty"`
+}
+
+func (x *ServicePrice) Reset() {
+	*x = ServicePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePrice) ProtoMessage() {}
+
+func (x *ServicePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePrice.ProtoReflect.Descriptor instead.
+func (*ServicePrice) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ServicePrice) GetPriceByCurrencyList() []*ServicePriceByCurrency {
+	if x != nil {
+		return x.PriceByCurrencyList
+	}
+	return nil
+}
+
+func (x *ServicePrice) GetEffectiveDatetime() string {
+	if x != nil {
+		return x.EffectiveDatetime
+	}
+	return ""
+}
+
+func (x *ServicePrice) GetMoreInfoUrl() string {
+	if x != nil {
+		return x.MoreInfoUrl
+	}
+	return ""
+}
+
+func (x *ServicePrice) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ServicePrice) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *ServicePrice) GetCreationChainId() string {
+	if x != nil {
+		return x.CreationChainId
+	}
+	return ""
+}
+
+type ServicePriceByCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinPrice float64 `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice float64 `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+}
+
+func (x *ServicePriceByCurrency) Reset() {
+	*x = ServicePriceByCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServicePriceByCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePriceByCurrency) ProtoMessage() {}
+
+func (x *ServicePriceByCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePriceByCurrency.ProtoReflect.Descriptor instead.
+func (*ServicePriceByCurrency) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ServicePriceByCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ServicePriceByCurrency) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ServicePriceByCurrency) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+type GetServicePriceCeilingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PriceCeilingByCurrencyList []*PriceCeilingByCurrency `protobuf:"bytes,1,rep,name=price_ceiling_by_currency_list,json=priceCeilingByCurrencyList,proto3" json:"price_ceiling_by_currency_list,omitempty"`
+}
+
+func (x *GetServicePriceCeilingResult) Reset() {
+	*x = GetServicePriceCeilingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServicePriceCeilingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicePriceCeilingResult) ProtoMessage() {}
+
+func (x *GetServicePriceCeilingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicePriceCeilingResult.ProtoReflect.Descriptor instead.
+func (*GetServicePriceCeilingResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetServicePriceCeilingResult) GetPriceCeilingByCurrencyList() []*PriceCeilingByCurrency {
+	if x != nil {
+		return x.PriceCeilingByCurrencyList
+	}
+	return nil
+}
+
+type PriceCeilingByCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Price    float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceCeilingByCurrency) Reset() {
+	*x = PriceCeilingByCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceCeilingByCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCeilingByCurrency) ProtoMessage() {}
+
+func (x *PriceCeilingByCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCeilingByCurrency.ProtoReflect.Descriptor instead.
+func (*PriceCeilingByCurrency) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PriceCeilingByCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceCeilingByCurrency) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type GetServicePriceMinEffectiveDatetimeDelayResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurationSecond uint32 `protobuf:"varint,1,opt,name=duration_second,json=durationSecond,proto3" json:"duration_second,omitempty"`
+}
+
+func (x *GetServicePriceMinEffectiveDatetimeDelayResult) Reset() {
+	*x = GetServicePriceMinEffectiveDatetimeDelayResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServicePriceMinEffectiveDatetimeDelayResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicePriceMinEffectiveDatetimeDelayResult) ProtoMessage() {}
+
+func (x *GetServicePriceMinEffectiveDatetimeDelayResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicePriceMinEffectiveDatetimeDelayResult.ProtoReflect.Descriptor instead.
+func (*GetServicePriceMinEffectiveDatetimeDelayResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetServicePriceMinEffectiveDatetimeDelayResult) GetDurationSecond() uint32 {
+	if x != nil {
+		return x.DurationSecond
+	}
+	return 0
+}
+
+type GetMessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetMessageResult) Reset() {
+	*x = GetMessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageResult) ProtoMessage() {}
+
+func (x *GetMessageResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageResult.ProtoReflect.Descriptor instead.
+func (*GetMessageResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetMessageResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetMessageDetailResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId           string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message             string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Purpose             string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	RequesterNodeId     string `protobuf:"bytes,4,opt,name=requester_node_id,json=requesterNodeId,proto3" json:"requester_node_id,omitempty"`
+	CreationBlockHeight int64  `protobuf:"varint,5,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	CreationChainId     string `protobuf:"bytes,6,opt,name=creation_chain_id,json=creationChainId,proto3" json:"creation_chain_id,omitempty"`
+}
+
+func (x *GetMessageDetailResult) Reset() {
+	*x = GetMessageDetailResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageDetailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageDetailResult) ProtoMessage() {}
+
+func (x *GetMessageDetailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageDetailResult.ProtoReflect.Descriptor instead.
+func (*GetMessageDetailResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetMessageDetailResult) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *GetMessageDetailResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMessageDetailResult) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *GetMessageDetailResult) GetRequesterNodeId() string {
+	if x != nil {
+		return x.RequesterNodeId
+	}
+	return ""
+}
+
+func (x *GetMessageDetailResult) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *GetMessageDetailResult) GetCreationChainId() string {
+	if x != nil {
+		return x.CreationChainId
+	}
+	return ""
+}
+
+type GetRequestTypeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetRequestTypeListResult) Reset() {
+	*x = GetRequestTypeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequestTypeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestTypeListResult) ProtoMessage() {}
+
+func (x *GetRequestTypeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestTypeListResult.ProtoReflect.Descriptor instead.
+func (*GetRequestTypeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetRequestTypeListResult) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetSuppressedIdentityModificationNotificationNodeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetSuppressedIdentityModificationNotificationNodeListResult) Reset() {
+	*x = GetSuppressedIdentityModificationNotificationNodeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSuppressedIdentityModificationNotificationNodeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuppressedIdentityModificationNotificationNodeListResult) ProtoMessage() {}
+
+func (x *GetSuppressedIdentityModificationNotificationNodeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuppressedIdentityModificationNotificationNodeListResult.ProtoReflect.Descriptor instead.
+func (*GetSuppressedIdentityModificationNotificationNodeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetSuppressedIdentityModificationNotificationNodeListResult) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type IsSuppressedIdentityModificationNotificationNodeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suppressed bool `protobuf:"varint,1,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+}
+
+func (x *IsSuppressedIdentityModificationNotificationNodeResult) Reset() {
+	*x = IsSuppressedIdentityModificationNotificationNodeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsSuppressedIdentityModificationNotificationNodeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsSuppressedIdentityModificationNotificationNodeResult) ProtoMessage() {}
+
+func (x *IsSuppressedIdentityModificationNotificationNodeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsSuppressedIdentityModificationNotificationNodeResult.ProtoReflect.Descriptor instead.
+func (*IsSuppressedIdentityModificationNotificationNodeResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *IsSuppressedIdentityModificationNotificationNodeResult) GetSuppressed() bool {
+	if x != nil {
+		return x.Suppressed
+	}
+	return false
+}
+
+type GetAllowedNodeSupportedFeatureListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetAllowedNodeSupportedFeatureListResult) Reset() {
+	*x = GetAllowedNodeSupportedFeatureListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllowedNodeSupportedFeatureListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedNodeSupportedFeatureListResult) ProtoMessage() {}
+
+func (x *GetAllowedNodeSupportedFeatureListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedNodeSupportedFeatureListResult.ProtoReflect.Descriptor instead.
+func (*GetAllowedNodeSupportedFeatureListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAllowedNodeSupportedFeatureListResult) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetIdPAssociationTransferResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId             string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	FromNodeId             string `protobuf:"bytes,2,opt,name=from_node_id,json=fromNodeId,proto3" json:"from_node_id,omitempty"`
+	ToNodeId               string `protobuf:"bytes,3,opt,name=to_node_id,json=toNodeId,proto3" json:"to_node_id,omitempty"`
+	TransferAccessors      bool   `protobuf:"varint,4,opt,name=transfer_accessors,json=transferAccessors,proto3" json:"transfer_accessors,omitempty"`
+	Status                 string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastReferenceGroupCode string `protobuf:"bytes,6,opt,name=last_reference_group_code,json=lastReferenceGroupCode,proto3" json:"last_reference_group_code,omitempty"`
+	TransferredCount       int64  `protobuf:"varint,7,opt,name=transferred_count,json=transferredCount,proto3" json:"transferred_count,omitempty"`
+	SkippedCount           int64  `protobuf:"varint,8,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	CreationBlockHeight    int64  `protobuf:"varint,9,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	AcceptedBlockHeight    int64  `protobuf:"varint,10,opt,name=accepted_block_height,json=acceptedBlockHeight,proto3" json:"accepted_block_height,omitempty"`
+	CompletedBlockHeight   int64  `protobuf:"varint,11,opt,name=completed_block_height,json=completedBlockHeight,proto3" json:"completed_block_height,omitempty"`
+}
+
+func (x *GetIdPAssociationTransferResult) Reset() {
+	*x = GetIdPAssociationTransferResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdPAssociationTransferResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdPAssociationTransferResult) ProtoMessage() {}
+
+func (x *GetIdPAssociationTransferResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdPAssociationTransferResult.ProtoReflect.Descriptor instead.
+func (*GetIdPAssociationTransferResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetIdPAssociationTransferResult) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *GetIdPAssociationTransferResult) GetFromNodeId() string {
+	if x != nil {
+		return x.FromNodeId
+	}
+	return ""
+}
+
+func (x *GetIdPAssociationTransferResult) GetToNodeId() string {
+	if x != nil {
+		return x.ToNodeId
+	}
+	return ""
+}
+
+func (x *GetIdPAssociationTransferResult) GetTransferAccessors() bool {
+	if x != nil {
+		return x.TransferAccessors
+	}
+	return false
+}
+
+func (x *GetIdPAssociationTransferResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetIdPAssociationTransferResult) GetLastReferenceGroupCode() string {
+	if x != nil {
+		return x.LastReferenceGroupCode
+	}
+	return ""
+}
+
+func (x *GetIdPAssociationTransferResult) GetTransferredCount() int64 {
+	if x != nil {
+		return x.TransferredCount
+	}
+	return 0
+}
+
+func (x *GetIdPAssociationTransferResult) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *GetIdPAssociationTransferResult) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *GetIdPAssociationTransferResult) GetAcceptedBlockHeight() int64 {
+	if x != nil {
+		return x.AcceptedBlockHeight
+	}
+	return 0
+}
+
+func (x *GetIdPAssociationTransferResult) GetCompletedBlockHeight() int64 {
+	if x != nil {
+		return x.CompletedBlockHeight
+	}
+	return 0
+}
+
+type NodeCertificateAuthorityResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaId                string `protobuf:"bytes,1,opt,name=ca_id,json=caId,proto3" json:"ca_id,omitempty"`
+	Certificate         string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Root                bool   `protobuf:"varint,3,opt,name=root,proto3" json:"root,omitempty"`
+	Subject             string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreationBlockHeight int64  `protobuf:"varint,5,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+}
+
+func (x *NodeCertificateAuthorityResult) Reset() {
+	*x = NodeCertificateAuthorityResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCertificateAuthorityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCertificateAuthorityResult) ProtoMessage() {}
+
+func (x *NodeCertificateAuthorityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCertificateAuthorityResult.ProtoReflect.Descriptor instead.
+func (*NodeCertificateAuthorityResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *NodeCertificateAuthorityResult) GetCaId() string {
+	if x != nil {
+		return x.CaId
+	}
+	return ""
+}
+
+func (x *NodeCertificateAuthorityResult) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *NodeCertificateAuthorityResult) GetRoot() bool {
+	if x != nil {
+		return x.Root
+	}
+	return false
+}
+
+func (x *NodeCertificateAuthorityResult) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *NodeCertificateAuthorityResult) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+type GetNodeCertificateAuthorityListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*NodeCertificateAuthorityResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetNodeCertificateAuthorityListResult) Reset() {
+	*x = GetNodeCertificateAuthorityListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeCertificateAuthorityListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeCertificateAuthorityListResult) ProtoMessage() {}
+
+func (x *GetNodeCertificateAuthorityListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeCertificateAuthorityListResult.ProtoReflect.Descriptor instead.
+func (*GetNodeCertificateAuthorityListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetNodeCertificateAuthorityListResult) GetItems() []*NodeCertificateAuthorityResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetDecommissionedNodeInfoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId                          string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Role                            string  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	RefundTokenAmount               float64 `protobuf:"fixed64,3,opt,name=refund_token_amount,json=refundTokenAmount,proto3" json:"refund_token_amount,omitempty"`
+	ClosedRequestCount              int64   `protobuf:"varint,4,opt,name=closed_request_count,json=closedRequestCount,proto3" json:"closed_request_count,omitempty"`
+	DisabledServiceDestinationCount int64   `protobuf:"varint,5,opt,name=disabled_service_destination_count,json=disabledServiceDestinationCount,proto3" json:"disabled_service_destination_count,omitempty"`
+	DisabledIdpAssociationCount     int64   `protobuf:"varint,6,opt,name=disabled_idp_association_count,json=disabledIdpAssociationCount,proto3" json:"disabled_idp_association_count,omitempty"`
+	DecommissionBlockHeight         int64   `protobuf:"varint,7,opt,name=decommission_block_height,json=decommissionBlockHeight,proto3" json:"decommission_block_height,omitempty"`
+}
+
+func (x *GetDecommissionedNodeInfoResult) Reset() {
+	*x = GetDecommissionedNodeInfoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDecommissionedNodeInfoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDecommissionedNodeInfoResult) ProtoMessage() {}
+
+func (x *GetDecommissionedNodeInfoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDecommissionedNodeInfoResult.ProtoReflect.Descriptor instead.
+func (*GetDecommissionedNodeInfoResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetRefundTokenAmount() float64 {
+	if x != nil {
+		return x.RefundTokenAmount
+	}
+	return 0
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetClosedRequestCount() int64 {
+	if x != nil {
+		return x.ClosedRequestCount
+	}
+	return 0
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetDisabledServiceDestinationCount() int64 {
+	if x != nil {
+		return x.DisabledServiceDestinationCount
+	}
+	return 0
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetDisabledIdpAssociationCount() int64 {
+	if x != nil {
+		return x.DisabledIdpAssociationCount
+	}
+	return 0
+}
+
+func (x *GetDecommissionedNodeInfoResult) GetDecommissionBlockHeight() int64 {
+	if x != nil {
+		return x.DecommissionBlockHeight
+	}
+	return 0
+}
+
+type GetStaleNodeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeList []*StaleNode `protobuf:"bytes,1,rep,name=node_list,json=nodeList,proto3" json:"node_list,omitempty"`
+}
+
+func (x *GetStaleNodeListResult) Reset() {
+	*x = GetStaleNodeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStaleNodeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStaleNodeListResult) ProtoMessage() {}
+
+func (x *GetStaleNodeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStaleNodeListResult.ProtoReflect.Descriptor instead.
+func (*GetStaleNodeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetStaleNodeListResult) GetNodeList() []*StaleNode {
+	if x != nil {
+		return x.NodeList
+	}
+	return nil
+}
+
+type StaleNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId              string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName            string `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Role                string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	LastSeenBlockHeight int64  `protobuf:"varint,4,opt,name=last_seen_block_height,json=lastSeenBlockHeight,proto3" json:"last_seen_block_height,omitempty"`
+	LastSeenBlockTime   int64  `protobuf:"varint,5,opt,name=last_seen_block_time,json=lastSeenBlockTime,proto3" json:"last_seen_block_time,omitempty"`
+}
+
+func (x *StaleNode) Reset() {
+	*x = StaleNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaleNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleNode) ProtoMessage() {}
+
+func (x *StaleNode) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleNode.ProtoReflect.Descriptor instead.
+func (*StaleNode) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StaleNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StaleNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *StaleNode) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StaleNode) GetLastSeenBlockHeight() int64 {
+	if x != nil {
+		return x.LastSeenBlockHeight
+	}
+	return 0
+}
+
+func (x *StaleNode) GetLastSeenBlockTime() int64 {
+	if x != nil {
+		return x.LastSeenBlockTime
+	}
+	return 0
+}
+
+type GetValidatorListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorList []*ValidatorInfo `protobuf:"bytes,1,rep,name=validator_list,json=validatorList,proto3" json:"validator_list,omitempty"`
+}
+
+func (x *GetValidatorListResult) Reset() {
+	*x = GetValidatorListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorListResult) ProtoMessage() {}
+
+func (x *GetValidatorListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorListResult.ProtoReflect.Descriptor instead.
+func (*GetValidatorListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetValidatorListResult) GetValidatorList() []*ValidatorInfo {
+	if x != nil {
+		return x.ValidatorList
+	}
+	return nil
+}
+
+type ValidatorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey        string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Power            int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	OperatorNodeId   string `protobuf:"bytes,3,opt,name=operator_node_id,json=operatorNodeId,proto3" json:"operator_node_id,omitempty"`
+	Moniker          string `protobuf:"bytes,4,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	AddedBlockHeight int64  `protobuf:"varint,5,opt,name=added_block_height,json=addedBlockHeight,proto3" json:"added_block_height,omitempty"`
+}
+
+func (x *ValidatorInfo) Reset() {
+	*x = ValidatorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorInfo) ProtoMessage() {}
+
+func (x *ValidatorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ValidatorInfo) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ValidatorInfo) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ValidatorInfo) GetOperatorNodeId() string {
+	if x != nil {
+		return x.OperatorNodeId
+	}
+	return ""
+}
+
+func (x *ValidatorInfo) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *ValidatorInfo) GetAddedBlockHeight() int64 {
+	if x != nil {
+		return x.AddedBlockHeight
+	}
+	return 0
+}
+
+type GetValidatorHistoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey    string                  `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PowerHistory []*ValidatorPowerChange `protobuf:"bytes,2,rep,name=power_history,json=powerHistory,proto3" json:"power_history,omitempty"`
+}
+
+func (x *GetValidatorHistoryResult) Reset() {
+	*x = GetValidatorHistoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorHistoryResult) ProtoMessage() {}
+
+func (x *GetValidatorHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorHistoryResult.ProtoReflect.Descriptor instead.
+func (*GetValidatorHistoryResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetValidatorHistoryResult) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *GetValidatorHistoryResult) GetPowerHistory() []*ValidatorPowerChange {
+	if x != nil {
+		return x.PowerHistory
+	}
+	return nil
+}
+
+type ValidatorPowerChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Power       int64  `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	SetByNodeId string `protobuf:"bytes,3,opt,name=set_by_node_id,json=setByNodeId,proto3" json:"set_by_node_id,omitempty"`
+}
+
+func (x *ValidatorPowerChange) Reset() {
+	*x = ValidatorPowerChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPowerChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPowerChange) ProtoMessage() {}
+
+func (x *ValidatorPowerChange) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorPowerChange.ProtoReflect.Descriptor instead.
+func (*ValidatorPowerChange) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ValidatorPowerChange) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ValidatorPowerChange) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ValidatorPowerChange) GetSetByNodeId() string {
+	if x != nil {
+		return x.SetByNodeId
+	}
+	return ""
+}
+
+type GetValidatorPowerCapResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxVotingPower      int64 `protobuf:"varint,1,opt,name=max_voting_power,json=maxVotingPower,proto3" json:"max_voting_power,omitempty"`
+	MaxTotalVotingPower int64 `protobuf:"varint,2,opt,name=max_total_voting_power,json=maxTotalVotingPower,proto3" json:"max_total_voting_power,omitempty"`
+}
+
+func (x *GetValidatorPowerCapResult) Reset() {
+	*x = GetValidatorPowerCapResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidatorPowerCapResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidatorPowerCapResult) ProtoMessage() {}
+
+func (x *GetValidatorPowerCapResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidatorPowerCapResult.ProtoReflect.Descriptor instead.
+func (*GetValidatorPowerCapResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetValidatorPowerCapResult) GetMaxVotingPower() int64 {
+	if x != nil {
+		return x.MaxVotingPower
+	}
+	return 0
+}
+
+func (x *GetValidatorPowerCapResult) GetMaxTotalVotingPower() int64 {
+	if x != nil {
+		return x.MaxTotalVotingPower
+	}
+	return 0
+}
+
+// GetAdminPermission
+type AdminPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId             string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Scopes             []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	GrantedBlockHeight int64    `protobuf:"varint,3,opt,name=granted_block_height,json=grantedBlockHeight,proto3" json:"granted_block_height,omitempty"`
+}
+
+func (x *AdminPermission) Reset() {
+	*x = AdminPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPermission) ProtoMessage() {}
+
+func (x *AdminPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPermission.ProtoReflect.Descriptor instead.
+func (*AdminPermission) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdminPermission) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *AdminPermission) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AdminPermission) GetGrantedBlockHeight() int64 {
+	if x != nil {
+		return x.GrantedBlockHeight
+	}
+	return 0
+}
+
+type GetAdminPermissionListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminPermissionList []*AdminPermission `protobuf:"bytes,1,rep,name=admin_permission_list,json=adminPermissionList,proto3" json:"admin_permission_list,omitempty"`
+}
+
+func (x *GetAdminPermissionListResult) Reset() {
+	*x = GetAdminPermissionListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdminPermissionListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminPermissionListResult) ProtoMessage() {}
+
+func (x *GetAdminPermissionListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminPermissionListResult.ProtoReflect.Descriptor instead.
+func (*GetAdminPermissionListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAdminPermissionListResult) GetAdminPermissionList() []*AdminPermission {
+	if x != nil {
+		return x.AdminPermissionList
+	}
+	return nil
+}
+
+type GetGovernanceConfigResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberNodeIdList []string `protobuf:"bytes,1,rep,name=member_node_id_list,json=memberNodeIdList,proto3" json:"member_node_id_list,omitempty"`
+	Threshold        int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *GetGovernanceConfigResult) Reset() {
+	*x = GetGovernanceConfigResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGovernanceConfigResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGovernanceConfigResult) ProtoMessage() {}
+
+func (x *GetGovernanceConfigResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGovernanceConfigResult.ProtoReflect.Descriptor instead.
+func (*GetGovernanceConfigResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetGovernanceConfigResult) GetMemberNodeIdList() []string {
+	if x != nil {
+		return x.MemberNodeIdList
+	}
+	return nil
+}
+
+func (x *GetGovernanceConfigResult) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// GetProposal
+type ProposalInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId          string          `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Method              string          `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params              *structpb.Value `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	ProposerNodeId      string          `protobuf:"bytes,4,opt,name=proposer_node_id,json=proposerNodeId,proto3" json:"proposer_node_id,omitempty"`
+	ApproverNodeIdList  []string        `protobuf:"bytes,5,rep,name=approver_node_id_list,json=approverNodeIdList,proto3" json:"approver_node_id_list,omitempty"`
+	CreationBlockHeight int64           `protobuf:"varint,6,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	ExpiryBlockHeight   int64           `protobuf:"varint,7,opt,name=expiry_block_height,json=expiryBlockHeight,proto3" json:"expiry_block_height,omitempty"`
+	Status              string          `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ExecutedBlockHeight int64           `protobuf:"varint,9,opt,name=executed_block_height,json=executedBlockHeight,proto3" json:"executed_block_height,omitempty"`
+	ResultCode          uint32          `protobuf:"varint,10,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	ResultLog           string          `protobuf:"bytes,11,opt,name=result_log,json=resultLog,proto3" json:"result_log,omitempty"`
+}
+
+func (x *ProposalInfo) Reset() {
+	*x = ProposalInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalInfo) ProtoMessage() {}
+
+func (x *ProposalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalInfo.ProtoReflect.Descriptor instead.
+func (*ProposalInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ProposalInfo) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *ProposalInfo) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ProposalInfo) GetParams() *structpb.Value {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ProposalInfo) GetProposerNodeId() string {
+	if x != nil {
+		return x.ProposerNodeId
+	}
+	return ""
+}
+
+func (x *ProposalInfo) GetApproverNodeIdList() []string {
+	if x != nil {
+		return x.ApproverNodeIdList
+	}
+	return nil
+}
+
+func (x *ProposalInfo) GetCreationBlockHeight() int64 {
+	if x != nil {
+		return x.CreationBlockHeight
+	}
+	return 0
+}
+
+func (x *ProposalInfo) GetExpiryBlockHeight() int64 {
+	if x != nil {
+		return x.ExpiryBlockHeight
+	}
+	return 0
+}
+
+func (x *ProposalInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProposalInfo) GetExecutedBlockHeight() int64 {
+	if x != nil {
+		return x.ExecutedBlockHeight
+	}
+	return 0
+}
+
+func (x *ProposalInfo) GetResultCode() uint32 {
+	if x != nil {
+		return x.ResultCode
+	}
+	return 0
+}
+
+func (x *ProposalInfo) GetResultLog() string {
+	if x != nil {
+		return x.ResultLog
+	}
+	return ""
+}
+
+type GetProposalListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalList []*ProposalInfo `protobuf:"bytes,1,rep,name=proposal_list,json=proposalList,proto3" json:"proposal_list,omitempty"`
+}
+
+func (x *GetProposalListResult) Reset() {
+	*x = GetProposalListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProposalListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProposalListResult) ProtoMessage() {}
+
+func (x *GetProposalListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProposalListResult.ProtoReflect.Descriptor instead.
+func (*GetProposalListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetProposalListResult) GetProposalList() []*ProposalInfo {
+	if x != nil {
+		return x.ProposalList
+	}
+	return nil
+}
+
+type GetScheduledChangeListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledChangeList []*ScheduledChange `protobuf:"bytes,1,rep,name=scheduled_change_list,json=scheduledChangeList,proto3" json:"scheduled_change_list,omitempty"`
+}
+
+func (x *GetScheduledChangeListResult) Reset() {
+	*x = GetScheduledChangeListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScheduledChangeListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledChangeListResult) ProtoMessage() {}
+
+func (x *GetScheduledChangeListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledChangeListResult.ProtoReflect.Descriptor instead.
+func (*GetScheduledChangeListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetScheduledChangeListResult) GetScheduledChangeList() []*ScheduledChange {
+	if x != nil {
+		return x.ScheduledChangeList
+	}
+	return nil
+}
+
+type ScheduledChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId             int64           `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Method               string          `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Params               *structpb.Value `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	NodeId               string          `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ActivationHeight     int64           `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	ActivationBlockTime  int64           `protobuf:"varint,6,opt,name=activation_block_time,json=activationBlockTime,proto3" json:"activation_block_time,omitempty"`
+	ScheduledBlockHeight int64           `protobuf:"varint,7,opt,name=scheduled_block_height,json=scheduledBlockHeight,proto3" json:"scheduled_block_height,omitempty"`
+}
+
+func (x *ScheduledChange) Reset() {
+	*x = ScheduledChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledChange) ProtoMessage() {}
+
+func (x *ScheduledChange) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledChange.ProtoReflect.Descriptor instead.
+func (*ScheduledChange) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ScheduledChange) GetChangeId() int64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *ScheduledChange) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetParams() *structpb.Value {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ScheduledChange) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ScheduledChange) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *ScheduledChange) GetActivationBlockTime() int64 {
+	if x != nil {
+		return x.ActivationBlockTime
+	}
+	return 0
+}
+
+func (x *ScheduledChange) GetScheduledBlockHeight() int64 {
+	if x != nil {
+		return x.ScheduledBlockHeight
+	}
+	return 0
+}
+
+type GetPausedMethodListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PausedMethodList []*PausedMethod `protobuf:"bytes,1,rep,name=paused_method_list,json=pausedMethodList,proto3" json:"paused_method_list,omitempty"`
+}
+
+func (x *GetPausedMethodListResult) Reset() {
+	*x = GetPausedMethodListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPausedMethodListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPausedMethodListResult) ProtoMessage() {}
+
+func (x *GetPausedMethodListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPausedMethodListResult.ProtoReflect.Descriptor instead.
+func (*GetPausedMethodListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetPausedMethodListResult) GetPausedMethodList() []*PausedMethod {
+	if x != nil {
+		return x.PausedMethodList
+	}
+	return nil
+}
+
+type PausedMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method            string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MethodGroup       string   `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
+	Roles             []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	NodeIdList        []string `protobuf:"bytes,4,rep,name=node_id_list,json=nodeIdList,proto3" json:"node_id_list,omitempty"`
+	Reason            string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	PausedBlockHeight int64    `protobuf:"varint,6,opt,name=paused_block_height,json=pausedBlockHeight,proto3" json:"paused_block_height,omitempty"`
+	PausedByNodeId    string   `protobuf:"bytes,7,opt,name=paused_by_node_id,json=pausedByNodeId,proto3" json:"paused_by_node_id,omitempty"`
+}
+
+func (x *PausedMethod) Reset() {
+	*x = PausedMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausedMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausedMethod) ProtoMessage() {}
+
+func (x *PausedMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausedMethod.ProtoReflect.Descriptor instead.
+func (*PausedMethod) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PausedMethod) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PausedMethod) GetMethodGroup() string {
+	if x != nil {
+		return x.MethodGroup
+	}
+	return ""
+}
+
+func (x *PausedMethod) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *PausedMethod) GetNodeIdList() []string {
+	if x != nil {
+		return x.NodeIdList
+	}
+	return nil
+}
+
+func (x *PausedMethod) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PausedMethod) GetPausedBlockHeight() int64 {
+	if x != nil {
+		return x.PausedBlockHeight
+	}
+	return 0
+}
+
+func (x *PausedMethod) GetPausedByNodeId() string {
+	if x != nil {
+		return x.PausedByNodeId
+	}
+	return ""
+}
+
+type GetUpgradePlanResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentProtocolVersion uint64                  `protobuf:"varint,1,opt,name=current_protocol_version,json=currentProtocolVersion,proto3" json:"current_protocol_version,omitempty"`
+	ActivationList         []*AppVersionActivation `protobuf:"bytes,2,rep,name=activation_list,json=activationList,proto3" json:"activation_list,omitempty"`
+}
+
+func (x *GetUpgradePlanResult) Reset() {
+	*x = GetUpgradePlanResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpgradePlanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradePlanResult) ProtoMessage() {}
+
+func (x *GetUpgradePlanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradePlanResult.ProtoReflect.Descriptor instead.
+func (*GetUpgradePlanResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetUpgradePlanResult) GetCurrentProtocolVersion() uint64 {
+	if x != nil {
+		return x.CurrentProtocolVersion
+	}
+	return 0
+}
+
+func (x *GetUpgradePlanResult) GetActivationList() []*AppVersionActivation {
+	if x != nil {
+		return x.ActivationList
+	}
+	return nil
+}
+
+type AppVersionActivation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolVersion      uint64 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ActivationHeight     int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Name                 string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ScheduledBlockHeight int64  `protobuf:"varint,4,opt,name=scheduled_block_height,json=scheduledBlockHeight,proto3" json:"scheduled_block_height,omitempty"`
+}
+
+func (x *AppVersionActivation) Reset() {
+	*x = AppVersionActivation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppVersionActivation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppVersionActivation) ProtoMessage() {}
+
+func (x *AppVersionActivation) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppVersionActivation.ProtoReflect.Descriptor instead.
+func (*AppVersionActivation) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AppVersionActivation) GetProtocolVersion() uint64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *AppVersionActivation) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *AppVersionActivation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppVersionActivation) GetScheduledBlockHeight() int64 {
+	if x != nil {
+		return x.ScheduledBlockHeight
+	}
+	return 0
+}
+
+// GetParameter
+type ParameterInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type         string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value        *structpb.Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	IsDefault    bool            `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	DefaultValue *structpb.Value `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Min          *float64        `protobuf:"fixed64,6,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max          *float64        `protobuf:"fixed64,7,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Description  string          `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ParameterInfo) Reset() {
+	*x = ParameterInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParameterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterInfo) ProtoMessage() {}
+
+func (x *ParameterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterInfo.ProtoReflect.Descriptor instead.
+func (*ParameterInfo) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ParameterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParameterInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParameterInfo) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ParameterInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *ParameterInfo) GetDefaultValue() *structpb.Value {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *ParameterInfo) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ParameterInfo) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *ParameterInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetParameterListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ParameterInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetParameterListResult) Reset() {
+	*x = GetParameterListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParameterListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParameterListResult) ProtoMessage() {}
+
+func (x *GetParameterListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParameterListResult.ProtoReflect.Descriptor instead.
+func (*GetParameterListResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetParameterListResult) GetItems() []*ParameterInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchQueryItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchQueryResult) Reset() {
+	*x = BatchQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchQueryResult) ProtoMessage() {}
+
+func (x *BatchQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchQueryResult.ProtoReflect.Descriptor instead.
+func (*BatchQueryResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BatchQueryResult) GetResults() []*BatchQueryItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchQueryItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method string          `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Code   uint32          `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Log    string          `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	Value  *structpb.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BatchQueryItemResult) Reset() {
+	*x = BatchQueryItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchQueryItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchQueryItemResult) ProtoMessage() {}
+
+func (x *BatchQueryItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchQueryItemResult.ProtoReflect.Descriptor instead.
+func (*BatchQueryItemResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *BatchQueryItemResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BatchQueryItemResult) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchQueryItemResult) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *BatchQueryItemResult) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_query_proto protoreflect.FileDescriptor
+
+var file_query_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76,
+	0x39, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xb5, 0x02, 0x0a, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x5b, 0x0a, 0x13, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61,
+	0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xbb, 0x02, 0x0a, 0x16, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x64, 0x70, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x49, 0x64,
+	0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x07,
+	0x49, 0x64, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x61, 0x6c, 0x12,
+	0x34, 0x0a, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x14, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x6c, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x04, 0x6c, 0x69,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x2c, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72,
+	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x26, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x69, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x61, 0x6c,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x61, 0x61, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64,
+	0x4f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xb8, 0x05, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x70, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x41, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x64, 0x70, 0x5f, 0x69,
+	0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x64,
+	0x70, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x76, 0x39, 0x2e, 0x49, 0x64, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x26, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x49, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x41, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x41, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb8, 0x01,
+	0x0a, 0x0a, 0x41, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05,
+	0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x0b, 0x49, 0x64, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x61, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03,
+	0x61, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x64, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x64, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x04, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x2c, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x06, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x61, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x61,
+	0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x41, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x41, 0x73,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x06, 0x41,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69,
+	0x6e, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41, 0x61, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x09, 0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3e,
+	0x0a, 0x1b, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x19, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69, 0x64,
+	0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4d,
+	0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2a, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e,
+	0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x5b, 0x0a, 0x2b,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x26, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x68, 0x0a, 0x32, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x2c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x22, 0x6e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76,
+	0x39, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x22, 0x84, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x70, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x9d, 0x07, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x56, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x15, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64,
+	0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x49, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x2c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x26, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x18, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x03, 0x52, 0x15, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x12, 0x2d, 0x0a, 0x02, 0x6d, 0x71, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76,
+	0x39, 0x2e, 0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x6d, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x61, 0x6c, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x22, 0x80, 0x03, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b,
+	0x65, 0x79, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61,
+	0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x15,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x02, 0x6d, 0x71, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69, 0x64,
+	0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4d,
+	0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x6d, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0xc0, 0x02, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x11, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x65, 0x67, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x6f, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27,
+	0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcc, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61,
+	0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x1e, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52,
+	0x1a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x1a, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x17, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x61, 0x6c, 0x12,
+	0x17, 0x0a, 0x04, 0x6c, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x04, 0x6c, 0x69, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x61, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x04, 0x6c, 0x61, 0x61, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x61, 0x6c, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6c, 0x61, 0x61, 0x6c, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x79, 0x41,
+	0x73, 0x49, 0x44, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x6d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x64, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x49, 0x64, 0x70, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x9b,
+	0x06, 0x0a, 0x0b, 0x49, 0x64, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61,
+	0x78, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x61, 0x6c, 0x12, 0x34, 0x0a,
+	0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x10, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f,
+	0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x2d, 0x0a, 0x02, 0x6d, 0x71, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39,
+	0x2e, 0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x6d, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x18, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x15, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x03, 0x69, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x69,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x5c, 0x0a, 0x2c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x26, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x49, 0x64, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x39, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x61, 0x6c, 0x22, 0x8e, 0x02, 0x0a,
+	0x10, 0x49, 0x64, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69,
+	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x02, 0x6d, 0x71, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x02, 0x6d, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x55, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x41, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x32, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x76, 0x39, 0x2e, 0x41, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x22, 0xe2, 0x03, 0x0a, 0x0a, 0x41, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x41,
+	0x61, 0x6c, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x10, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a,
+	0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76,
+	0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d,
+	0x0a, 0x02, 0x6d, 0x71, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e,
+	0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x6d, 0x71, 0x12, 0x38, 0x0a,
+	0x18, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x16, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x41, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x89, 0x02, 0x0a, 0x0b, 0x41, 0x73,
+	0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x10, 0x73, 0x69, 0x67,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a,
+	0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+	0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76,
+	0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d,
+	0x0a, 0x02, 0x6d, 0x71, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e,
+	0x4d, 0x71, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x02, 0x6d, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42,
+	0x65, 0x68, 0x69, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x22, 0xd9, 0x04, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x10,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x56, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x16, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61,
+	0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x49,
+	0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x61, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x5c, 0x0a, 0x2c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x26, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x55, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x58, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x11, 0x49, 0x73, 0x49, 0x6e,
+	0x69, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70,
+	0x70, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4f, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x49, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x49, 0x41, 0x4c, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x49, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x41, 0x4c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01,
+	0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x61, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x33, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x46, 0x6f,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x41, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x49, 0x61, 0x6c, 0x22, 0x74, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x12, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x72, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61,
+	0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62,
+	0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63,
+	0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f,
+	0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x22, 0x6e, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x6e, 0x0a, 0x1e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x1a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x4a, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x42, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x59,
+	0x0a, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4d, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
+	0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x22, 0x30, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x53, 0x0a, 0x3b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x58, 0x0a, 0x36, 0x49, 0x73, 0x53, 0x75,
+	0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x22, 0x40, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x64, 0x50, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x74,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x1e,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x13,
+	0x0a, 0x05, 0x63, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x61, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x71, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x48, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfe, 0x02, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a,
+	0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x1e, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x70, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x1b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x70, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x19, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x17, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x54, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f,
+	0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f,
+	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x89, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x74, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0e, 0x73, 0x65, 0x74,
+	0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x7b,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x77, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x57, 0x0a, 0x15, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0xc4, 0x03, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x67, 0x22, 0x5e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x64,
+	0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x15, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x13, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6b, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x12, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62,
+	0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x22, 0xa3, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x76, 0x39, 0x2e, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x39, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x56, 0x0a, 0x10, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6e, 0x64, 0x69, 0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x76, 0x39, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x17, 0x5a, 0x15, 0x2e, 0x2f, 0x3b, 0x6e, 0x64, 0x69,
+	0x64, 0x5f, 0x61, 0x62, 0x63, 0x69, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x39, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_query_proto_rawDescOnce sync.Once
+	file_query_proto_rawDescData = file_query_proto_rawDesc
+)
+
+func file_query_proto_rawDescGZIP() []byte {
+	file_query_proto_rawDescOnce.Do(func() {
+		file_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_query_proto_rawDescData)
+	})
+	return file_query_proto_rawDescData
+}
+
+var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_query_proto_goTypes = []interface{}{
+	(*NodeKey)(nil),                                             // 0: ndid_abci_query_v9.NodeKey
+	(*NodeCertificateSubject)(nil),                              // 1: ndid_abci_query_v9.NodeCertificateSubject
+	(*GetIdpNodesResult)(nil),                                   // 2: ndid_abci_query_v9.GetIdpNodesResult
+	(*IdpNode)(nil),                                             // 3: ndid_abci_query_v9.IdpNode
+	(*GetRequestResult)(nil),                                    // 4: ndid_abci_query_v9.GetRequestResult
+	(*GetRequestDetailResult)(nil),                              // 5: ndid_abci_query_v9.GetRequestDetailResult
+	(*DataRequest)(nil),                                         // 6: ndid_abci_query_v9.DataRequest
+	(*AsResponse)(nil),                                          // 7: ndid_abci_query_v9.AsResponse
+	(*IdpResponse)(nil),                                         // 8: ndid_abci_query_v9.IdpResponse
+	(*GetAsNodesByServiceIdResult)(nil),                         // 9: ndid_abci_query_v9.GetAsNodesByServiceIdResult
+	(*AsNode)(nil),                                              // 10: ndid_abci_query_v9.AsNode
+	(*MqAddress)(nil),                                           // 11: ndid_abci_query_v9.MqAddress
+	(*GetMqAddressesResult)(nil),                                // 12: ndid_abci_query_v9.GetMqAddressesResult
+	(*GetNodeTokenResult)(nil),                                  // 13: ndid_abci_query_v9.GetNodeTokenResult
+	(*GetPriceFuncResult)(nil),                                  // 14: ndid_abci_query_v9.GetPriceFuncResult
+	(*ServiceDetail)(nil),                                       // 15: ndid_abci_query_v9.ServiceDetail
+	(*Namespace)(nil),                                           // 16: ndid_abci_query_v9.Namespace
+	(*GetNamespaceListResult)(nil),                              // 17: ndid_abci_query_v9.GetNamespaceListResult
+	(*CheckExistingIdentityResult)(nil),                         // 18: ndid_abci_query_v9.CheckExistingIdentityResult
+	(*GetAccessorKeyResult)(nil),                                // 19: ndid_abci_query_v9.GetAccessorKeyResult
+	(*GetServiceListResult)(nil),                                // 20: ndid_abci_query_v9.GetServiceListResult
+	(*GetNodeInfoResult)(nil),                                   // 21: ndid_abci_query_v9.GetNodeInfoResult
+	(*ProxyNodeInfo)(nil),                                       // 22: ndid_abci_query_v9.ProxyNodeInfo
+	(*NodeProfile)(nil),                                         // 23: ndid_abci_query_v9.NodeProfile
+	(*GetNodePublicKeyListResult)(nil),                          // 24: ndid_abci_query_v9.GetNodePublicKeyListResult
+	(*CheckExistingResult)(nil),                                 // 25: ndid_abci_query_v9.CheckExistingResult
+	(*GetIdentityInfoResult)(nil),                               // 26: ndid_abci_query_v9.GetIdentityInfoResult
+	(*GetDataSignatureResult)(nil),                              // 27: ndid_abci_query_v9.GetDataSignatureResult
+	(*GetServicesByAsIDResult)(nil),                             // 28: ndid_abci_query_v9.GetServicesByAsIDResult
+	(*Service)(nil),                                             // 29: ndid_abci_query_v9.Service
+	(*GetIdpNodesInfoResult)(nil),                               // 30: ndid_abci_query_v9.GetIdpNodesInfoResult
+	(*IdpNodeInfo)(nil),                                         // 31: ndid_abci_query_v9.IdpNodeInfo
+	(*IdpNodeInfoProxy)(nil),                                    // 32: ndid_abci_query_v9.IdpNodeInfoProxy
+	(*GetAsNodesInfoByServiceIdResult)(nil),                     // 33: ndid_abci_query_v9.GetAsNodesInfoByServiceIdResult
+	(*AsNodeInfo)(nil),                                          // 34: ndid_abci_query_v9.AsNodeInfo
+	(*AsNodeProxy)(nil),                                         // 35: ndid_abci_query_v9.AsNodeProxy
+	(*GetNodesBehindProxyNodeResult)(nil),                       // 36: ndid_abci_query_v9.GetNodesBehindProxyNodeResult
+	(*NodeBehindProxy)(nil),                                     // 37: ndid_abci_query_v9.NodeBehindProxy
+	(*GetNodeIDListResult)(nil),                                 // 38: ndid_abci_query_v9.GetNodeIDListResult
+	(*GetAccessorOwnerResult)(nil),                              // 39: ndid_abci_query_v9.GetAccessorOwnerResult
+	(*ErrorCode)(nil),                                           // 40: ndid_abci_query_v9.ErrorCode
+	(*GetErrorCodeListResult)(nil),                              // 41: ndid_abci_query_v9.GetErrorCodeListResult
+	(*IsInitEndedResult)(nil),                                   // 42: ndid_abci_query_v9.IsInitEndedResult
+	(*GetChainHistoryResult)(nil),                               // 43: ndid_abci_query_v9.GetChainHistoryResult
+	(*ChainHistoryDetail)(nil),                                  // 44: ndid_abci_query_v9.ChainHistoryDetail
+	(*GetReferenceGroupCodeResult)(nil),                         // 45: ndid_abci_query_v9.GetReferenceGroupCodeResult
+	(*GetSupportedIALListResult)(nil),                           // 46: ndid_abci_query_v9.GetSupportedIALListResult
+	(*GetSupportedAALListResult)(nil),                           // 47: ndid_abci_query_v9.GetSupportedAALListResult
+	(*GetAllowedModeListResult)(nil),                            // 48: ndid_abci_query_v9.GetAllowedModeListResult
+	(*GetAllowedMinIalForRegisterIdentityAtFirstIdpResult)(nil), // 49: ndid_abci_query_v9.GetAllowedMinIalForRegisterIdentityAtFirstIdpResult
+	(*GetServicePriceListResult)(nil),                           // 50: ndid_abci_query_v9.GetServicePriceListResult
+	(*ServicePriceListByNode)(nil),                              // 51: ndid_abci_query_v9.ServicePriceListByNode
+	(*ServicePrice)(nil),                                        // 52: ndid_abci_query_v9.ServicePrice
+	(*ServicePriceByCurrency)(nil),                              // 53: ndid_abci_query_v9.ServicePriceByCurrency
+	(*GetServicePriceCeilingResult)(nil),                        // 54: ndid_abci_query_v9.GetServicePriceCeilingResult
+	(*PriceCeilingByCurrency)(nil),                              // 55: ndid_abci_query_v9.PriceCeilingByCurrency
+	(*GetServicePriceMinEffectiveDatetimeDelayResult)(nil),      // 56: ndid_abci_query_v9.GetServicePriceMinEffectiveDatetimeDelayResult
+	(*GetMessageResult)(nil),                                    // 57: ndid_abci_query_v9.GetMessageResult
+	(*GetMessageDetailResult)(nil),                              // 58: ndid_abci_query_v9.GetMessageDetailResult
+	(*GetRequestTypeListResult)(nil),                            // 59: ndid_abci_query_v9.GetRequestTypeListResult
+	(*GetSuppressedIdentityModificationNotificationNodeListResult)(nil), // 60: ndid_abci_query_v9.GetSuppressedIdentityModificationNotificationNodeListResult
+	(*IsSuppressedIdentityModificationNotificationNodeResult)(nil),      // 61: ndid_abci_query_v9.IsSuppressedIdentityModificationNotificationNodeResult
+	(*GetAllowedNodeSupportedFeatureListResult)(nil),                    // 62: ndid_abci_query_v9.GetAllowedNodeSupportedFeatureListResult
+	(*GetIdPAssociationTransferResult)(nil),                             // 63: ndid_abci_query_v9.GetIdPAssociationTransferResult
+	(*NodeCertificateAuthorityResult)(nil),                              // 64: ndid_abci_query_v9.NodeCertificateAuthorityResult
+	(*GetNodeCertificateAuthorityListResult)(nil),                       // 65: ndid_abci_query_v9.GetNodeCertificateAuthorityListResult
+	(*GetDecommissionedNodeInfoResult)(nil),                             // 66: ndid_abci_query_v9.GetDecommissionedNodeInfoResult
+	(*GetStaleNodeListResult)(nil),                                      // 67: ndid_abci_query_v9.GetStaleNodeListResult
+	(*StaleNode)(nil),                                                   // 68: ndid_abci_query_v9.StaleNode
+	(*GetValidatorListResult)(nil),                                      // 69: ndid_abci_query_v9.GetValidatorListResult
+	(*ValidatorInfo)(nil),                                               // 70: ndid_abci_query_v9.ValidatorInfo
+	(*GetValidatorHistoryResult)(nil),                                   // 71: ndid_abci_query_v9.GetValidatorHistoryResult
+	(*ValidatorPowerChange)(nil),                                        // 72: ndid_abci_query_v9.ValidatorPowerChange
+	(*GetValidatorPowerCapResult)(nil),                                  // 73: ndid_abci_query_v9.GetValidatorPowerCapResult
+	(*AdminPermission)(nil),                                             // 74: ndid_abci_query_v9.AdminPermission
+	(*GetAdminPermissionListResult)(nil),                                // 75: ndid_abci_query_v9.GetAdminPermissionListResult
+	(*GetGovernanceConfigResult)(nil),                                   // 76: ndid_abci_query_v9.GetGovernanceConfigResult
+	(*ProposalInfo)(nil),                                                // 77: ndid_abci_query_v9.ProposalInfo
+	(*GetProposalListResult)(nil),                                       // 78: ndid_abci_query_v9.GetProposalListResult
+	(*GetScheduledChangeListResult)(nil),                                // 79: ndid_abci_query_v9.GetScheduledChangeListResult
+	(*ScheduledChange)(nil),                                             // 80: ndid_abci_query_v9.ScheduledChange
+	(*GetPausedMethodListResult)(nil),                                   // 81: ndid_abci_query_v9.GetPausedMethodListResult
+	(*PausedMethod)(nil),                                                // 82: ndid_abci_query_v9.PausedMethod
+	(*GetUpgradePlanResult)(nil),                                        // 83: ndid_abci_query_v9.GetUpgradePlanResult
+	(*AppVersionActivation)(nil),                                        // 84: ndid_abci_query_v9.AppVersionActivation
+	(*ParameterInfo)(nil),                                               // 85: ndid_abci_query_v9.ParameterInfo
+	(*GetParameterListResult)(nil),                                      // 86: ndid_abci_query_v9.GetParameterListResult
+	(*BatchQueryResult)(nil),                                            // 87: ndid_abci_query_v9.BatchQueryResult
+	(*BatchQueryItemResult)(nil),                                        // 88: ndid_abci_query_v9.BatchQueryItemResult
+	(*structpb.Value)(nil),                                              // 89: google.protobuf.Value
+}
+var file_query_proto_depIdxs = []int32{
+	1,  // 0: ndid_abci_query_v9.NodeKey.certificate_subject:type_name -> ndid_abci_query_v9.NodeCertificateSubject
+	3,  // 1: ndid_abci_query_v9.GetIdpNodesResult.node:type_name -> ndid_abci_query_v9.IdpNode
+	6,  // 2: ndid_abci_query_v9.GetRequestDetailResult.data_request_list:type_name -> ndid_abci_query_v9.DataRequest
+	8,  // 3: ndid_abci_query_v9.GetRequestDetailResult.response_list:type_name -> ndid_abci_query_v9.IdpResponse
+	7,  // 4: ndid_abci_query_v9.DataRequest.response_list:type_name -> ndid_abci_query_v9.AsResponse
+	10, // 5: ndid_abci_query_v9.GetAsNodesByServiceIdResult.node:type_name -> ndid_abci_query_v9.AsNode
+	11, // 6: ndid_abci_query_v9.GetMqAddressesResult.items:type_name -> ndid_abci_query_v9.MqAddress
+	16, // 7: ndid_abci_query_v9.GetNamespaceListResult.items:type_name -> ndid_abci_query_v9.Namespace
+	15, // 8: ndid_abci_query_v9.GetServiceListResult.items:type_name -> ndid_abci_query_v9.ServiceDetail
+	0,  // 9: ndid_abci_query_v9.GetNodeInfoResult.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 10: ndid_abci_query_v9.GetNodeInfoResult.signing_master_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 11: ndid_abci_query_v9.GetNodeInfoResult.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	22, // 12: ndid_abci_query_v9.GetNodeInfoResult.proxy:type_name -> ndid_abci_query_v9.ProxyNodeInfo
+	11, // 13: ndid_abci_query_v9.GetNodeInfoResult.mq:type_name -> ndid_abci_query_v9.MqAddress
+	23, // 14: ndid_abci_query_v9.GetNodeInfoResult.profile:type_name -> ndid_abci_query_v9.NodeProfile
+	0,  // 15: ndid_abci_query_v9.ProxyNodeInfo.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 16: ndid_abci_query_v9.ProxyNodeInfo.signing_master_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 17: ndid_abci_query_v9.ProxyNodeInfo.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	11, // 18: ndid_abci_query_v9.ProxyNodeInfo.mq:type_name -> ndid_abci_query_v9.MqAddress
+	0,  // 19: ndid_abci_query_v9.GetNodePublicKeyListResult.signing_public_key_list:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 20: ndid_abci_query_v9.GetNodePublicKeyListResult.signing_master_public_key_list:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 21: ndid_abci_query_v9.GetNodePublicKeyListResult.encryption_public_key_list:type_name -> ndid_abci_query_v9.NodeKey
+	29, // 22: ndid_abci_query_v9.GetServicesByAsIDResult.services:type_name -> ndid_abci_query_v9.Service
+	31, // 23: ndid_abci_query_v9.GetIdpNodesInfoResult.node:type_name -> ndid_abci_query_v9.IdpNodeInfo
+	0,  // 24: ndid_abci_query_v9.IdpNodeInfo.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 25: ndid_abci_query_v9.IdpNodeInfo.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	11, // 26: ndid_abci_query_v9.IdpNodeInfo.mq:type_name -> ndid_abci_query_v9.MqAddress
+	32, // 27: ndid_abci_query_v9.IdpNodeInfo.proxy:type_name -> ndid_abci_query_v9.IdpNodeInfoProxy
+	23, // 28: ndid_abci_query_v9.IdpNodeInfo.profile:type_name -> ndid_abci_query_v9.NodeProfile
+	0,  // 29: ndid_abci_query_v9.IdpNodeInfoProxy.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 30: ndid_abci_query_v9.IdpNodeInfoProxy.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	11, // 31: ndid_abci_query_v9.IdpNodeInfoProxy.mq:type_name -> ndid_abci_query_v9.MqAddress
+	34, // 32: ndid_abci_query_v9.GetAsNodesInfoByServiceIdResult.node:type_name -> ndid_abci_query_v9.AsNodeInfo
+	0,  // 33: ndid_abci_query_v9.AsNodeInfo.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 34: ndid_abci_query_v9.AsNodeInfo.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	11, // 35: ndid_abci_query_v9.AsNodeInfo.mq:type_name -> ndid_abci_query_v9.MqAddress
+	23, // 36: ndid_abci_query_v9.AsNodeInfo.profile:type_name -> ndid_abci_query_v9.NodeProfile
+	35, // 37: ndid_abci_query_v9.AsNodeInfo.proxy:type_name -> ndid_abci_query_v9.AsNodeProxy
+	0,  // 38: ndid_abci_query_v9.AsNodeProxy.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 39: ndid_abci_query_v9.AsNodeProxy.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	11, // 40: ndid_abci_query_v9.AsNodeProxy.mq:type_name -> ndid_abci_query_v9.MqAddress
+	37, // 41: ndid_abci_query_v9.GetNodesBehindProxyNodeResult.nodes:type_name -> ndid_abci_query_v9.NodeBehindProxy
+	0,  // 42: ndid_abci_query_v9.NodeBehindProxy.signing_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 43: ndid_abci_query_v9.NodeBehindProxy.signing_master_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	0,  // 44: ndid_abci_query_v9.NodeBehindProxy.encryption_public_key:type_name -> ndid_abci_query_v9.NodeKey
+	40, // 45: ndid_abci_query_v9.GetErrorCodeListResult.items:type_name -> ndid_abci_query_v9.ErrorCode
+	44, // 46: ndid_abci_query_v9.GetChainHistoryResult.chains:type_name -> ndid_abci_query_v9.ChainHistoryDetail
+	51, // 47: ndid_abci_query_v9.GetServicePriceListResult.price_list_by_node:type_name -> ndid_abci_query_v9.ServicePriceListByNode
+	52, // 48: ndid_abci_query_v9.ServicePriceListByNode.price_list:type_name -> ndid_abci_query_v9.ServicePrice
+	53, // 49: ndid_abci_query_v9.ServicePrice.price_by_currency_list:type_name -> ndid_abci_query_v9.ServicePriceByCurrency
+	55, // 50: ndid_abci_query_v9.GetServicePriceCeilingResult.price_ceiling_by_currency_list:type_name -> ndid_abci_query_v9.PriceCeilingByCurrency
+	64, // 51: ndid_abci_query_v9.GetNodeCertificateAuthorityListResult.items:type_name -> ndid_abci_query_v9.NodeCertificateAuthorityResult
+	68, // 52: ndid_abci_query_v9.GetStaleNodeListResult.node_list:type_name -> ndid_abci_query_v9.StaleNode
+	70, // 53: ndid_abci_query_v9.GetValidatorListResult.validator_list:type_name -> ndid_abci_query_v9.ValidatorInfo
+	72, // 54: ndid_abci_query_v9.GetValidatorHistoryResult.power_history:type_name -> ndid_abci_query_v9.ValidatorPowerChange
+	74, // 55: ndid_abci_query_v9.GetAdminPermissionListResult.admin_permission_list:type_name -> ndid_abci_query_v9.AdminPermission
+	89, // 56: ndid_abci_query_v9.ProposalInfo.params:type_name -> google.protobuf.Value
+	77, // 57: ndid_abci_query_v9.GetProposalListResult.proposal_list:type_name -> ndid_abci_query_v9.ProposalInfo
+	80, // 58: ndid_abci_query_v9.GetScheduledChangeListResult.scheduled_change_list:type_name -> ndid_abci_query_v9.ScheduledChange
+	89, // 59: ndid_abci_query_v9.ScheduledChange.params:type_name -> google.protobuf.Value
+	82, // 60: ndid_abci_query_v9.GetPausedMethodListResult.paused_method_list:type_name -> ndid_abci_query_v9.PausedMethod
+	84, // 61: ndid_abci_query_v9.GetUpgradePlanResult.activation_list:type_name -> ndid_abci_query_v9.AppVersionActivation
+	89, // 62: ndid_abci_query_v9.ParameterInfo.value:type_name -> google.protobuf.Value
+	89, // 63: ndid_abci_query_v9.ParameterInfo.default_value:type_name -> google.protobuf.Value
+	85, // 64: ndid_abci_query_v9.GetParameterListResult.items:type_name -> ndid_abci_query_v9.ParameterInfo
+	88, // 65: ndid_abci_query_v9.BatchQueryResult.results:type_name -> ndid_abci_query_v9.BatchQueryItemResult
+	89, // 66: ndid_abci_query_v9.BatchQueryItemResult.value:type_name -> google.protobuf.Value
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_query_proto_init() }
+func file_query_proto_init() {
+	if File_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCertificateSubject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdpNodesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdpNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequestDetailResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAsNodesByServiceIdResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MqAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMqAddressesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeTokenResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceFuncResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Namespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckExistingIdentityResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccessorKeyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyNodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodePublicKeyListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckExistingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentityInfoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataSignatureResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicesByAsIDResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdpNodesInfoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdpNodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdpNodeInfoProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAsNodesInfoByServiceIdResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsNodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsNodeProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesBehindProxyNodeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeBehindProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeIDListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccessorOwnerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetErrorCodeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsInitEndedResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainHistoryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainHistoryDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReferenceGroupCodeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSupportedIALListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSupportedAALListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowedModeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowedMinIalForRegisterIdentityAtFirstIdpResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicePriceListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePriceListByNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServicePriceByCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicePriceCeilingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceCeilingByCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicePriceMinEffectiveDatetimeDelayResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageDetailResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequestTypeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSuppressedIdentityModificationNotificationNodeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsSuppressedIdentityModificationNotificationNodeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllowedNodeSupportedFeatureListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdPAssociationTransferResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCertificateAuthorityResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeCertificateAuthorityListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDecommissionedNodeInfoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStaleNodeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaleNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorHistoryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPowerChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorPowerCapResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminPermissionListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGovernanceConfigResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProposalListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledChangeListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPausedMethodListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausedMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpgradePlanResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppVersionActivation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParameterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParameterListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchQueryItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_query_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[85].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   89,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_query_proto_goTypes,
+		DependencyIndexes: file_query_proto_depIdxs,
+		MessageInfos:      file_query_proto_msgTypes,
+	}.Build()
+	File_query_proto = out.File
+	file_query_proto_rawDesc = nil
+	file_query_proto_goTypes = nil
+	file_query_proto_depIdxs = nil
+}
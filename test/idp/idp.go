@@ -28,6 +28,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ndidplatform/smart-contract/v9/abci/app/v1"
 	"github.com/ndidplatform/smart-contract/v9/test/data"
@@ -540,3 +541,57 @@ func TestRevokeAndAddAccessor(t *testing.T, caseID int64, expected string, expec
 	}
 	RevokeAndAddAccessor(t, nodeID, privK, param, expected, expectResultFrom)
 }
+
+func RenewAccessor(t *testing.T, nodeID, privK string, param app.RenewAccessorParam, expected string, expectResultFrom string) {
+	privKey := utils.GetPrivateKeyFromString(privK)
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	fnName := "RenewAccessor"
+	nonce, signature := utils.CreateSignatureAndNonce(fnName, paramJSON, privKey)
+	result, _ := utils.CreateTxn([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(utils.ResponseTx)
+	var actual string
+	if expectResultFrom == "CheckTx" {
+		actual = resultObj.Result.CheckTx.Log
+	} else {
+		actual = resultObj.Result.TxResult.Log
+	}
+	if actual != expected {
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TestRenewAccessor(t *testing.T, caseID int64, expected string, expectResultFrom string) {
+	var nodeID string
+	var privK string
+	var param app.RenewAccessorParam
+	switch caseID {
+	case 1:
+		param.AccessorID = ""
+		nodeID = data.IdP1
+		privK = data.IdpPrivK1
+	case 2:
+		expiration := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		param.AccessorID = data.AccessorID5.String()
+		param.AccessorExpiration = &expiration
+		nodeID = data.IdP1
+		privK = data.IdpPrivK1
+	case 3:
+		expiration := time.Date(2099, time.January, 1, 0, 0, 0, 0, time.UTC)
+		param.AccessorID = data.AccessorID1.String()
+		param.AccessorExpiration = &expiration
+		nodeID = data.IdP1
+		privK = data.IdpPrivK1
+	case 4:
+		expiration := time.Date(2099, time.January, 1, 0, 0, 0, 0, time.UTC)
+		param.AccessorID = data.AccessorID5.String()
+		param.AccessorExpiration = &expiration
+		nodeID = data.IdP1
+		privK = data.IdpPrivK1
+	}
+	RenewAccessor(t, nodeID, privK, param, expected, expectResultFrom)
+}
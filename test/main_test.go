@@ -64,8 +64,8 @@ func TestIdP1RegisterIdentity(t *testing.T) {
 	idp.TestRegisterIdentity(t, 2, "Identifier count is greater than allowed identifier count", "DeliverTx")
 	idp.TestRegisterIdentity(t, 3, "Invalid namespace", "DeliverTx")
 	idp.TestRegisterIdentity(t, 4, "success", "DeliverTx")
-	query.TestGetIdentityInfo(t, 1, `{"ial":3,"lial":null,"laal":null,"mode_list":[2]}`)
-	query.TestGetIdentityInfo(t, 2, `{"ial":3,"lial":null,"laal":null,"mode_list":[2]}`)
+	query.TestGetIdentityInfo(t, 1, `{"ial":3,"lial":null,"laal":null,"mode_list":[2],"frozen":false}`)
+	query.TestGetIdentityInfo(t, 2, `{"ial":3,"lial":null,"laal":null,"mode_list":[2],"frozen":false}`)
 	query.TestQueryCheckExistingIdentity(t, data.UserNamespace1, data.UserID1, `{"exist":true}`)
 	query.TestGetIdpNodes(t, 1, `{"node":[{"node_id":"`+data.IdP1+`","node_name":"IdP Number 1","max_ial":3,"max_aal":3,"supported_feature_list":[],"lial":null,"laal":null,"supported_request_message_data_url_type_list":[],"agent":false}]}`)
 	query.TestGetIdpNodesInfo(t, 1, `{"node":[{"node_id":"`+data.IdP1+`","name":"IdP Number 1","max_ial":3,"max_aal":3,"supported_feature_list":[],"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"mq":[{"ip":"192.168.3.99","port":8000}],"agent":false,"node_id_whitelist_active":false,"supported_request_message_data_url_type_list":[]}]}`)
@@ -91,7 +91,7 @@ func TestIdP2RegisterIdentity(t *testing.T) {
 }
 
 func TestIdPAgent1GetNodesInfo(t *testing.T) {
-	query.TestGetNodeInfo(t, data.IdPAgent1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAz7N55vytQuBV17KHPzd1\nILPonOpltFqcMCV+x81NJNcvf2QcDDemYK2oObcs8rDuavx3+aSAeBrGXmFIjvVT\n7YTpEfoCGVf50AJKeyOeuaGefVy12GlGUsxKxCWDJaWe6Vc7S+cOyiLHNp/U/La3\nrSRbJeS6+GLbbVtJZpXsJwIejrK52JwSnCTH9aeVUDovJZNfQvPHaKArqermyI7/\n44o8qfGkImAs4UhLLpcQVyyADaqHMFKpRTE/cLISCB6Ut9Vb1lyBgk0xlGWLfrXa\n0erk96NK3tw0thd464qz2qFojNISmM1ddG+VSHoZUu7UJzeCUXyw0RkB1PZEXiwz\n7wIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAz7N55vytQuBV17KHPzd1\nILPonOpltFqcMCV+x81NJNcvf2QcDDemYK2oObcs8rDuavx3+aSAeBrGXmFIjvVT\n7YTpEfoCGVf50AJKeyOeuaGefVy12GlGUsxKxCWDJaWe6Vc7S+cOyiLHNp/U/La3\nrSRbJeS6+GLbbVtJZpXsJwIejrK52JwSnCTH9aeVUDovJZNfQvPHaKArqermyI7/\n44o8qfGkImAs4UhLLpcQVyyADaqHMFKpRTE/cLISCB6Ut9Vb1lyBgk0xlGWLfrXa\n0erk96NK3tw0thd464qz2qFojNISmM1ddG+VSHoZUu7UJzeCUXyw0RkB1PZEXiwz\n7wIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Agent 1","role":"IdP","max_ial":2.3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":[],"agent":true,"node_id_whitelist_active":false,"mq":null,"active":true}`)
+	query.TestGetNodeInfo(t, data.IdPAgent1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAz7N55vytQuBV17KHPzd1\nILPonOpltFqcMCV+x81NJNcvf2QcDDemYK2oObcs8rDuavx3+aSAeBrGXmFIjvVT\n7YTpEfoCGVf50AJKeyOeuaGefVy12GlGUsxKxCWDJaWe6Vc7S+cOyiLHNp/U/La3\nrSRbJeS6+GLbbVtJZpXsJwIejrK52JwSnCTH9aeVUDovJZNfQvPHaKArqermyI7/\n44o8qfGkImAs4UhLLpcQVyyADaqHMFKpRTE/cLISCB6Ut9Vb1lyBgk0xlGWLfrXa\n0erk96NK3tw0thd464qz2qFojNISmM1ddG+VSHoZUu7UJzeCUXyw0RkB1PZEXiwz\n7wIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAz7N55vytQuBV17KHPzd1\nILPonOpltFqcMCV+x81NJNcvf2QcDDemYK2oObcs8rDuavx3+aSAeBrGXmFIjvVT\n7YTpEfoCGVf50AJKeyOeuaGefVy12GlGUsxKxCWDJaWe6Vc7S+cOyiLHNp/U/La3\nrSRbJeS6+GLbbVtJZpXsJwIejrK52JwSnCTH9aeVUDovJZNfQvPHaKArqermyI7/\n44o8qfGkImAs4UhLLpcQVyyADaqHMFKpRTE/cLISCB6Ut9Vb1lyBgk0xlGWLfrXa\n0erk96NK3tw0thd464qz2qFojNISmM1ddG+VSHoZUu7UJzeCUXyw0RkB1PZEXiwz\n7wIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":20,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Agent 1","role":"IdP","roles":["IdP"],"max_ial":2.3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":[],"agent":true,"node_id_whitelist_active":false,"mq":null,"active":true}`)
 }
 
 func TestIdP2AddAccessor(t *testing.T) {
@@ -130,9 +130,9 @@ func TestASRegisterServiceDestination(t *testing.T) {
 }
 
 func TestIdP1UpdateIdentity(t *testing.T) {
-	query.TestGetIdentityInfo(t, 2, `{"ial":3,"lial":null,"laal":null,"mode_list":[2]}`)
+	query.TestGetIdentityInfo(t, 2, `{"ial":3,"lial":null,"laal":null,"mode_list":[2],"frozen":false}`)
 	idp.TestUpdateIdentity(t, 1, "success", "DeliverTx")
-	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2]}`)
+	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2],"frozen":false}`)
 	query.TestGetIdpNodesInfo(t, 3, `{"node":[{"node_id":"`+data.IdP1+`","name":"IdP Number 1","max_ial":3,"max_aal":3,"supported_feature_list":[],"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"mq":[{"ip":"192.168.3.99","port":8000}],"agent":false,"node_id_whitelist_active":false,"ial":2.3,"mode_list":[2],"supported_request_message_data_url_type_list":[]},{"node_id":"`+data.IdP2+`","name":"IdP Number 2","max_ial":2.3,"max_aal":3,"supported_feature_list":[],"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":18,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":18,"creation_chain_id":"test-chain-NDID","active":true},"mq":[{"ip":"192.168.3.100","port":8000}],"agent":false,"node_id_whitelist_active":false,"ial":2.3,"mode_list":[2],"supported_request_message_data_url_type_list":[]}]}`)
 }
 
@@ -153,17 +153,17 @@ func TestIdP2RegisterIdentityAfterRevokeIdentityAssociation(t *testing.T) {
 }
 
 func TestQueryGetAccessorKey(t *testing.T) {
-	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID"}`)
-	query.TestGetAccessorKey(t, data.AccessorID2.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey2, "\n", "\\n", -1)+`","accessor_type":"RSA2048","active":true,"owner_node_id":"`+data.IdP2+`","creation_block_height":62,"creation_chain_id":"test-chain-NDID"}`)
+	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","accessor_algorithm":"","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID","accessor_expiration":null}`)
+	query.TestGetAccessorKey(t, data.AccessorID2.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey2, "\n", "\\n", -1)+`","accessor_type":"RSA2048","accessor_algorithm":"","active":true,"owner_node_id":"`+data.IdP2+`","creation_block_height":62,"creation_chain_id":"test-chain-NDID","accessor_expiration":null}`)
 }
 func TestQueryGetAllowedModeList(t *testing.T) {
 	query.TestGetAllowedModeList(t, "", `{"allowed_mode_list":[1,2,3]}`)
 }
 
 func TestIdP1UpdateIdentityModeList(t *testing.T) {
-	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2]}`)
+	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2],"frozen":false}`)
 	idp.TestUpdateIdentityModeList(t, 1, "success", "DeliverTx")
-	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3]}`)
+	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3],"frozen":false}`)
 }
 
 func TestIdP1AddIdentity(t *testing.T) {
@@ -171,29 +171,46 @@ func TestIdP1AddIdentity(t *testing.T) {
 	idp.TestCreateIdpResponse(t, data.RequestID6.String())
 	common.TestCloseRequest(t, data.RequestID6.String())
 	idp.TestAddIdentity(t, 1, "success", "DeliverTx")
-	query.TestGetIdentityInfo(t, 3, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3]}`)
+	query.TestGetIdentityInfo(t, 3, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3],"frozen":false}`)
 }
 
 func TestIdP1UpdateNode(t *testing.T) {
-	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":[],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
+	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","roles":["IdP"],"max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":[],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
 	common.TestUpdateNode(t, 1, "success", "DeliverTx")
-	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":["text/plain","application/pdf"],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
+	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","roles":["IdP"],"max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":["text/plain","application/pdf"],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
 	query.TestGetIdpNodesInfo(t, 4, `{"node":[{"node_id":"`+data.IdP1+`","name":"IdP Number 1","max_ial":3,"max_aal":3,"supported_feature_list":[],"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEArdcKj/gAetVyg6Nn2lDi\nm/UJYQsQCav60EVbECm5EVT8WgnpzO+GrRyBtxqWUdtGar7d6orLh1RX1ikU7Yx2\nSA8Xlf+ZDaCELba/85Nb+IppLBdPywixgumoto9G9dDGSnPkHAlq5lXXA1eeUS7j\niU1lf37lwTZaO0COAuu8Vt9GcwYPh7SSf4/eXabQGbo/TMUVpXX1w5N1A07Qh5DG\nr/ZKzEE9/5bJJJRS635OA2T4gIY9XRWYiTxtiZz6AFCxP92Cjz/sNvSc/Cuvwi15\nycS4C35tjM8iT5djsRcR+MJeXyvurkaYgMGJTDIWub/A5oavVD3VwusZZNZvpDpD\nPwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":2,"creation_block_height":118,"creation_chain_id":"test-chain-NDID","active":true},"mq":[{"ip":"192.168.3.99","port":8000}],"agent":false,"node_id_whitelist_active":false,"ial":2.3,"mode_list":[2,3],"supported_request_message_data_url_type_list":["text/plain","application/pdf"]}]}`)
 	common.TestUpdateNode(t, 2, "success", "DeliverTx")
-	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":3,"creation_block_height":120,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":3,"creation_block_height":120,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":["text/plain"],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
+	query.TestGetNodeInfo(t, data.IdP1, `{"signing_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":3,"creation_block_height":120,"creation_chain_id":"test-chain-NDID","active":true},"signing_master_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAukTxVg8qpwXebALGCrly\niv8PNNxLo0CEX3N33cR1TNfImItd5nFwmozLJLM9LpNF711PrkH3EBLJM+qwASlC\nBayeMiMT8tDmOtv1RqIxyLjEU8M0RBBedk/TsKQwNmmeU3n5Ap+GRTYoEOwTKNra\nI8YDfbjb9fNtSICiDzn3UcQj13iLz5x4MjaewtC6PR1r8uVfLyS4uI+3/qau0zWV\n+s6b3JdqU2zdHeuaj9XjX7aNV7mvnjYgzk/O7M/p/86RBEOm7pt6JmTGnFu44jBO\nez6GqF2hZzqR9nM1K4aOedBMHintVnhh1oOPG9uRiDnJWvN16PNTfr7XBOUzL03X\nDQIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSASSA_PKCS1_V1_5_SHA_256","version":1,"creation_block_height":16,"creation_chain_id":"test-chain-NDID","active":true},"encryption_public_key":{"public_key":"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAwx9oT44DmDRiQJ1K0b9Q\nolEsrQ51hBUDq3oCKTffBikYenSUQNimVCsVBfNpKhZqpW56hH0mtgLbI7QgZGj9\ncNBMzSLMolltw0EerF0Ckz0Svvie1/oFJ1a0Cf4bdKKW6wRzL+aFVvelmNlLoSZX\noCpxUPQq7SMLoYEK1c+e3l3H0bfh6TAVt7APOQEFhXy9MRt83oVSAGW36gdNEksm\nz1WIT/C1XcHHVwCIJGSdZw5F6Y2gBjtiLsiFtpKfxQAPwBvDi7uS0PUdN7YQ/G69\nb0FgoE6qivDTqYfr80Y345Qe/qPGDvfne7oA8DIbRV+Kd5s4tFn/cC0Wd+jvrZJ7\njwIDAQAB\n-----END PUBLIC KEY-----\n","algorithm":"RSAES_PKCS1_V1_5","version":3,"creation_block_height":120,"creation_chain_id":"test-chain-NDID","active":true},"node_name":"IdP Number 1","role":"IdP","roles":["IdP"],"max_ial":3,"max_aal":3,"supported_feature_list":[],"supported_request_message_data_url_type_list":["text/plain"],"agent":false,"node_id_whitelist_active":false,"mq":[{"ip":"192.168.3.99","port":8000}],"active":true}`)
 }
 
 func TestIdP1RevokeAndAddAccessor(t *testing.T) {
-	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID"}`)
+	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","accessor_algorithm":"","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID","accessor_expiration":null}`)
 	common.TestCreateRequest(t, data.RequestID7.String())
 	idp.TestCreateIdpResponse(t, data.RequestID7.String())
 	common.TestCloseRequest(t, data.RequestID7.String())
 	idp.TestRevokeAndAddAccessor(t, 1, "success", "DeliverTx")
-	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","active":false,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID"}`)
-	query.TestGetAccessorKey(t, data.AccessorID5.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey2, "\n", "\\n", -1)+`","accessor_type":"RSA2048","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":128,"creation_chain_id":"test-chain-NDID"}`)
+	query.TestGetAccessorKey(t, data.AccessorID1.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey1, "\n", "\\n", -1)+`","accessor_type":"RSA2048","accessor_algorithm":"","active":false,"owner_node_id":"`+data.IdP1+`","creation_block_height":50,"creation_chain_id":"test-chain-NDID","accessor_expiration":null}`)
+	query.TestGetAccessorKey(t, data.AccessorID5.String(), `{"accessor_public_key":"`+strings.Replace(data.AccessorPubKey2, "\n", "\\n", -1)+`","accessor_type":"RSA2048","accessor_algorithm":"","active":true,"owner_node_id":"`+data.IdP1+`","creation_block_height":128,"creation_chain_id":"test-chain-NDID","accessor_expiration":null}`)
 
 }
 
+func TestIdP1RenewAccessor(t *testing.T) {
+	idp.TestRenewAccessor(t, 1, "Accessor ID is required", "CheckTx")
+	idp.TestRenewAccessor(t, 2, "Accessor expiration must be in the future", "DeliverTx")
+	idp.TestRenewAccessor(t, 3, "Accessor is not active", "DeliverTx")
+	idp.TestRenewAccessor(t, 4, "success", "DeliverTx")
+}
+
+func TestNDIDFreezeReferenceGroup(t *testing.T) {
+	ndid.TestFreezeReferenceGroup(t, "", "Reason code cannot be empty", "CheckTx")
+	ndid.TestFreezeReferenceGroup(t, "LEGAL_ORDER", "success", "DeliverTx")
+	ndid.TestFreezeReferenceGroup(t, "LEGAL_ORDER", "Reference group is frozen", "DeliverTx")
+	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3],"frozen":true,"freeze_reason_code":"LEGAL_ORDER"}`)
+	ndid.TestUnfreezeReferenceGroup(t, "LEGAL_ORDER_REVOKED", "success", "DeliverTx")
+	ndid.TestUnfreezeReferenceGroup(t, "LEGAL_ORDER_REVOKED", "Reference group is not frozen", "DeliverTx")
+	query.TestGetIdentityInfo(t, 2, `{"ial":2.3,"lial":null,"laal":null,"mode_list":[2,3],"frozen":false}`)
+}
+
 func TestAddErrorCodeByNDID(t *testing.T) {
 	ndid.TestAddErrorCode(t, "idp", data.IdpErrorCode1, data.IdpErrorCodeDescription1, "success", "DeliverTx")
 	ndid.TestAddErrorCode(t, "idp", data.IdpErrorCode1, data.IdpErrorCodeDescription1, "ErrorCode already exists", "DeliverTx")
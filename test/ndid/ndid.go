@@ -495,3 +495,42 @@ func TestRemoveErrorCode(t *testing.T, errorCodeType string, errorCode int32, ex
 	}
 	RemoveErrorCode(t, ndidNodeID, data.NdidPrivK, param, expected, expectResultFrom)
 }
+
+func FreezeReferenceGroup(t *testing.T, nodeID, privK string, fnName string, param app.FreezeReferenceGroupParam, expected string, expectResultFrom string) {
+	privKey := utils.GetPrivateKeyFromString(privK)
+	paramJSON, err := json.Marshal(param)
+	if err != nil {
+		fmt.Println("error:", err)
+	}
+	nonce, signature := utils.CreateSignatureAndNonce(fnName, paramJSON, privKey)
+	result, _ := utils.CreateTxn([]byte(fnName), paramJSON, []byte(nonce), signature, []byte(nodeID))
+	resultObj, _ := result.(utils.ResponseTx)
+	var actual string
+	if expectResultFrom == "CheckTx" {
+		actual = resultObj.Result.CheckTx.Log
+	} else {
+		actual = resultObj.Result.TxResult.Log
+	}
+	if actual != expected {
+		t.Errorf("\n"+`param: %s`, paramJSON)
+		t.Errorf("\n"+`CheckTx log: "%s"`, resultObj.Result.CheckTx.Log)
+		t.Fatalf("FAIL: %s\nExpected: %#v\nActual: %#v", fnName, expected, actual)
+	}
+	t.Logf("PASS: %s", fnName)
+}
+
+func TestFreezeReferenceGroup(t *testing.T, reasonCode string, expected string, expectResultFrom string) {
+	param := app.FreezeReferenceGroupParam{
+		ReferenceGroupCode: data.ReferenceGroupCode1.String(),
+		ReasonCode:         reasonCode,
+	}
+	FreezeReferenceGroup(t, ndidNodeID, data.NdidPrivK, "FreezeReferenceGroup", param, expected, expectResultFrom)
+}
+
+func TestUnfreezeReferenceGroup(t *testing.T, reasonCode string, expected string, expectResultFrom string) {
+	param := app.FreezeReferenceGroupParam{
+		ReferenceGroupCode: data.ReferenceGroupCode1.String(),
+		ReasonCode:         reasonCode,
+	}
+	FreezeReferenceGroup(t, ndidNodeID, data.NdidPrivK, "UnfreezeReferenceGroup", param, expected, expectResultFrom)
+}